@@ -0,0 +1,229 @@
+// Command syso compiles an application manifest, icons, version information and
+// string tables into COFF .syso objects, which are linked by the Go toolchain
+// into Windows executables. It runs on any platform, with no external resource
+// compiler.
+//
+// # Usage
+//
+//	go run github.com/rodrigocfd/windigo/cmd/syso -manifest app.exe.manifest -ico app.ico -version 1.2.0.0 -product "My App"
+//
+// This writes rsrc_windows_386.syso, rsrc_windows_amd64.syso and
+// rsrc_windows_arm64.syso to the current folder, which must be the folder of
+// the main package.
+//
+// String tables are read from an .ini file, with one section per language
+// identifier in hex, and one key per string ID:
+//
+//	[0409]
+//	1001 = Hello
+//	1002 = World
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/ini"
+	"github.com/rodrigocfd/windigo/res"
+	"github.com/rodrigocfd/windigo/versioninfo"
+)
+
+// A flag which can be passed many times.
+type _ListFlag []string
+
+func (l *_ListFlag) String() string     { return strings.Join(*l, ",") }
+func (l *_ListFlag) Set(v string) error { *l = append(*l, v); return nil }
+
+func main() {
+	var icos _ListFlag
+	manifest := flag.String("manifest", "", "application manifest `file`")
+	flag.Var(&icos, "ico", "icon `file`, can be repeated; the first one is the application icon")
+	strs := flag.String("strings", "", "string tables .ini `file`, with one section per language in hex")
+	lang := flag.String("lang", "0409", "language identifier in hex of the manifest, icons and version")
+	version := flag.String("version", "", "file `version`, like 1.2.0.0")
+	productVersion := flag.String("product-version", "", "product `version`, defaults to -version")
+	company := flag.String("company", "", "CompanyName version string")
+	product := flag.String("product", "", "ProductName version string")
+	description := flag.String("description", "", "FileDescription version string")
+	copyright := flag.String("copyright", "", "LegalCopyright version string")
+	originalName := flag.String("original-name", "", "OriginalFilename version string")
+	archs := flag.String("arch", "386,amd64,arm64", "comma-separated target architectures")
+	out := flag.String("o", "rsrc", "output file `prefix`, suffixed with _windows_<arch>.syso")
+	flag.Parse()
+
+	langId, err := parseLangId(*lang)
+	if err != nil {
+		fail(err)
+	}
+
+	var file res.File
+
+	if *manifest != "" {
+		data, err := os.ReadFile(*manifest)
+		if err != nil {
+			fail(err)
+		}
+		file.SetManifest(langId, data)
+	}
+
+	for i, icoPath := range icos {
+		data, err := os.ReadFile(icoPath)
+		if err != nil {
+			fail(err)
+		}
+		if err := file.SetIconGroup(res.IdInt(uint16(i+1)), langId, data); err != nil {
+			fail(fmt.Errorf("%s: %w", icoPath, err))
+		}
+	}
+
+	if *version != "" || *productVersion != "" {
+		if *productVersion == "" {
+			*productVersion = *version
+		} else if *version == "" {
+			*version = *productVersion
+		}
+		vi, err := buildVersionInfo(langId, *version, *productVersion, map[string]string{
+			"CompanyName":      *company,
+			"ProductName":      *product,
+			"FileDescription":  *description,
+			"LegalCopyright":   *copyright,
+			"OriginalFilename": *originalName,
+		})
+		if err != nil {
+			fail(err)
+		}
+		file.SetVersionInfo(langId, vi)
+	}
+
+	if *strs != "" {
+		if err := loadStrings(&file, *strs); err != nil {
+			fail(err)
+		}
+	}
+
+	if len(file.Resources) == 0 {
+		fail(fmt.Errorf("no resources given, run with -h for help"))
+	}
+
+	for _, name := range strings.Split(*archs, ",") {
+		arch, err := parseArch(strings.TrimSpace(name))
+		if err != nil {
+			fail(err)
+		}
+		outPath := fmt.Sprintf("%s_windows_%s.syso", *out, arch)
+		if err := os.WriteFile(outPath, file.SysoBytes(arch), 0o644); err != nil {
+			fail(err)
+		}
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "syso:", err)
+	os.Exit(1)
+}
+
+func parseArch(name string) (res.ARCH, error) {
+	for _, arch := range []res.ARCH{res.ARCH_386, res.ARCH_AMD64, res.ARCH_ARM64} {
+		if arch.String() == name {
+			return arch, nil
+		}
+	}
+	return 0, fmt.Errorf("unsupported architecture: %q", name)
+}
+
+func parseLangId(s string) (uint16, error) {
+	n, err := strconv.ParseUint(strings.TrimPrefix(strings.ToLower(s), "0x"), 16, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid language identifier: %q", s)
+	}
+	return uint16(n), nil
+}
+
+// Parses a version like 1.2.3.4, with up to 4 fields.
+func parseVersion(s string) ([4]uint16, error) {
+	var ver [4]uint16
+	fields := strings.Split(s, ".")
+	if len(fields) > 4 {
+		return ver, fmt.Errorf("invalid version: %q", s)
+	}
+	for i, field := range fields {
+		n, err := strconv.ParseUint(field, 10, 16)
+		if err != nil {
+			return ver, fmt.Errorf("invalid version: %q", s)
+		}
+		ver[i] = uint16(n)
+	}
+	return ver, nil
+}
+
+func buildVersionInfo(langId uint16,
+	version, productVersion string, strs map[string]string) (*versioninfo.VersionInfo, error) {
+
+	fileVer, err := parseVersion(version)
+	if err != nil {
+		return nil, err
+	}
+	prodVer, err := parseVersion(productVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	vi := &versioninfo.VersionInfo{
+		Fixed: versioninfo.FixedFileInfo{
+			FileVersion:    fileVer,
+			ProductVersion: prodVer,
+			FileFlagsMask:  versioninfo.FF_MASK,
+			FileOS:         versioninfo.OS_NT_WINDOWS32,
+			FileType:       versioninfo.FT_APP,
+		},
+	}
+	st := vi.AddStringTable(langId, versioninfo.CP_UNICODE)
+	st.Set("FileVersion", version)
+	st.Set("ProductVersion", productVersion)
+	for _, key := range []string{"CompanyName", "ProductName",
+		"FileDescription", "LegalCopyright", "OriginalFilename"} {
+
+		if strs[key] != "" {
+			st.Set(key, strs[key])
+		}
+	}
+	return vi, nil
+}
+
+// Loads the string tables from an .ini file, with one section per language.
+func loadStrings(file *res.File, iniPath string) error {
+	data, err := os.ReadFile(iniPath)
+	if err != nil {
+		return err
+	}
+	iniFile, err := ini.Parse(data, ini.DUPLICATE_ERROR)
+	if err != nil {
+		return fmt.Errorf("%s: %w", iniPath, err)
+	}
+
+	for _, section := range iniFile.Sections {
+		if section.Name == "" {
+			continue // keys before the first section
+		}
+		langId, err := parseLangId(section.Name)
+		if err != nil {
+			return fmt.Errorf("%s: %w", iniPath, err)
+		}
+
+		strs := make(map[uint16]string, len(section.Values))
+		for _, key := range section.Values {
+			strId, err := strconv.ParseUint(key.Name, 10, 16)
+			if err != nil {
+				return fmt.Errorf("%s: invalid string ID: %q", iniPath, key.Name)
+			}
+			strs[uint16(strId)] = key.Value
+		}
+		if err := file.SetStrings(langId, strs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package ico
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// An .ico or .cur file, which contains the same picture in several sizes and
+// color depths.
+//
+// # Example
+//
+//	data, _ := os.ReadFile("C:\\Temp\\app.ico")
+//	file, _ := ico.Parse(data)
+//	for _, entry := range file.Entries {
+//		fmt.Printf("%dx%d, %d bpp\n", entry.Width, entry.Height, entry.BitCount)
+//	}
+//	img, _ := file.Best(32, 32).Image()
+type File struct {
+	Type    TYPE    // Whether the file is an icon or a cursor.
+	Entries []Entry // Images in file order.
+}
+
+// An image of a File.
+type Entry struct {
+	Width    int    // Width in pixels, up to 256.
+	Height   int    // Height in pixels, up to 256.
+	Colors   uint8  // Number of palette colors, or 0 if there's no palette.
+	Planes   uint16 // Icons only: number of color planes.
+	BitCount uint16 // Icons only: bits per pixel.
+	HotspotX uint16 // Cursors only: horizontal position of the hotspot.
+	HotspotY uint16 // Cursors only: vertical position of the hotspot.
+	Data     []byte // Image data: either a DIB, without the BITMAPFILEHEADER, or a PNG.
+}
+
+// Type of a File.
+type TYPE uint16
+
+const (
+	TYPE_ICON   TYPE = 1
+	TYPE_CURSOR TYPE = 2
+)
+
+var _PNG_SIGNATURE = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+
+// Tells whether the image data is PNG; otherwise it's a DIB.
+func (e *Entry) IsPng() bool {
+	return bytes.HasPrefix(e.Data, _PNG_SIGNATURE)
+}
+
+// Returns the entry which best fits the given size in pixels, or nil if the
+// file has no entries.
+//
+// An entry with the exact size is preferred; otherwise, the smallest larger
+// one, which can be scaled down; otherwise, the largest one. Among entries of
+// the same size, the one with more bits per pixel is chosen.
+func (f *File) Best(width, height int) *Entry {
+	var best *Entry
+	for i := range f.Entries {
+		entry := &f.Entries[i]
+		if best == nil || entry.fitsBetter(best, width, height) {
+			best = entry
+		}
+	}
+	return best
+}
+
+func (e *Entry) fitsBetter(other *Entry, width, height int) bool {
+	rank := func(e *Entry) int {
+		if e.Width == width && e.Height == height {
+			return 2
+		} else if e.Width >= width && e.Height >= height {
+			return 1
+		}
+		return 0
+	}
+
+	eRank, otherRank := rank(e), rank(other)
+	eArea, otherArea := e.Width*e.Height, other.Width*other.Height
+	if eRank != otherRank {
+		return eRank > otherRank
+	} else if eArea != otherArea {
+		if eRank == 1 {
+			return eArea < otherArea // smallest of the larger ones
+		}
+		return eArea > otherArea // largest of the smaller ones
+	}
+	return e.bitCount() > other.bitCount()
+}
+
+// Returns the bits per pixel of the image, read from the image data if
+// BitCount is zero, as often written in PNG entries.
+func (e *Entry) bitCount() uint16 {
+	if e.BitCount != 0 {
+		return e.BitCount
+	} else if e.IsPng() {
+		return 32
+	} else if len(e.Data) >= 16 { // BITMAPINFOHEADER.biBitCount
+		return binary.LittleEndian.Uint16(e.Data[14:])
+	}
+	return 0
+}
+
+// Returns the number of color planes, which is always 1 when not set.
+func (e *Entry) planes() uint16 {
+	if e.Planes == 0 {
+		return 1
+	}
+	return e.Planes
+}
+
+// Width and height are stored in a byte, where 0 means 256.
+func sizeByte(n int) uint8 {
+	if n >= 256 {
+		return 0
+	}
+	return uint8(n)
+}
+
+func sizeFromByte(b uint8) int {
+	if b == 0 {
+		return 256
+	}
+	return int(b)
+}
@@ -0,0 +1,81 @@
+package ico
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const _GROUP_ENTRY_SIZE = 14 // sizeof(GRPICONDIRENTRY)
+
+// An entry of an RT_GROUP_ICON resource, which refers to the RT_ICON resource
+// holding the image data.
+type GroupEntry struct {
+	Width    int
+	Height   int
+	Colors   uint8
+	Planes   uint16
+	BitCount uint16
+	Size     uint32 // Size of the image data.
+	Id       uint16 // ID of the RT_ICON resource.
+}
+
+// Serializes the directory of the icon file as an RT_GROUP_ICON resource. Each
+// entry refers to an RT_ICON resource with the given ID, which must be stored
+// with the Data of the entry.
+//
+// Panics if the file is not an icon, or if the number of IDs doesn't match the
+// number of entries.
+//
+// # Example
+//
+//	group := file.GroupBytes([]uint16{1, 2, 3})
+func (f *File) GroupBytes(ids []uint16) []byte {
+	if f.Type != TYPE_ICON {
+		panic("Only icon files can be serialized as RT_GROUP_ICON.")
+	} else if len(ids) != len(f.Entries) {
+		panic(fmt.Sprintf("Expected %d icon IDs, got %d.", len(f.Entries), len(ids)))
+	}
+
+	le := binary.LittleEndian
+	buf := make([]byte, 0, _DIR_SIZE+len(f.Entries)*_GROUP_ENTRY_SIZE)
+	buf = le.AppendUint16(buf, 0)
+	buf = le.AppendUint16(buf, uint16(TYPE_ICON))
+	buf = le.AppendUint16(buf, uint16(len(f.Entries)))
+	for i := range f.Entries {
+		entry := &f.Entries[i]
+		buf = append(buf, sizeByte(entry.Width), sizeByte(entry.Height), entry.Colors, 0)
+		buf = le.AppendUint16(buf, entry.planes())
+		buf = le.AppendUint16(buf, entry.bitCount())
+		buf = le.AppendUint32(buf, uint32(len(entry.Data)))
+		buf = le.AppendUint16(buf, ids[i])
+	}
+	return buf
+}
+
+// Parses an RT_GROUP_ICON resource.
+func ParseGroup(src []byte) ([]GroupEntry, error) {
+	le := binary.LittleEndian
+	if len(src) < _DIR_SIZE || TYPE(le.Uint16(src[2:])) != TYPE_ICON {
+		return nil, errors.New("not an RT_GROUP_ICON resource")
+	}
+	count := int(le.Uint16(src[4:]))
+	if len(src) < _DIR_SIZE+count*_GROUP_ENTRY_SIZE {
+		return nil, errors.New("truncated RT_GROUP_ICON resource")
+	}
+
+	entries := make([]GroupEntry, 0, count)
+	for i := 0; i < count; i++ {
+		raw := src[_DIR_SIZE+i*_GROUP_ENTRY_SIZE:]
+		entries = append(entries, GroupEntry{
+			Width:    sizeFromByte(raw[0]),
+			Height:   sizeFromByte(raw[1]),
+			Colors:   raw[2],
+			Planes:   le.Uint16(raw[4:]),
+			BitCount: le.Uint16(raw[6:]),
+			Size:     le.Uint32(raw[8:]),
+			Id:       le.Uint16(raw[12:]),
+		})
+	}
+	return entries, nil
+}
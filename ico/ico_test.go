@@ -0,0 +1,308 @@
+package ico
+
+import (
+	"encoding/binary"
+	"image"
+	"image/color"
+	"reflect"
+	"testing"
+)
+
+// Colors of the test images, also used as their palette.
+var testColors = []color.NRGBA{
+	{R: 0xff, A: 0xff},
+	{G: 0x80, B: 0xff, A: 0xff},
+	{R: 10, G: 20, B: 30, A: 0xff},
+	{R: 200, G: 200, A: 0xff},
+}
+
+// The AND mask of the test images, one byte per row: pixels (1, 0) and (2, 1)
+// are transparent.
+var testMask = [2]byte{0x40, 0x20}
+
+// Builds a bottom-up DIB of 3x2 pixels with the given bits per pixel, followed
+// by the AND mask, and returns it along with the image it should decode to.
+// The alpha channel of 32 bpp DIBs is left empty, so the mask is used.
+func testDib(bitCount int) ([]byte, *image.NRGBA) {
+	const width, height = 3, 2
+	nColors := len(testColors)
+	var palette []color.NRGBA
+	if bitCount <= 8 {
+		if nColors > 1<<bitCount {
+			nColors = 1 << bitCount
+		}
+		palette = testColors[:nColors]
+	}
+
+	le := binary.LittleEndian
+	buf := le.AppendUint32(nil, _BITMAPINFOHEADER_SIZE)
+	buf = le.AppendUint32(buf, width)
+	buf = le.AppendUint32(buf, height*2)
+	buf = le.AppendUint16(buf, 1) // biPlanes
+	buf = le.AppendUint16(buf, uint16(bitCount))
+	buf = le.AppendUint32(buf, _BI_RGB)
+	buf = le.AppendUint32(buf, 0)                    // biSizeImage
+	buf = append(buf, make([]byte, 8)...)            // resolution
+	buf = le.AppendUint32(buf, uint32(len(palette))) // biClrUsed
+	buf = le.AppendUint32(buf, 0)                    // biClrImportant
+	for _, c := range palette {
+		buf = append(buf, c.B, c.G, c.R, 0)
+	}
+
+	want := image.NewNRGBA(image.Rect(0, 0, width, height))
+	xorStride := (width*bitCount + 31) / 32 * 4
+	for y := height - 1; y >= 0; y-- {
+		row := make([]byte, xorStride)
+		for x := 0; x < width; x++ {
+			idx := (x + y*width) % nColors
+			c := testColors[idx]
+			switch bitCount {
+			case 1, 4, 8:
+				bitPos := x * bitCount
+				row[bitPos/8] |= byte(idx << (8 - bitCount - bitPos%8))
+			case 24:
+				copy(row[x*3:], []byte{c.B, c.G, c.R})
+			case 32:
+				copy(row[x*4:], []byte{c.B, c.G, c.R, 0})
+			}
+			if testMask[y]&(0x80>>x) != 0 {
+				c.A = 0
+			}
+			want.SetNRGBA(x, y, c)
+		}
+		buf = append(buf, row...)
+	}
+	for y := height - 1; y >= 0; y-- {
+		buf = append(buf, testMask[y], 0, 0, 0)
+	}
+	return buf, want
+}
+
+func TestDibRoundTrip(t *testing.T) {
+	for _, bitCount := range []int{1, 4, 8, 24, 32} {
+		dib, want := testDib(bitCount)
+		file := &File{
+			Type: TYPE_ICON,
+			Entries: []Entry{
+				{Width: 3, Height: 2, Planes: 1, BitCount: uint16(bitCount), Data: dib},
+			},
+		}
+
+		parsed, err := Parse(file.Bytes())
+		if err != nil {
+			t.Fatalf("%d bpp: Parse: %v", bitCount, err)
+		}
+		if !reflect.DeepEqual(parsed, file) {
+			t.Errorf("%d bpp: got  %+v\nwant %+v", bitCount, parsed, file)
+		}
+
+		img, err := parsed.Entries[0].Image()
+		if err != nil {
+			t.Fatalf("%d bpp: Image: %v", bitCount, err)
+		}
+		if !reflect.DeepEqual(img, want) {
+			t.Errorf("%d bpp: got  %v\nwant %v", bitCount, img.(*image.NRGBA).Pix, want.Pix)
+		}
+
+		entry, err := NewEntry(img)
+		if err != nil {
+			t.Fatalf("%d bpp: NewEntry: %v", bitCount, err)
+		}
+		if entry.IsPng() || entry.Width != 3 || entry.Height != 2 || entry.BitCount != 32 {
+			t.Errorf("%d bpp: bad entry %+v", bitCount, entry)
+		}
+		if again, err := entry.Image(); err != nil || !reflect.DeepEqual(again, want) {
+			t.Errorf("%d bpp: re-encoded image differs: %v", bitCount, err)
+		}
+	}
+}
+
+func TestDibAlpha(t *testing.T) {
+	dib, want := testDib(32)
+	for i := _BITMAPINFOHEADER_SIZE + 3; i < len(dib)-8; i += 4 {
+		dib[i] = 0x80 // alpha channel takes precedence over the AND mask
+	}
+	for i := 3; i < len(want.Pix); i += 4 {
+		want.Pix[i] = 0x80
+	}
+
+	entry := Entry{Width: 3, Height: 2, Data: dib}
+	if img, err := entry.Image(); err != nil || !reflect.DeepEqual(img, want) {
+		t.Errorf("got %v %v, want %v", img, err, want)
+	}
+}
+
+func TestPng(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 256, 256))
+	for i := range src.Pix {
+		src.Pix[i] = uint8(i % 251)
+	}
+
+	entry, err := NewEntry(src)
+	if err != nil {
+		t.Fatalf("NewEntry: %v", err)
+	}
+	if !entry.IsPng() || entry.Width != 256 || entry.Height != 256 {
+		t.Fatalf("bad entry %dx%d, PNG %v", entry.Width, entry.Height, entry.IsPng())
+	}
+
+	file := &File{Type: TYPE_ICON, Entries: []Entry{entry}}
+	parsed, err := Parse(file.Bytes())
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, file) {
+		t.Errorf("parsed file differs")
+	}
+
+	img, err := parsed.Entries[0].Image()
+	if err != nil {
+		t.Fatalf("Image: %v", err)
+	}
+	for y := 0; y < 256; y++ {
+		for x := 0; x < 256; x++ {
+			got := color.NRGBAModel.Convert(img.At(x, y))
+			if want := src.NRGBAAt(x, y); got != want {
+				t.Fatalf("pixel (%d, %d) = %v, want %v", x, y, got, want)
+			}
+		}
+	}
+
+	if _, err := NewEntry(image.NewNRGBA(image.Rect(0, 0, 257, 16))); err == nil {
+		t.Errorf("257x16: expected error")
+	}
+}
+
+func TestCursor(t *testing.T) {
+	file := &File{
+		Type: TYPE_CURSOR,
+		Entries: []Entry{
+			{Width: 32, Height: 32, HotspotX: 5, HotspotY: 7, Data: []byte{1, 2, 3}},
+			{Width: 256, Height: 256, HotspotX: 100, HotspotY: 200, Data: []byte{4, 5}},
+		},
+	}
+
+	data := file.Bytes()
+	if len(data) != _DIR_SIZE+2*_DIR_ENTRY_SIZE+5 {
+		t.Errorf("%d bytes", len(data))
+	}
+	parsed, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, file) {
+		t.Errorf("got  %+v\nwant %+v", parsed, file)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	valid := (&File{Type: TYPE_ICON, Entries: []Entry{{Width: 16, Height: 16, Data: []byte{1, 2, 3}}}}).Bytes()
+
+	badType := append([]byte(nil), valid...)
+	badType[2] = 3
+
+	outOfBounds := append([]byte(nil), valid...)
+	binary.LittleEndian.PutUint32(outOfBounds[_DIR_SIZE+12:], 0xffff_fff0)
+
+	for name, data := range map[string][]byte{
+		"empty":           nil,
+		"not an icon":     {1, 0, 1, 0, 0, 0},
+		"bad type":        badType,
+		"truncated dir":   valid[:_DIR_SIZE+8],
+		"truncated data":  valid[:len(valid)-1],
+		"out of bounds":   outOfBounds,
+		"truncated image": valid[:_DIR_SIZE+_DIR_ENTRY_SIZE],
+	} {
+		if _, err := Parse(data); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestGroup(t *testing.T) {
+	dib, _ := testDib(8)
+	png := append(append([]byte(nil), _PNG_SIGNATURE...), 0, 0, 0, 0)
+	file := &File{
+		Type: TYPE_ICON,
+		Entries: []Entry{
+			{Width: 3, Height: 2, Colors: 4, Data: dib},                             // Planes and BitCount not set
+			{Width: 256, Height: 256, Planes: 1, Data: png},                         // PNG without BitCount
+			{Width: 48, Height: 48, Planes: 1, BitCount: 24, Data: []byte{1, 2, 3}}, // as written
+		},
+	}
+
+	entries, err := ParseGroup(file.GroupBytes([]uint16{7, 8, 9}))
+	if err != nil {
+		t.Fatalf("ParseGroup: %v", err)
+	}
+	want := []GroupEntry{
+		{Width: 3, Height: 2, Colors: 4, Planes: 1, BitCount: 8, Size: uint32(len(dib)), Id: 7},
+		{Width: 256, Height: 256, Planes: 1, BitCount: 32, Size: uint32(len(png)), Id: 8},
+		{Width: 48, Height: 48, Planes: 1, BitCount: 24, Size: 3, Id: 9},
+	}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("got  %+v\nwant %+v", entries, want)
+	}
+
+	for name, data := range map[string][]byte{
+		"cursor":    {0, 0, 2, 0, 0, 0},
+		"truncated": file.GroupBytes([]uint16{7, 8, 9})[:_DIR_SIZE+2*_GROUP_ENTRY_SIZE],
+	} {
+		if _, err := ParseGroup(data); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+
+	for name, fn := range map[string]func(){
+		"cursor file":    func() { (&File{Type: TYPE_CURSOR}).GroupBytes(nil) },
+		"IDs mismatched": func() { file.GroupBytes([]uint16{1}) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected panic", name)
+				}
+			}()
+			fn()
+		}()
+	}
+}
+
+func TestBest(t *testing.T) {
+	png := append([]byte(nil), _PNG_SIGNATURE...)
+	file := &File{
+		Type: TYPE_ICON,
+		Entries: []Entry{
+			{Width: 16, Height: 16, BitCount: 8},
+			{Width: 32, Height: 32, BitCount: 4},
+			{Width: 32, Height: 32, BitCount: 32},
+			{Width: 48, Height: 48, BitCount: 32},
+			{Width: 256, Height: 256, BitCount: 8},
+			{Width: 256, Height: 256, Data: png}, // 32 bpp, read from the data
+		},
+	}
+
+	for _, tt := range []struct {
+		width, height int
+		want          int
+	}{
+		{16, 16, 0},   // exact size
+		{32, 32, 2},   // exact size, more bits per pixel
+		{24, 24, 2},   // smallest larger one
+		{40, 40, 3},   // smallest larger one
+		{64, 64, 5},   // smallest larger one, PNG
+		{256, 256, 5}, // exact size, PNG
+		{300, 300, 5}, // largest one
+		{24, 48, 3},   // smallest one larger in both dimensions
+		{8, 8, 0},     // smallest larger one
+	} {
+		got := file.Best(tt.width, tt.height)
+		if got != &file.Entries[tt.want] {
+			t.Errorf("Best(%d, %d) = %+v, want entry %d", tt.width, tt.height, got, tt.want)
+		}
+	}
+
+	if best := (&File{Type: TYPE_ICON}).Best(32, 32); best != nil {
+		t.Errorf("empty file: got %+v", best)
+	}
+}
@@ -0,0 +1,239 @@
+package ico
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+)
+
+const (
+	_BITMAPINFOHEADER_SIZE = 40
+	_BI_RGB                = 0
+)
+
+// Creates a File of the given type with one entry for each image, encoded
+// with NewEntry(). The hotspots of cursor entries are set to zero.
+//
+// # Example
+//
+//	img16, _ := png.Decode(bytes.NewReader(data16))
+//	img256, _ := png.Decode(bytes.NewReader(data256))
+//	file, _ := ico.FromImages(ico.TYPE_ICON, img16, img256)
+//	os.WriteFile("C:\\Temp\\app.ico", file.Bytes(), 0o644)
+func FromImages(ty TYPE, imgs ...image.Image) (*File, error) {
+	file := &File{Type: ty, Entries: make([]Entry, 0, len(imgs))}
+	for i, img := range imgs {
+		entry, err := NewEntry(img)
+		if err != nil {
+			return nil, fmt.Errorf("image %d: %w", i, err)
+		}
+		file.Entries = append(file.Entries, entry)
+	}
+	return file, nil
+}
+
+// Encodes an image as an Entry with 32 bits per pixel. Images of 256 pixels
+// are compressed as PNG, like Windows does; smaller ones are stored as a DIB
+// followed by the AND mask, so they can be read by older software.
+//
+// Returns an error if the image is larger than 256x256.
+func NewEntry(img image.Image) (Entry, error) {
+	bounds := img.Bounds()
+	if bounds.Dx() < 1 || bounds.Dy() < 1 || bounds.Dx() > 256 || bounds.Dy() > 256 {
+		return Entry{}, fmt.Errorf("invalid image size %dx%d, must be up to 256x256",
+			bounds.Dx(), bounds.Dy())
+	}
+
+	entry := Entry{
+		Width:    bounds.Dx(),
+		Height:   bounds.Dy(),
+		Planes:   1,
+		BitCount: 32,
+	}
+	if entry.Width == 256 || entry.Height == 256 {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, img); err != nil {
+			return Entry{}, err
+		}
+		entry.Data = buf.Bytes()
+	} else {
+		entry.Data = encodeDib(img)
+	}
+	return entry, nil
+}
+
+// Decodes the image data, either PNG or DIB. DIBs with 1, 4, 8, 16, 24 and
+// 32 bits per pixel are supported; their AND mask is applied as the alpha
+// channel, unless the DIB has 32 bits per pixel with a non-empty alpha
+// channel. DIBs are returned as *image.NRGBA.
+//
+// # Example
+//
+//	img, _ := file.Entries[0].Image()
+//	fout, _ := os.Create("C:\\Temp\\entry.png")
+//	defer fout.Close()
+//	png.Encode(fout, img)
+func (e *Entry) Image() (image.Image, error) {
+	if e.IsPng() {
+		return png.Decode(bytes.NewReader(e.Data))
+	}
+	return decodeDib(e.Data)
+}
+
+func decodeDib(src []byte) (*image.NRGBA, error) {
+	le := binary.LittleEndian
+	if len(src) < _BITMAPINFOHEADER_SIZE {
+		return nil, errors.New("truncated BITMAPINFOHEADER")
+	}
+	headerSize := int(le.Uint32(src[0:]))
+	width := int(int32(le.Uint32(src[4:])))
+	height := int(int32(le.Uint32(src[8:])))
+	bitCount := int(le.Uint16(src[14:]))
+	compression := le.Uint32(src[16:])
+	clrUsed := int(le.Uint32(src[32:]))
+
+	if headerSize < _BITMAPINFOHEADER_SIZE || headerSize > len(src) {
+		return nil, fmt.Errorf("invalid BITMAPINFOHEADER size %d", headerSize)
+	} else if compression != _BI_RGB {
+		return nil, fmt.Errorf("unsupported DIB compression %d", compression)
+	}
+
+	bottomUp := height > 0
+	if !bottomUp {
+		height = -height
+	}
+	height /= 2 // the height includes the AND mask
+	if width < 1 || height < 1 || width > 1024 || height > 1024 {
+		return nil, fmt.Errorf("invalid DIB size %dx%d", width, height)
+	}
+
+	var palette []color.NRGBA
+	switch bitCount {
+	case 1, 4, 8:
+		if clrUsed == 0 || clrUsed > 1<<bitCount {
+			clrUsed = 1 << bitCount
+		}
+		pos := headerSize
+		if pos+clrUsed*4 > len(src) {
+			return nil, errors.New("truncated DIB palette")
+		}
+		palette = make([]color.NRGBA, clrUsed)
+		for i := range palette {
+			quad := src[pos+i*4:]
+			palette[i] = color.NRGBA{R: quad[2], G: quad[1], B: quad[0], A: 0xff}
+		}
+	case 16, 24, 32:
+	default:
+		return nil, fmt.Errorf("unsupported DIB bit count %d", bitCount)
+	}
+
+	xorStride := (width*bitCount + 31) / 32 * 4
+	andStride := (width + 31) / 32 * 4
+	xorPos := headerSize + len(palette)*4
+	andPos := xorPos + xorStride*height
+	if andPos > len(src) {
+		return nil, errors.New("truncated DIB pixels")
+	}
+	hasMask := andPos+andStride*height <= len(src) // some 32 bpp images omit the mask
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	hasAlpha := false
+	for y := 0; y < height; y++ {
+		srcY := y
+		if bottomUp {
+			srcY = height - 1 - y
+		}
+		row := src[xorPos+srcY*xorStride:]
+		for x := 0; x < width; x++ {
+			var c color.NRGBA
+			switch bitCount {
+			case 1, 4, 8:
+				bitPos := x * bitCount
+				idx := int(row[bitPos/8]>>(8-bitCount-bitPos%8)) & (1<<bitCount - 1)
+				if idx < len(palette) {
+					c = palette[idx]
+				}
+			case 16: // 5-5-5
+				px := le.Uint16(row[x*2:])
+				c = color.NRGBA{
+					R: uint8(uint32(px>>10&0x1f) * 255 / 31),
+					G: uint8(uint32(px>>5&0x1f) * 255 / 31),
+					B: uint8(uint32(px&0x1f) * 255 / 31),
+					A: 0xff,
+				}
+			case 24:
+				c = color.NRGBA{R: row[x*3+2], G: row[x*3+1], B: row[x*3], A: 0xff}
+			case 32:
+				c = color.NRGBA{R: row[x*4+2], G: row[x*4+1], B: row[x*4], A: row[x*4+3]}
+				hasAlpha = hasAlpha || c.A != 0
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+
+	if bitCount == 32 && hasAlpha {
+		return img, nil // alpha channel takes precedence over the AND mask
+	}
+	for y := 0; y < height; y++ {
+		srcY := y
+		if bottomUp {
+			srcY = height - 1 - y
+		}
+		for x := 0; x < width; x++ {
+			transparent := hasMask &&
+				src[andPos+srcY*andStride+x/8]&(0x80>>(x%8)) != 0
+			off := img.PixOffset(x, y)
+			if transparent {
+				img.Pix[off+3] = 0
+			} else {
+				img.Pix[off+3] = 0xff
+			}
+		}
+	}
+	return img, nil
+}
+
+// Encodes the image as a bottom-up 32 bpp DIB, followed by the AND mask,
+// where fully transparent pixels are set.
+func encodeDib(img image.Image) []byte {
+	bounds := img.Bounds()
+	nrgba := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(nrgba, nrgba.Bounds(), img, bounds.Min, draw.Src)
+
+	width, height := nrgba.Rect.Dx(), nrgba.Rect.Dy()
+	xorStride := width * 4
+	andStride := (width + 31) / 32 * 4
+
+	le := binary.LittleEndian
+	buf := make([]byte, 0, _BITMAPINFOHEADER_SIZE+(xorStride+andStride)*height)
+	buf = le.AppendUint32(buf, _BITMAPINFOHEADER_SIZE)
+	buf = le.AppendUint32(buf, uint32(width))
+	buf = le.AppendUint32(buf, uint32(height*2)) // XOR and AND masks
+	buf = le.AppendUint16(buf, 1)                // biPlanes
+	buf = le.AppendUint16(buf, 32)               // biBitCount
+	buf = le.AppendUint32(buf, _BI_RGB)
+	buf = le.AppendUint32(buf, uint32((xorStride+andStride)*height)) // biSizeImage
+	buf = append(buf, make([]byte, 16)...)                           // resolution and colors
+
+	for y := height - 1; y >= 0; y-- {
+		for x := 0; x < width; x++ {
+			c := nrgba.NRGBAAt(x, y)
+			buf = append(buf, c.B, c.G, c.R, c.A)
+		}
+	}
+	for y := height - 1; y >= 0; y-- {
+		row := make([]byte, andStride)
+		for x := 0; x < width; x++ {
+			if nrgba.NRGBAAt(x, y).A == 0 {
+				row[x/8] |= 0x80 >> (x % 8)
+			}
+		}
+		buf = append(buf, row...)
+	}
+	return buf
+}
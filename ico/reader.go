@@ -0,0 +1,65 @@
+package ico
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	_DIR_SIZE       = 6  // sizeof(ICONDIR)
+	_DIR_ENTRY_SIZE = 16 // sizeof(ICONDIRENTRY)
+)
+
+// Reads all the .ico or .cur content from r and parses it.
+func Read(r io.Reader) (*File, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(src)
+}
+
+// Parses an .ico or .cur file. The image data of each entry is kept as it is,
+// either DIB or PNG.
+func Parse(src []byte) (*File, error) {
+	le := binary.LittleEndian
+	if len(src) < _DIR_SIZE || le.Uint16(src[0:]) != 0 {
+		return nil, errors.New("not an .ico or .cur file")
+	}
+	ty := TYPE(le.Uint16(src[2:]))
+	if ty != TYPE_ICON && ty != TYPE_CURSOR {
+		return nil, fmt.Errorf("invalid .ico or .cur type %d", ty)
+	}
+	count := int(le.Uint16(src[4:]))
+	if len(src) < _DIR_SIZE+count*_DIR_ENTRY_SIZE {
+		return nil, errors.New("truncated .ico or .cur directory")
+	}
+
+	file := &File{Type: ty, Entries: make([]Entry, 0, count)}
+	for i := 0; i < count; i++ {
+		raw := src[_DIR_SIZE+i*_DIR_ENTRY_SIZE:]
+		size := int(le.Uint32(raw[8:]))
+		offset := int(le.Uint32(raw[12:]))
+		if offset < 0 || size < 0 || offset+size > len(src) || offset+size < offset {
+			return nil, fmt.Errorf("entry %d is out of bounds", i)
+		}
+
+		entry := Entry{
+			Width:  sizeFromByte(raw[0]),
+			Height: sizeFromByte(raw[1]),
+			Colors: raw[2],
+			Data:   append([]byte{}, src[offset:offset+size]...),
+		}
+		if ty == TYPE_ICON {
+			entry.Planes = le.Uint16(raw[4:])
+			entry.BitCount = le.Uint16(raw[6:])
+		} else {
+			entry.HotspotX = le.Uint16(raw[4:])
+			entry.HotspotY = le.Uint16(raw[6:])
+		}
+		file.Entries = append(file.Entries, entry)
+	}
+	return file, nil
+}
@@ -0,0 +1,48 @@
+package ico
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// Serializes the file, returning the bytes.
+func (f *File) Bytes() []byte {
+	var buf bytes.Buffer
+	f.WriteTo(&buf)
+	return buf.Bytes()
+}
+
+// Serializes the file, writing it to w. The image data is written right after
+// the directory, in the same order of the entries.
+//
+// Implements io.WriterTo.
+func (f *File) WriteTo(w io.Writer) (int64, error) {
+	le := binary.LittleEndian
+	buf := make([]byte, 0, _DIR_SIZE+len(f.Entries)*_DIR_ENTRY_SIZE)
+	buf = le.AppendUint16(buf, 0)
+	buf = le.AppendUint16(buf, uint16(f.Type))
+	buf = le.AppendUint16(buf, uint16(len(f.Entries)))
+
+	offset := _DIR_SIZE + len(f.Entries)*_DIR_ENTRY_SIZE
+	for i := range f.Entries {
+		entry := &f.Entries[i]
+		buf = append(buf, sizeByte(entry.Width), sizeByte(entry.Height), entry.Colors, 0)
+		if f.Type == TYPE_CURSOR {
+			buf = le.AppendUint16(buf, entry.HotspotX)
+			buf = le.AppendUint16(buf, entry.HotspotY)
+		} else {
+			buf = le.AppendUint16(buf, entry.Planes)
+			buf = le.AppendUint16(buf, entry.BitCount)
+		}
+		buf = le.AppendUint32(buf, uint32(len(entry.Data)))
+		buf = le.AppendUint32(buf, uint32(offset))
+		offset += len(entry.Data)
+	}
+	for i := range f.Entries {
+		buf = append(buf, f.Entries[i].Data...)
+	}
+
+	n, err := w.Write(buf)
+	return int64(n), err
+}
@@ -0,0 +1,309 @@
+package ini
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// An .ini file, made of sections with their keys.
+//
+// Comments, blank lines, key order and the original encoding are kept, so a
+// parsed file is written back exactly as it was read, except for what has been
+// modified.
+//
+// # Example
+//
+//	file, _ := ini.Parse(data, ini.DUPLICATE_KEEP)
+//	sec := file.AddSection("Window")
+//	sec.SetInt("Width", 800)
+//	sec.SetBool("Maximized", false)
+//	data = file.Bytes()
+type File struct {
+	Sections  []Section // Sections in file order; keys before the first [section] header belong to a first section with an empty name.
+	Trailing  []string  // Comments and blank lines after the last key, kept verbatim.
+	Encoding  ENCODING  // Text encoding used when writing the file.
+	LineBreak string    // Line break used when writing the file; if empty, "\r\n" is used.
+
+	noFinalBreak bool // the source had no line break after the last line
+}
+
+// A section of a File.
+type Section struct {
+	Comments []string // Comments and blank lines above the [section] header, kept verbatim.
+	Name     string   // Name of the section, without the brackets.
+	Values   []Key    // Keys of the section, in file order.
+
+	raw     string // original header line
+	rawName string // name when parsed, to tell if it was modified
+}
+
+// A key of a Section.
+type Key struct {
+	Comments []string // Comments, blank lines and lines without '=' above the key, kept verbatim.
+	Name     string   // Name of the key, at the left of '='.
+	Value    string   // Value of the key, at the right of '='.
+
+	raw      string // original key line
+	rawName  string // name when parsed, to tell if it was modified
+	rawValue string // value when parsed, to tell if it was modified
+}
+
+// Text encoding of a File.
+type ENCODING uint8
+
+const (
+	ENCODING_UTF8     ENCODING = iota // UTF-8 without BOM.
+	ENCODING_UTF8_BOM                 // UTF-8 with BOM.
+	ENCODING_UTF16LE                  // UTF-16 little-endian with BOM, written by Windows' WritePrivateProfileString.
+	ENCODING_UTF16BE                  // UTF-16 big-endian with BOM.
+	ENCODING_ANSI                     // Non-UTF-8 text without BOM, read and written as Latin-1.
+)
+
+// How Parse() handles a key which appears more than once in the same section.
+//
+// With DUPLICATE_FIRST and DUPLICATE_LAST, repeated sections are merged into the
+// first one.
+type DUPLICATE uint8
+
+const (
+	DUPLICATE_KEEP  DUPLICATE = iota // All keys and sections are kept; lookups find the first one.
+	DUPLICATE_FIRST                  // The first key is kept; the others are dropped, and their comments are moved above the first one.
+	DUPLICATE_LAST                   // The value of the last key is stored in the first one; the others are dropped, and their comments are moved above the first one.
+	DUPLICATE_ERROR                  // Parse() fails on repeated keys and sections.
+)
+
+// Returns the first section with the given name, if any.
+func (f *File) Section(name string) (*Section, bool) {
+	for i := range f.Sections {
+		if f.Sections[i].Name == name {
+			return &f.Sections[i], true
+		}
+	}
+	return nil, false
+}
+
+// Returns the first section with the given name, appending a new one if it
+// doesn't exist.
+//
+// Note that the returned pointer is invalidated when another section is added.
+func (f *File) AddSection(name string) *Section {
+	if sec, ok := f.Section(name); ok {
+		return sec
+	}
+	sec := Section{Name: name}
+	if len(f.Sections) > 0 {
+		sec.Comments = []string{""} // blank line before the header
+	}
+	f.Sections = append(f.Sections, sec)
+	return &f.Sections[len(f.Sections)-1]
+}
+
+// Removes all the sections with the given name, returning true if any was
+// found.
+func (f *File) RemoveSection(name string) bool {
+	found := false
+	kept := f.Sections[:0]
+	for _, sec := range f.Sections {
+		if sec.Name == name {
+			found = true
+		} else {
+			kept = append(kept, sec)
+		}
+	}
+	f.Sections = kept
+	return found
+}
+
+// Returns the value of the given key, if existing.
+//
+// Note that a pointer to the string is returned, so that the value can be
+// directly modified.
+//
+// # Example
+//
+//	if val, ok := file.Value("my section", "my key"); ok {
+//		fmt.Printf("Old value: %s\n", *val)
+//		*val = "new value"
+//	}
+func (f *File) Value(sectionName, keyName string) (*string, bool) {
+	if sec, ok := f.Section(sectionName); ok {
+		if key, ok := sec.Value(keyName); ok {
+			return &key.Value, true
+		}
+	}
+	return nil, false
+}
+
+// Returns the first key with the given name, if any.
+func (s *Section) Value(name string) (*Key, bool) {
+	for i := range s.Values {
+		if s.Values[i].Name == name {
+			return &s.Values[i], true
+		}
+	}
+	return nil, false
+}
+
+// Returns the value of the first key with the given name, if any.
+func (s *Section) Get(name string) (string, bool) {
+	if key, ok := s.Value(name); ok {
+		return key.Value, true
+	}
+	return "", false
+}
+
+// Sets the value of the first key with the given name, appending a new key if
+// it doesn't exist.
+func (s *Section) Set(name, value string) {
+	if key, ok := s.Value(name); ok {
+		key.Value = value
+	} else {
+		s.Values = append(s.Values, Key{Name: name, Value: value})
+	}
+}
+
+// Removes all the keys with the given name, returning true if any was found.
+//
+// The comments above the removed keys are removed too.
+func (s *Section) Remove(name string) bool {
+	found := false
+	kept := s.Values[:0]
+	for _, key := range s.Values {
+		if key.Name == name {
+			found = true
+		} else {
+			kept = append(kept, key)
+		}
+	}
+	s.Values = kept
+	return found
+}
+
+// Returns the value parsed as a boolean, which can be 1/0, true/false, yes/no
+// or on/off, case-insensitive; false if the key doesn't exist or can't be
+// parsed.
+func (s *Section) Bool(name string) (bool, bool) {
+	val, ok := s.Get(name)
+	if !ok {
+		return false, false
+	}
+	return parseBool(val)
+}
+
+// Returns the value parsed with [time.ParseDuration], like "1m30s"; false if
+// the key doesn't exist or can't be parsed.
+func (s *Section) Duration(name string) (time.Duration, bool) {
+	val, ok := s.Get(name)
+	if !ok {
+		return 0, false
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(val))
+	return d, err == nil
+}
+
+// Returns the value parsed as a float; false if the key doesn't exist or can't
+// be parsed.
+func (s *Section) Float(name string) (float64, bool) {
+	val, ok := s.Get(name)
+	if !ok {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+	return f, err == nil
+}
+
+// Returns the value parsed as a decimal integer, or hexadecimal with the 0x
+// prefix; false if the key doesn't exist or can't be parsed.
+func (s *Section) Int(name string) (int, bool) {
+	val, ok := s.Get(name)
+	if !ok {
+		return 0, false
+	}
+	n, err := parseInt(val, strconv.IntSize)
+	return int(n), err == nil
+}
+
+// Returns the value split at the commas, with each item trimmed; false if the
+// key doesn't exist. An empty value returns an empty slice.
+func (s *Section) List(name string) ([]string, bool) {
+	val, ok := s.Get(name)
+	if !ok {
+		return nil, false
+	}
+	return splitList(val), true
+}
+
+// Sets the value as 1 or 0.
+func (s *Section) SetBool(name string, b bool) {
+	s.Set(name, formatBool(b))
+}
+
+// Sets the value formatted with [time.Duration.String], like "1m30s".
+func (s *Section) SetDuration(name string, d time.Duration) {
+	s.Set(name, d.String())
+}
+
+// Sets the value as a float, with the minimum number of digits.
+func (s *Section) SetFloat(name string, f float64) {
+	s.Set(name, strconv.FormatFloat(f, 'g', -1, 64))
+}
+
+// Sets the value as a decimal integer.
+func (s *Section) SetInt(name string, n int) {
+	s.Set(name, strconv.Itoa(n))
+}
+
+// Sets the value as the items joined by commas. The items must not contain
+// commas.
+func (s *Section) SetList(name string, items []string) {
+	s.Set(name, joinList(items))
+}
+
+func parseBool(s string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "1", "true", "yes", "on":
+		return true, true
+	case "0", "false", "no", "off":
+		return false, true
+	}
+	return false, false
+}
+
+func parseInt(s string, bitSize int) (int64, error) {
+	s = strings.TrimSpace(s)
+	if hex, ok := strings.CutPrefix(s, "0x"); ok {
+		return strconv.ParseInt(hex, 16, bitSize)
+	}
+	return strconv.ParseInt(s, 10, bitSize)
+}
+
+func parseUint(s string, bitSize int) (uint64, error) {
+	s = strings.TrimSpace(s)
+	if hex, ok := strings.CutPrefix(s, "0x"); ok {
+		return strconv.ParseUint(hex, 16, bitSize)
+	}
+	return strconv.ParseUint(s, 10, bitSize)
+}
+
+func formatBool(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+func splitList(s string) []string {
+	items := make([]string, 0)
+	if strings.TrimSpace(s) == "" {
+		return items
+	}
+	for _, item := range strings.Split(s, ",") {
+		items = append(items, strings.TrimSpace(item))
+	}
+	return items
+}
+
+func joinList(items []string) string {
+	return strings.Join(items, ", ")
+}
@@ -0,0 +1,350 @@
+package ini
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf16"
+)
+
+const sample = "; global comment\r\n" +
+	"top = level\r\n" +
+	"\r\n" +
+	"[Window]\r\n" +
+	"; size in pixels\r\n" +
+	"Width = 800\r\n" +
+	"Height=600\r\n" +
+	"Maximized = yes\r\n" +
+	"not a key\r\n" +
+	"Recent = a.txt,b.txt\r\n" +
+	"\r\n" +
+	"  [ Colors ]  \r\n" +
+	"# hash comment\r\n" +
+	"Back=0xff00ff\r\n" +
+	"\r\n" +
+	"; trailing\r\n"
+
+func encode(text string, enc ENCODING) []byte {
+	switch enc {
+	case ENCODING_UTF8_BOM:
+		return append([]byte{0xef, 0xbb, 0xbf}, text...)
+	case ENCODING_UTF16LE:
+		return encodeUtf16([]byte{0xff, 0xfe}, text, binary.LittleEndian)
+	case ENCODING_UTF16BE:
+		return encodeUtf16([]byte{0xfe, 0xff}, text, binary.BigEndian)
+	case ENCODING_ANSI:
+		return encodeLatin1(text)
+	default:
+		return []byte(text)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		enc  ENCODING
+	}{
+		{"UTF-8", sample, ENCODING_UTF8},
+		{"UTF-8 BOM", sample, ENCODING_UTF8_BOM},
+		{"UTF-16LE", sample, ENCODING_UTF16LE},
+		{"UTF-16BE", sample, ENCODING_UTF16BE},
+		{"ANSI", sample + "[Café]\r\nNome=José\r\n", ENCODING_ANSI},
+		{"LF", strings.ReplaceAll(sample, "\r\n", "\n"), ENCODING_UTF8},
+		{"no final break", "[A]\r\nx=1", ENCODING_UTF8},
+		{"empty", "", ENCODING_UTF8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := encode(tt.text, tt.enc)
+			file, err := Parse(src, DUPLICATE_KEEP)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if file.Encoding != tt.enc {
+				t.Errorf("encoding %d, want %d", file.Encoding, tt.enc)
+			}
+			if got := file.Bytes(); !bytes.Equal(got, src) {
+				t.Errorf("got:\n%q\nwant:\n%q", got, src)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	file, err := Parse([]byte(sample), DUPLICATE_KEEP)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	var names []string
+	for _, sec := range file.Sections {
+		names = append(names, sec.Name)
+	}
+	if want := []string{"", "Window", "Colors"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("sections %q, want %q", names, want)
+	}
+
+	if val, ok := file.Value("", "top"); !ok || *val != "level" {
+		t.Errorf("top: %v", ok)
+	}
+	sec, _ := file.Section("Window")
+	if key, ok := sec.Value("Width"); !ok || key.Value != "800" ||
+		!reflect.DeepEqual(key.Comments, []string{"; size in pixels"}) {
+		t.Errorf("Width: %+v", key)
+	}
+	if key, _ := sec.Value("Recent"); !reflect.DeepEqual(key.Comments, []string{"not a key"}) {
+		t.Errorf("line without '=' not kept as comment: %+v", key.Comments)
+	}
+	if !reflect.DeepEqual(file.Trailing, []string{"", "; trailing"}) {
+		t.Errorf("trailing %q", file.Trailing)
+	}
+}
+
+func TestCaseSensitive(t *testing.T) {
+	file, _ := Parse([]byte(sample), DUPLICATE_KEEP)
+	if _, ok := file.Section("WINDOW"); ok {
+		t.Errorf("section lookup is case-insensitive")
+	}
+	if _, ok := file.Value("Colors", "BACK"); ok {
+		t.Errorf("key lookup is case-insensitive")
+	}
+	if val, ok := file.Value("Colors", "Back"); !ok || *val != "0xff00ff" {
+		t.Errorf("Back: %v", ok)
+	}
+}
+
+func TestModify(t *testing.T) {
+	file, _ := Parse([]byte(sample), DUPLICATE_KEEP)
+
+	sec, _ := file.Section("Window")
+	sec.SetInt("Width", 1024)
+	sec.Set("Height", "600") // same value, line is kept
+	sec.Remove("Recent")
+	sec.SetBool("Visible", true)
+	file.AddSection("New").Set("k", "v")
+	file.RemoveSection("Colors")
+
+	want := "; global comment\r\n" +
+		"top = level\r\n" +
+		"\r\n" +
+		"[Window]\r\n" +
+		"; size in pixels\r\n" +
+		"Width=1024\r\n" +
+		"Height=600\r\n" +
+		"Maximized = yes\r\n" +
+		"Visible=1\r\n" +
+		"\r\n" +
+		"[New]\r\n" +
+		"k=v\r\n" +
+		"\r\n" +
+		"; trailing\r\n"
+	if got := string(file.Bytes()); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestNewFile(t *testing.T) {
+	file := &File{}
+	file.AddSection("A").SetList("List", []string{"x", "y"})
+	file.AddSection("B").SetDuration("Timeout", 90*time.Second)
+
+	want := "[A]\r\nList=x, y\r\n\r\n[B]\r\nTimeout=1m30s\r\n"
+	if got := string(file.Bytes()); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	file.Encoding = ENCODING_UTF16LE
+	chars := utf16.Encode([]rune(want))
+	if got := file.Bytes(); len(got) != 2+len(chars)*2 || got[0] != 0xff || got[1] != 0xfe {
+		t.Errorf("bad UTF-16LE output: % x", got)
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	const src = "[A]\nx=1\n; second\nx=2\n[B]\ny=1\n[A]\nz=3\n"
+	tests := []struct {
+		dup      DUPLICATE
+		sections int
+		x        string
+		out      string
+	}{
+		{DUPLICATE_KEEP, 3, "1", src},
+		{DUPLICATE_FIRST, 2, "1", "[A]\n; second\nx=1\nz=3\n[B]\ny=1\n"},
+		{DUPLICATE_LAST, 2, "2", "[A]\n; second\nx=2\nz=3\n[B]\ny=1\n"},
+	}
+
+	for _, tt := range tests {
+		file, err := Parse([]byte(src), tt.dup)
+		if err != nil {
+			t.Fatalf("dup %d: %v", tt.dup, err)
+		}
+		if len(file.Sections) != tt.sections {
+			t.Errorf("dup %d: %d sections, want %d", tt.dup, len(file.Sections), tt.sections)
+		}
+		if x, _ := file.Value("A", "x"); *x != tt.x {
+			t.Errorf("dup %d: x=%s, want %s", tt.dup, *x, tt.x)
+		}
+		if got := string(file.Bytes()); got != tt.out {
+			t.Errorf("dup %d: got %q, want %q", tt.dup, got, tt.out)
+		}
+	}
+
+	if _, err := Parse([]byte("[A]\nx=1\nx=2\n"), DUPLICATE_ERROR); err == nil {
+		t.Errorf("repeated key: expected error")
+	}
+	if _, err := Parse([]byte("[A]\n[A]\n"), DUPLICATE_ERROR); err == nil {
+		t.Errorf("repeated section: expected error")
+	}
+}
+
+func TestTypedGetters(t *testing.T) {
+	file, _ := Parse([]byte("[S]\n"+
+		"b1=Yes\nb2=off\nb3=maybe\n"+
+		"i1= -42 \ni2=0x1F\ni3=abc\n"+
+		"f=2.5\nd=1h2m\n"+
+		"l1=a, b ,c\nl2=\n"), DUPLICATE_KEEP)
+	sec, _ := file.Section("S")
+
+	if b, ok := sec.Bool("b1"); !ok || !b {
+		t.Errorf("b1: %v %v", b, ok)
+	}
+	if b, ok := sec.Bool("b2"); !ok || b {
+		t.Errorf("b2: %v %v", b, ok)
+	}
+	if _, ok := sec.Bool("b3"); ok {
+		t.Errorf("b3: expected failure")
+	}
+	if n, ok := sec.Int("i1"); !ok || n != -42 {
+		t.Errorf("i1: %d %v", n, ok)
+	}
+	if n, ok := sec.Int("i2"); !ok || n != 31 {
+		t.Errorf("i2: %d %v", n, ok)
+	}
+	if _, ok := sec.Int("i3"); ok {
+		t.Errorf("i3: expected failure")
+	}
+	if _, ok := sec.Int("missing"); ok {
+		t.Errorf("missing: expected failure")
+	}
+	if f, ok := sec.Float("f"); !ok || f != 2.5 {
+		t.Errorf("f: %v %v", f, ok)
+	}
+	if d, ok := sec.Duration("d"); !ok || d != time.Hour+2*time.Minute {
+		t.Errorf("d: %v %v", d, ok)
+	}
+	if l, ok := sec.List("l1"); !ok || !reflect.DeepEqual(l, []string{"a", "b", "c"}) {
+		t.Errorf("l1: %q %v", l, ok)
+	}
+	if l, ok := sec.List("l2"); !ok || l == nil || len(l) != 0 {
+		t.Errorf("l2: %q %v", l, ok)
+	}
+}
+
+type window struct {
+	Width    int
+	Height   uint16
+	Scale    float64
+	Title    string
+	Visible  bool
+	Recent   []string `ini:"RecentFiles"`
+	Autosave time.Duration
+	Ignored  int `ini:"-"`
+	private  int
+}
+
+func TestLoadStruct(t *testing.T) {
+	file, _ := Parse([]byte("[Window]\n"+
+		"Width=1024\nHeight=0x300\nScale=1.25\nTitle=My App\n"+
+		"Visible=yes\nRecentFiles=a.txt, b.txt\nAutosave=5m\nIgnored=9\n"), DUPLICATE_KEEP)
+	sec, _ := file.Section("Window")
+
+	w := window{Width: 1, Title: "default", Ignored: 7}
+	if err := sec.LoadStruct(&w); err != nil {
+		t.Fatalf("LoadStruct: %v", err)
+	}
+	want := window{
+		Width:    1024,
+		Height:   0x300,
+		Scale:    1.25,
+		Title:    "My App",
+		Visible:  true,
+		Recent:   []string{"a.txt", "b.txt"},
+		Autosave: 5 * time.Minute,
+		Ignored:  7,
+	}
+	if !reflect.DeepEqual(w, want) {
+		t.Errorf("got  %+v\nwant %+v", w, want)
+	}
+
+	defaults := window{Width: 640}
+	empty := &Section{}
+	if err := empty.LoadStruct(&defaults); err != nil || defaults.Width != 640 {
+		t.Errorf("missing keys changed the defaults: %+v %v", defaults, err)
+	}
+
+	for _, src := range []string{"[W]\nWidth=x\n", "[W]\nHeight=70000\n", "[W]\nVisible=2\n"} {
+		file, _ := Parse([]byte(src), DUPLICATE_KEEP)
+		if err := file.Sections[0].LoadStruct(&window{}); err == nil {
+			t.Errorf("%q: expected error", src)
+		}
+	}
+}
+
+func TestSaveStruct(t *testing.T) {
+	const src = "[Window]\n" +
+		"Width = 800\n" +
+		"Height=600\n" +
+		"Scale=1.5\n" +
+		"Title=\n" +
+		"Visible = yes\n" +
+		"RecentFiles=a.txt,b.txt\n" +
+		"Autosave=300s\n"
+	file, _ := Parse([]byte(src), DUPLICATE_KEEP)
+	sec, _ := file.Section("Window")
+
+	w := window{}
+	sec.LoadStruct(&w)
+	sec.SaveStruct(&w)
+	if got := string(file.Bytes()); got != src {
+		t.Errorf("unchanged fields were rewritten:\n%s", got)
+	}
+
+	w.Width = 1024
+	w.Visible = false
+	w.Title = "App"
+	sec.SaveStruct(&w)
+	want := "[Window]\n" +
+		"Width=1024\n" +
+		"Height=600\n" +
+		"Scale=1.5\n" +
+		"Title=App\n" +
+		"Visible=0\n" +
+		"RecentFiles=a.txt,b.txt\n" +
+		"Autosave=300s\n"
+	if got := string(file.Bytes()); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestStructPanics(t *testing.T) {
+	for _, dest := range []interface{}{
+		window{},
+		new(int),
+		&struct{ M map[string]int }{},
+		&struct{ S []int }{},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%T: expected panic", dest)
+				}
+			}()
+			(&Section{}).LoadStruct(dest)
+		}()
+	}
+}
@@ -0,0 +1,180 @@
+package ini
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Reads all the .ini content from r and parses it.
+func Read(r io.Reader, dup DUPLICATE) (*File, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(src, dup)
+}
+
+// Parses an .ini file, encoded as UTF-16 or UTF-8 with BOM, or as UTF-8 or ANSI
+// without BOM. The encoding and the line break are stored in the File, so it's
+// written back the same way.
+//
+// Lines starting with ';' or '#' are comments. Lines without '=', which
+// Windows ignores, are kept as comments too.
+func Parse(src []byte, dup DUPLICATE) (*File, error) {
+	file := &File{}
+	var text string
+	if bytes.HasPrefix(src, []byte{0xff, 0xfe}) {
+		file.Encoding = ENCODING_UTF16LE
+		text = decodeUtf16(src[2:], binary.LittleEndian)
+	} else if bytes.HasPrefix(src, []byte{0xfe, 0xff}) {
+		file.Encoding = ENCODING_UTF16BE
+		text = decodeUtf16(src[2:], binary.BigEndian)
+	} else if bytes.HasPrefix(src, []byte{0xef, 0xbb, 0xbf}) {
+		file.Encoding = ENCODING_UTF8_BOM
+		text = string(src[3:])
+	} else if utf8.Valid(src) {
+		file.Encoding = ENCODING_UTF8
+		text = string(src)
+	} else {
+		file.Encoding = ENCODING_ANSI
+		text = latin1(src)
+	}
+
+	if strings.Contains(text, "\r\n") {
+		file.LineBreak = "\r\n"
+	} else if strings.Contains(text, "\n") {
+		file.LineBreak = "\n"
+	}
+
+	lines := strings.Split(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	} else {
+		file.noFinalBreak = true
+	}
+
+	p := _Parser{
+		file:   file,
+		dup:    dup,
+		curSec: -1,
+	}
+	for i, line := range lines {
+		p.lineNo = i + 1
+		if err := p.parseLine(strings.TrimSuffix(line, "\r")); err != nil {
+			return nil, err
+		}
+	}
+	file.Trailing = p.pending
+	return file, nil
+}
+
+type _Parser struct {
+	file    *File
+	dup     DUPLICATE
+	lineNo  int      // 1-based number of the current line
+	curSec  int      // index of the current section, or -1
+	pending []string // comments to be attached to the next key or section
+}
+
+func (p *_Parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", p.lineNo, fmt.Sprintf(format, args...))
+}
+
+func (p *_Parser) parseLine(line string) error {
+	trimmed := strings.TrimSpace(line)
+	switch {
+	case trimmed == "", trimmed[0] == ';', trimmed[0] == '#':
+		p.pending = append(p.pending, line)
+	case trimmed[0] == '[' && trimmed[len(trimmed)-1] == ']':
+		return p.parseSection(line, strings.TrimSpace(trimmed[1:len(trimmed)-1]))
+	case strings.Contains(trimmed, "="):
+		return p.parseKey(line, trimmed)
+	default:
+		p.pending = append(p.pending, line)
+	}
+	return nil
+}
+
+func (p *_Parser) parseSection(line, name string) error {
+	if p.dup != DUPLICATE_KEEP {
+		for i := range p.file.Sections {
+			if p.file.Sections[i].Name == name {
+				if p.dup == DUPLICATE_ERROR {
+					return p.errorf("duplicated section [%s]", name)
+				}
+				p.curSec = i // merge; pending comments go to the next key
+				return nil
+			}
+		}
+	}
+
+	p.file.Sections = append(p.file.Sections, Section{
+		Comments: p.pending,
+		Name:     name,
+		raw:      line,
+		rawName:  name,
+	})
+	p.curSec = len(p.file.Sections) - 1
+	p.pending = nil
+	return nil
+}
+
+func (p *_Parser) parseKey(line, trimmed string) error {
+	if p.curSec == -1 { // keys before any section
+		p.file.Sections = append(p.file.Sections, Section{})
+		p.curSec = 0
+	}
+	sec := &p.file.Sections[p.curSec]
+
+	name, value, _ := strings.Cut(trimmed, "=")
+	name = strings.TrimSpace(name)
+	value = strings.TrimSpace(value)
+
+	if p.dup != DUPLICATE_KEEP {
+		if prev, ok := sec.Value(name); ok {
+			switch p.dup {
+			case DUPLICATE_ERROR:
+				return p.errorf("duplicated key %q in section [%s]", name, sec.Name)
+			case DUPLICATE_LAST:
+				prev.Value = value
+			}
+			prev.Comments = append(prev.Comments, p.pending...) // the dropped line's comments
+			p.pending = nil
+			return nil
+		}
+	}
+
+	sec.Values = append(sec.Values, Key{
+		Comments: p.pending,
+		Name:     name,
+		Value:    value,
+		raw:      line,
+		rawName:  name,
+		rawValue: value,
+	})
+	p.pending = nil
+	return nil
+}
+
+// Decodes UTF-16 bytes, ignoring a trailing odd byte.
+func decodeUtf16(data []byte, order binary.ByteOrder) string {
+	chars := make([]uint16, len(data)/2)
+	for i := range chars {
+		chars[i] = order.Uint16(data[i*2:])
+	}
+	return string(utf16.Decode(chars))
+}
+
+// Converts Latin-1 bytes to a string.
+func latin1(src []byte) string {
+	runes := make([]rune, len(src))
+	for i, b := range src {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
@@ -0,0 +1,199 @@
+package ini
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Loads the keys of the section into the fields of the struct pointed by dest.
+// Keys which don't exist leave their fields untouched, so default values can be
+// set before the call.
+//
+// Each exported field is mapped to the key with its name, unless a tag in the
+// form `ini:"Name"` is given. A tag `ini:"-"` ignores the field. The supported
+// field types are string, bool, integers, floats, time.Duration and []string,
+// parsed like the typed getters of Section.
+//
+// Panics if dest is not a pointer to a struct, or if a field has an unsupported
+// type.
+//
+// # Example
+//
+//	type Window struct {
+//		Width     int
+//		Maximized bool
+//		Recent    []string      `ini:"RecentFiles"`
+//		Autosave  time.Duration
+//		Internal  int           `ini:"-"`
+//	}
+//
+//	window := Window{Width: 800} // default values
+//	if sec, ok := file.Section("Window"); ok {
+//		err := sec.LoadStruct(&window)
+//	}
+func (s *Section) LoadStruct(dest interface{}) error {
+	for _, field := range structFields(dest) {
+		if val, ok := s.Get(field.name); ok {
+			if err := field.load(val); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Saves the fields of the struct pointed by src into keys of the section, which
+// are created if they don't exist. The fields are mapped as in LoadStruct().
+//
+// Keys whose value already parses to the field value are left untouched, so
+// values like "yes" for true keep their original form.
+//
+// Panics if src is not a pointer to a struct, or if a field has an unsupported
+// type.
+//
+// # Example
+//
+//	window := Window{Width: 1024, Maximized: true}
+//	file.AddSection("Window").SaveStruct(&window)
+func (s *Section) SaveStruct(src interface{}) {
+	for _, field := range structFields(src) {
+		if val, ok := s.Get(field.name); !ok || !field.equals(val) {
+			s.Set(field.name, field.save())
+		}
+	}
+}
+
+// A struct field mapped to a key.
+type _StructField struct {
+	name  string
+	value reflect.Value
+}
+
+var _durationType = reflect.TypeOf(time.Duration(0))
+
+// Parses the fields of the struct pointed by ptr, panicking on errors.
+func structFields(ptr interface{}) []_StructField {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("Expected a pointer to struct, got %T.", ptr))
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	fields := make([]_StructField, 0, rt.NumField())
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		name := sf.Tag.Get("ini")
+		if name == "-" {
+			continue
+		} else if name == "" {
+			name = sf.Name
+		}
+
+		switch sf.Type.Kind() {
+		case reflect.String, reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+		case reflect.Slice:
+			if sf.Type.Elem() != reflect.TypeOf("") {
+				panic(fmt.Sprintf("Unsupported type %s for field %s.", sf.Type, sf.Name))
+			}
+		default:
+			panic(fmt.Sprintf("Unsupported type %s for field %s.", sf.Type, sf.Name))
+		}
+		fields = append(fields, _StructField{name, rv.Field(i)})
+	}
+	return fields
+}
+
+// Parses the key value into the field.
+func (me *_StructField) load(val string) error {
+	invalid := func() error {
+		return fmt.Errorf("key %s has value %q, invalid for %s",
+			me.name, val, me.value.Type())
+	}
+
+	switch me.value.Kind() {
+	case reflect.String:
+		me.value.SetString(val)
+
+	case reflect.Bool:
+		b, ok := parseBool(val)
+		if !ok {
+			return invalid()
+		}
+		me.value.SetBool(b)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if me.value.Type() == _durationType {
+			d, err := time.ParseDuration(strings.TrimSpace(val))
+			if err != nil {
+				return invalid()
+			}
+			me.value.SetInt(int64(d))
+		} else {
+			n, err := parseInt(val, me.value.Type().Bits())
+			if err != nil {
+				return invalid()
+			}
+			me.value.SetInt(n)
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := parseUint(val, me.value.Type().Bits())
+		if err != nil {
+			return invalid()
+		}
+		me.value.SetUint(n)
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(strings.TrimSpace(val), me.value.Type().Bits())
+		if err != nil {
+			return invalid()
+		}
+		me.value.SetFloat(f)
+
+	case reflect.Slice:
+		me.value.Set(reflect.ValueOf(splitList(val)).Convert(me.value.Type()))
+	}
+	return nil
+}
+
+// Tells whether the key value parses to the current value of the field.
+func (me *_StructField) equals(val string) bool {
+	parsed := _StructField{me.name, reflect.New(me.value.Type()).Elem()}
+	if err := parsed.load(val); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(parsed.value.Interface(), me.value.Interface())
+}
+
+// Formats the field as a key value.
+func (me *_StructField) save() string {
+	switch me.value.Kind() {
+	case reflect.String:
+		return me.value.String()
+	case reflect.Bool:
+		return formatBool(me.value.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if me.value.Type() == _durationType {
+			return time.Duration(me.value.Int()).String()
+		}
+		return strconv.FormatInt(me.value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(me.value.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(me.value.Float(), 'g', -1, me.value.Type().Bits())
+	default: // []string
+		items := me.value.Convert(reflect.TypeOf([]string{})).Interface().([]string)
+		return joinList(items)
+	}
+}
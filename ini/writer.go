@@ -0,0 +1,91 @@
+package ini
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"unicode/utf16"
+)
+
+// Serializes the file, returning the bytes.
+func (f *File) Bytes() []byte {
+	var buf bytes.Buffer
+	f.WriteTo(&buf)
+	return buf.Bytes()
+}
+
+// Serializes the file in its Encoding, writing it to w.
+//
+// Unmodified lines are written exactly as they were parsed; modified and new
+// ones are written as name=value.
+//
+// Implements io.WriterTo.
+func (f *File) WriteTo(w io.Writer) (int64, error) {
+	var lines []string
+	for i := range f.Sections {
+		sec := &f.Sections[i]
+		lines = append(lines, sec.Comments...)
+		if sec.raw != "" && sec.Name == sec.rawName {
+			lines = append(lines, sec.raw)
+		} else if i > 0 || sec.Name != "" {
+			lines = append(lines, "["+sec.Name+"]")
+		}
+
+		for k := range sec.Values {
+			key := &sec.Values[k]
+			lines = append(lines, key.Comments...)
+			if key.raw != "" && key.Name == key.rawName && key.Value == key.rawValue {
+				lines = append(lines, key.raw)
+			} else {
+				lines = append(lines, key.Name+"="+key.Value)
+			}
+		}
+	}
+	lines = append(lines, f.Trailing...)
+
+	lineBreak := f.LineBreak
+	if lineBreak == "" {
+		lineBreak = "\r\n"
+	}
+	text := strings.Join(lines, lineBreak)
+	if len(lines) > 0 && !f.noFinalBreak {
+		text += lineBreak
+	}
+
+	var buf []byte
+	switch f.Encoding {
+	case ENCODING_UTF8_BOM:
+		buf = append([]byte{0xef, 0xbb, 0xbf}, text...)
+	case ENCODING_UTF16LE:
+		buf = encodeUtf16([]byte{0xff, 0xfe}, text, binary.LittleEndian)
+	case ENCODING_UTF16BE:
+		buf = encodeUtf16([]byte{0xfe, 0xff}, text, binary.BigEndian)
+	case ENCODING_ANSI:
+		buf = encodeLatin1(text)
+	default:
+		buf = []byte(text)
+	}
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// Appends the string as UTF-16 bytes.
+func encodeUtf16(dest []byte, s string, order binary.AppendByteOrder) []byte {
+	for _, ch := range utf16.Encode([]rune(s)) {
+		dest = order.AppendUint16(dest, ch)
+	}
+	return dest
+}
+
+// Converts the string to Latin-1 bytes; chars out of range become '?'.
+func encodeLatin1(s string) []byte {
+	dest := make([]byte, 0, len(s))
+	for _, ch := range s {
+		if ch > 0xff {
+			ch = '?'
+		}
+		dest = append(dest, byte(ch))
+	}
+	return dest
+}
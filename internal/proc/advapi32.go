@@ -15,6 +15,7 @@ var (
 	GetTokenInformation            = advapi32.NewProc("GetTokenInformation")
 	OpenProcessToken               = advapi32.NewProc("OpenProcessToken")
 	RegCloseKey                    = advapi32.NewProc("RegCloseKey")
+	RegCreateKeyEx                 = advapi32.NewProc("RegCreateKeyExW")
 	RegDeleteKey                   = advapi32.NewProc("RegDeleteKeyW")
 	RegDeleteKeyEx                 = advapi32.NewProc("RegDeleteKeyExW")
 	RegDeleteKeyValue              = advapi32.NewProc("RegDeleteKeyValueW")
@@ -23,6 +24,7 @@ var (
 	RegEnumValue                   = advapi32.NewProc("RegEnumValueW")
 	RegFlushKey                    = advapi32.NewProc("RegFlushKey")
 	RegGetValue                    = advapi32.NewProc("RegGetValueW")
+	RegNotifyChangeKeyValue        = advapi32.NewProc("RegNotifyChangeKeyValue")
 	RegOpenKeyEx                   = advapi32.NewProc("RegOpenKeyExW")
 	RegQueryInfoKey                = advapi32.NewProc("RegQueryInfoKeyW")
 	RegSetKeyValue                 = advapi32.NewProc("RegSetKeyValueW")
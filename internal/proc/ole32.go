@@ -9,16 +9,22 @@ import (
 var (
 	ole32 = syscall.NewLazyDLL("ole32.dll")
 
-	CLSIDFromProgID  = ole32.NewProc("CLSIDFromProgID")
-	CoCreateGuid     = ole32.NewProc("CoCreateGuid")
-	CoCreateInstance = ole32.NewProc("CoCreateInstance")
-	CoInitializeEx   = ole32.NewProc("CoInitializeEx")
-	CoTaskMemAlloc   = ole32.NewProc("CoTaskMemAlloc")
-	CoTaskMemFree    = ole32.NewProc("CoTaskMemFree")
-	CoTaskMemRealloc = ole32.NewProc("CoTaskMemRealloc")
-	CoUninitialize   = ole32.NewProc("CoUninitialize")
-	OleInitialize    = ole32.NewProc("OleInitialize")
-	OleUninitialize  = ole32.NewProc("OleUninitialize")
-	RegisterDragDrop = ole32.NewProc("RegisterDragDrop")
-	RevokeDragDrop   = ole32.NewProc("RevokeDragDrop")
+	CLSIDFromProgID       = ole32.NewProc("CLSIDFromProgID")
+	CoCreateGuid          = ole32.NewProc("CoCreateGuid")
+	CoCreateInstance      = ole32.NewProc("CoCreateInstance")
+	CoInitializeEx        = ole32.NewProc("CoInitializeEx")
+	CoTaskMemAlloc        = ole32.NewProc("CoTaskMemAlloc")
+	CoTaskMemFree         = ole32.NewProc("CoTaskMemFree")
+	CoTaskMemRealloc      = ole32.NewProc("CoTaskMemRealloc")
+	CoUninitialize        = ole32.NewProc("CoUninitialize")
+	DoDragDrop            = ole32.NewProc("DoDragDrop")
+	OleFlushClipboard     = ole32.NewProc("OleFlushClipboard")
+	OleGetClipboard       = ole32.NewProc("OleGetClipboard")
+	OleInitialize         = ole32.NewProc("OleInitialize")
+	OleIsCurrentClipboard = ole32.NewProc("OleIsCurrentClipboard")
+	OleSetClipboard       = ole32.NewProc("OleSetClipboard")
+	OleUninitialize       = ole32.NewProc("OleUninitialize")
+	RegisterDragDrop      = ole32.NewProc("RegisterDragDrop")
+	ReleaseStgMedium      = ole32.NewProc("ReleaseStgMedium")
+	RevokeDragDrop        = ole32.NewProc("RevokeDragDrop")
 )
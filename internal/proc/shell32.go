@@ -17,6 +17,7 @@ var (
 	DuplicateIcon               = shell32.NewProc("DuplicateIcon")
 	ExtractIconEx               = shell32.NewProc("ExtractIconExW")
 	SHCreateItemFromParsingName = shell32.NewProc("SHCreateItemFromParsingName")
+	SHCreateStdEnumFmtEtc       = shell32.NewProc("SHCreateStdEnumFmtEtc")
 	Shell_NotifyIcon            = shell32.NewProc("Shell_NotifyIconW")
 	SHGetFileInfo               = shell32.NewProc("SHGetFileInfoW")
 )
@@ -26,6 +26,7 @@ var (
 	CopyIcon                      = user32.NewProc("CopyIcon")
 	CountClipboardFormats         = user32.NewProc("CountClipboardFormats")
 	CreateAcceleratorTable        = user32.NewProc("CreateAcceleratorTableW")
+	CreateDialogIndirectParam     = user32.NewProc("CreateDialogIndirectParamW")
 	CreateDialogParam             = user32.NewProc("CreateDialogParamW")
 	CreateIconFromResourceEx      = user32.NewProc("CreateIconFromResourceEx")
 	CreateIconIndirect            = user32.NewProc("CreateIconIndirect")
@@ -84,6 +85,7 @@ var (
 	GetClassLongPtr               = user32.NewProc("GetClassLongPtrW")
 	GetClassName                  = user32.NewProc("GetClassNameW")
 	GetClientRect                 = user32.NewProc("GetClientRect")
+	GetClipboardFormatName        = user32.NewProc("GetClipboardFormatNameW")
 	GetClipboardOwner             = user32.NewProc("GetClipboardOwner")
 	GetClipboardSequenceNumber    = user32.NewProc("GetClipboardSequenceNumber")
 	GetCursorPos                  = user32.NewProc("GetCursorPos")
@@ -174,6 +176,7 @@ var (
 	RealChildWindowFromPoint      = user32.NewProc("RealChildWindowFromPoint")
 	RealGetWindowClass            = user32.NewProc("RealGetWindowClassW")
 	RegisterClassEx               = user32.NewProc("RegisterClassExW")
+	RegisterClipboardFormat       = user32.NewProc("RegisterClipboardFormatW")
 	RegisterWindowMessage         = user32.NewProc("RegisterWindowMessageW")
 	ReleaseDC                     = user32.NewProc("ReleaseDC")
 	RemoveMenu                    = user32.NewProc("RemoveMenu")
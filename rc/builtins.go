@@ -0,0 +1,545 @@
+package rc
+
+// Symbols of the Windows headers, like windows.h and commctrl.h, which are
+// available to the scripts without #include. Those defined by the script take
+// precedence.
+var _builtins = map[string]int64{
+	"BS_3STATE":                    5,
+	"BS_AUTO3STATE":                6,
+	"BS_AUTOCHECKBOX":              3,
+	"BS_AUTORADIOBUTTON":           9,
+	"BS_BITMAP":                    0x80,
+	"BS_BOTTOM":                    0x800,
+	"BS_CENTER":                    0x300,
+	"BS_CHECKBOX":                  2,
+	"BS_DEFPUSHBUTTON":             1,
+	"BS_FLAT":                      0x8000,
+	"BS_GROUPBOX":                  7,
+	"BS_ICON":                      0x40,
+	"BS_LEFT":                      0x100,
+	"BS_LEFTTEXT":                  0x20,
+	"BS_MULTILINE":                 0x2000,
+	"BS_NOTIFY":                    0x4000,
+	"BS_OWNERDRAW":                 0xb,
+	"BS_PUSHBOX":                   0xa,
+	"BS_PUSHBUTTON":                0,
+	"BS_PUSHLIKE":                  0x1000,
+	"BS_RADIOBUTTON":               4,
+	"BS_RIGHT":                     0x200,
+	"BS_RIGHTBUTTON":               0x20,
+	"BS_TEXT":                      0,
+	"BS_TOP":                       0x400,
+	"BS_TYPEMASK":                  0xf,
+	"BS_USERBUTTON":                8,
+	"BS_VCENTER":                   0xc00,
+	"CBS_AUTOHSCROLL":              0x40,
+	"CBS_DISABLENOSCROLL":          0x800,
+	"CBS_DROPDOWN":                 2,
+	"CBS_DROPDOWNLIST":             3,
+	"CBS_HASSTRINGS":               0x200,
+	"CBS_LOWERCASE":                0x4000,
+	"CBS_NOINTEGRALHEIGHT":         0x400,
+	"CBS_OEMCONVERT":               0x80,
+	"CBS_OWNERDRAWFIXED":           0x10,
+	"CBS_OWNERDRAWVARIABLE":        0x20,
+	"CBS_SIMPLE":                   1,
+	"CBS_SORT":                     0x100,
+	"CBS_UPPERCASE":                0x2000,
+	"DS_3DLOOK":                    4,
+	"DS_ABSALIGN":                  1,
+	"DS_CENTER":                    0x800,
+	"DS_CENTERMOUSE":               0x1000,
+	"DS_CONTEXTHELP":               0x2000,
+	"DS_CONTROL":                   0x400,
+	"DS_FIXEDSYS":                  8,
+	"DS_LOCALEDIT":                 0x20,
+	"DS_MODALFRAME":                0x80,
+	"DS_NOFAILCREATE":              0x10,
+	"DS_NOIDLEMSG":                 0x100,
+	"DS_SETFONT":                   0x40,
+	"DS_SETFOREGROUND":             0x200,
+	"DS_SHELLFONT":                 0x48,
+	"DS_SYSMODAL":                  2,
+	"DTS_APPCANPARSE":              0x10,
+	"DTS_LONGDATEFORMAT":           4,
+	"DTS_NONE":                     0,
+	"DTS_RIGHTALIGN":               0x20,
+	"DTS_SHORTDATECENTURYFORMAT":   0xc,
+	"DTS_SHORTDATEFORMAT":          0,
+	"DTS_SHOWNONE":                 2,
+	"DTS_TIMEFORMAT":               9,
+	"DTS_UPDOWN":                   1,
+	"ES_AUTOHSCROLL":               0x80,
+	"ES_AUTOVSCROLL":               0x40,
+	"ES_CENTER":                    1,
+	"ES_LEFT":                      0,
+	"ES_LOWERCASE":                 0x10,
+	"ES_MULTILINE":                 4,
+	"ES_NOHIDESEL":                 0x100,
+	"ES_NUMBER":                    0x2000,
+	"ES_OEMCONVERT":                0x400,
+	"ES_PASSWORD":                  0x20,
+	"ES_READONLY":                  0x800,
+	"ES_RIGHT":                     2,
+	"ES_UPPERCASE":                 8,
+	"ES_WANTRETURN":                0x1000,
+	"IDABORT":                      3,
+	"IDCANCEL":                     2,
+	"IDCLOSE":                      8,
+	"IDCONTINUE":                   0xb,
+	"IDC_STATIC":                   -1,
+	"IDHELP":                       9,
+	"IDIGNORE":                     5,
+	"IDNO":                         7,
+	"IDOK":                         1,
+	"IDRETRY":                      4,
+	"IDTRYAGAIN":                   0xa,
+	"IDYES":                        6,
+	"LANG_CHINESE":                 4,
+	"LANG_DUTCH":                   0x13,
+	"LANG_ENGLISH":                 9,
+	"LANG_FRENCH":                  0xc,
+	"LANG_GERMAN":                  7,
+	"LANG_ITALIAN":                 0x10,
+	"LANG_JAPANESE":                0x11,
+	"LANG_KOREAN":                  0x12,
+	"LANG_NEUTRAL":                 0,
+	"LANG_POLISH":                  0x15,
+	"LANG_PORTUGUESE":              0x16,
+	"LANG_RUSSIAN":                 0x19,
+	"LANG_SPANISH":                 0xa,
+	"LBS_COMBOBOX":                 0x8000,
+	"LBS_DISABLENOSCROLL":          0x1000,
+	"LBS_EXTENDEDSEL":              0x800,
+	"LBS_HASSTRINGS":               0x40,
+	"LBS_MULTICOLUMN":              0x200,
+	"LBS_MULTIPLESEL":              8,
+	"LBS_NODATA":                   0x2000,
+	"LBS_NOINTEGRALHEIGHT":         0x100,
+	"LBS_NOREDRAW":                 4,
+	"LBS_NOSEL":                    0x4000,
+	"LBS_NOTIFY":                   1,
+	"LBS_OWNERDRAWFIXED":           0x10,
+	"LBS_OWNERDRAWVARIABLE":        0x20,
+	"LBS_SORT":                     2,
+	"LBS_STANDARD":                 0xa00003,
+	"LBS_USETABSTOPS":              0x80,
+	"LBS_WANTKEYBOARDINPUT":        0x400,
+	"LVS_ALIGNLEFT":                0x800,
+	"LVS_ALIGNMASK":                0xc00,
+	"LVS_ALIGNTOP":                 0,
+	"LVS_AUTOARRANGE":              0x100,
+	"LVS_EDITLABELS":               0x200,
+	"LVS_ICON":                     0,
+	"LVS_LIST":                     3,
+	"LVS_NOCOLUMNHEADER":           0x4000,
+	"LVS_NOLABELWRAP":              0x80,
+	"LVS_NOSCROLL":                 0x2000,
+	"LVS_NOSORTHEADER":             0x8000,
+	"LVS_OWNERDATA":                0x1000,
+	"LVS_OWNERDRAWFIXED":           0x400,
+	"LVS_REPORT":                   1,
+	"LVS_SHAREIMAGELISTS":          0x40,
+	"LVS_SHOWSELALWAYS":            8,
+	"LVS_SINGLESEL":                4,
+	"LVS_SMALLICON":                2,
+	"LVS_SORTASCENDING":            0x10,
+	"LVS_SORTDESCENDING":           0x20,
+	"LVS_TYPEMASK":                 3,
+	"LVS_TYPESTYLEMASK":            0xfc00,
+	"MCS_DAYSTATE":                 1,
+	"MCS_MULTISELECT":              2,
+	"MCS_NONE":                     0,
+	"MCS_NOSELCHANGEONNAV":         0x100,
+	"MCS_NOTODAY":                  0x10,
+	"MCS_NOTODAYCIRCLE":            8,
+	"MCS_NOTRAILINGDATES":          0x40,
+	"MCS_SHORTDAYSOFWEEK":          0x80,
+	"MCS_WEEKNUMBERS":              4,
+	"MFS_CHECKED":                  8,
+	"MFS_DEFAULT":                  0x1000,
+	"MFS_DISABLED":                 3,
+	"MFS_ENABLED":                  0,
+	"MFS_GRAYED":                   3,
+	"MFS_HILITE":                   0x80,
+	"MFS_UNCHECKED":                0,
+	"MFS_UNHILITE":                 0,
+	"MFT_BITMAP":                   4,
+	"MFT_MENUBARBREAK":             0x20,
+	"MFT_MENUBREAK":                0x40,
+	"MFT_OWNERDRAW":                0x100,
+	"MFT_RADIOCHECK":               0x200,
+	"MFT_RIGHTJUSTIFY":             0x4000,
+	"MFT_RIGHTORDER":               0x2000,
+	"MFT_SEPARATOR":                0x800,
+	"MFT_STRING":                   0,
+	"PBS_MARQUEE":                  8,
+	"PBS_SMOOTH":                   1,
+	"PBS_SMOOTHREVERSE":            0x10,
+	"PBS_VERTICAL":                 4,
+	"SBS_BOTTOMALIGN":              4,
+	"SBS_HORZ":                     0,
+	"SBS_LEFTALIGN":                2,
+	"SBS_RIGHTALIGN":               4,
+	"SBS_SIZEBOX":                  8,
+	"SBS_SIZEBOXBOTTOMRIGHTALIGN":  4,
+	"SBS_SIZEBOXTOPLEFTALIGN":      2,
+	"SBS_SIZEGRIP":                 0x10,
+	"SBS_TOPALIGN":                 2,
+	"SBS_VERT":                     1,
+	"SS_BITMAP":                    0xe,
+	"SS_BLACKFRAME":                7,
+	"SS_BLACKRECT":                 4,
+	"SS_CENTER":                    1,
+	"SS_CENTERIMAGE":               0x200,
+	"SS_EDITCONTROL":               0x2000,
+	"SS_ELLIPSISMASK":              0xc000,
+	"SS_ENDELLIPSIS":               0x4000,
+	"SS_ENHMETAFILE":               0xf,
+	"SS_ETCHEDFRAME":               0x12,
+	"SS_ETCHEDHORZ":                0x10,
+	"SS_ETCHEDVERT":                0x11,
+	"SS_GRAYFRAME":                 8,
+	"SS_GRAYRECT":                  5,
+	"SS_ICON":                      3,
+	"SS_LEFT":                      0,
+	"SS_LEFTNOWORDWRAP":            0xc,
+	"SS_NOPREFIX":                  0x80,
+	"SS_NOTIFY":                    0x100,
+	"SS_OWNERDRAW":                 0xd,
+	"SS_PATHELLIPSIS":              0x8000,
+	"SS_REALSIZECONTROL":           0x40,
+	"SS_REALSIZEIMAGE":             0x800,
+	"SS_RIGHT":                     2,
+	"SS_RIGHTJUST":                 0x400,
+	"SS_SIMPLE":                    0xb,
+	"SS_SUNKEN":                    0x1000,
+	"SS_TYPEMASK":                  0x1f,
+	"SS_USERITEM":                  0xa,
+	"SS_WHITEFRAME":                9,
+	"SS_WHITERECT":                 6,
+	"SS_WORDELLIPSIS":              0xc000,
+	"SUBLANG_CHINESE_SIMPLIFIED":   2,
+	"SUBLANG_CHINESE_TRADITIONAL":  1,
+	"SUBLANG_DEFAULT":              1,
+	"SUBLANG_DUTCH":                1,
+	"SUBLANG_ENGLISH_UK":           2,
+	"SUBLANG_ENGLISH_US":           1,
+	"SUBLANG_FRENCH":               1,
+	"SUBLANG_GERMAN":               1,
+	"SUBLANG_ITALIAN":              1,
+	"SUBLANG_JAPANESE_JAPAN":       1,
+	"SUBLANG_KOREAN":               1,
+	"SUBLANG_NEUTRAL":              0,
+	"SUBLANG_POLISH_POLAND":        1,
+	"SUBLANG_PORTUGUESE":           2,
+	"SUBLANG_PORTUGUESE_BRAZILIAN": 1,
+	"SUBLANG_RUSSIAN_RUSSIA":       1,
+	"SUBLANG_SPANISH_MODERN":       3,
+	"SUBLANG_SYS_DEFAULT":          2,
+	"TBS_AUTOTICKS":                1,
+	"TBS_BOTH":                     8,
+	"TBS_BOTTOM":                   0,
+	"TBS_DOWNISLEFT":               0x400,
+	"TBS_ENABLESELRANGE":           0x20,
+	"TBS_FIXEDLENGTH":              0x40,
+	"TBS_HORZ":                     0,
+	"TBS_LEFT":                     4,
+	"TBS_NOTHUMB":                  0x80,
+	"TBS_NOTICKS":                  0x10,
+	"TBS_NOTIFYBEFOREMOVE":         0x800,
+	"TBS_REVERSED":                 0x200,
+	"TBS_RIGHT":                    0,
+	"TBS_TOOLTIPS":                 0x100,
+	"TBS_TOP":                      4,
+	"TBS_TRANSPARENTBKGND":         0x1000,
+	"TBS_VERT":                     2,
+	"TVS_CHECKBOXES":               0x100,
+	"TVS_DISABLEDRAGDROP":          0x10,
+	"TVS_EDITLABELS":               8,
+	"TVS_FULLROWSELECT":            0x1000,
+	"TVS_HASBUTTONS":               1,
+	"TVS_HASLINES":                 2,
+	"TVS_INFOTIP":                  0x800,
+	"TVS_LINESATROOT":              4,
+	"TVS_NOHSCROLL":                0x8000,
+	"TVS_NONEVENHEIGHT":            0x4000,
+	"TVS_NOSCROLL":                 0x2000,
+	"TVS_NOTOOLTIPS":               0x80,
+	"TVS_RTLREADING":               0x40,
+	"TVS_SHOWSELALWAYS":            0x20,
+	"TVS_SINGLEEXPAND":             0x400,
+	"TVS_TRACKSELECT":              0x200,
+	"UDS_ALIGNLEFT":                8,
+	"UDS_ALIGNRIGHT":               4,
+	"UDS_ARROWKEYS":                0x20,
+	"UDS_AUTOBUDDY":                0x10,
+	"UDS_HORZ":                     0x40,
+	"UDS_HOTTRACK":                 0x100,
+	"UDS_NOTHOUSANDS":              0x80,
+	"UDS_SETBUDDYINT":              2,
+	"UDS_WRAP":                     1,
+	"VFT2_UNKNOWN":                 0,
+	"VFT_APP":                      1,
+	"VFT_DLL":                      2,
+	"VFT_DRV":                      3,
+	"VFT_FONT":                     4,
+	"VFT_STATIC_LIB":               7,
+	"VFT_UNKNOWN":                  0,
+	"VFT_VXD":                      5,
+	"VK_ACCEPT":                    0x1e,
+	"VK_ADD":                       0x6b,
+	"VK_APPS":                      0x5d,
+	"VK_ATTN":                      0xf6,
+	"VK_BACK":                      8,
+	"VK_BROWSER_BACK":              0xa6,
+	"VK_BROWSER_FAVORITES":         0xab,
+	"VK_BROWSER_FORWARD":           0xa7,
+	"VK_BROWSER_HOME":              0xac,
+	"VK_BROWSER_REFRESH":           0xa8,
+	"VK_BROWSER_SEARCH":            0xaa,
+	"VK_BROWSER_STOP":              0xa9,
+	"VK_CANCEL":                    3,
+	"VK_CAPITAL":                   0x14,
+	"VK_CLEAR":                     0xc,
+	"VK_CONTROL":                   0x11,
+	"VK_CONVERT":                   0x1c,
+	"VK_CRSEL":                     0xf7,
+	"VK_DECIMAL":                   0x6e,
+	"VK_DELETE":                    0x2e,
+	"VK_DIVIDE":                    0x6f,
+	"VK_DOWN":                      0x28,
+	"VK_END":                       0x23,
+	"VK_EREOF":                     0xf9,
+	"VK_ESCAPE":                    0x1b,
+	"VK_EXECUTE":                   0x2b,
+	"VK_EXSEL":                     0xf8,
+	"VK_F1":                        0x70,
+	"VK_F10":                       0x79,
+	"VK_F11":                       0x7a,
+	"VK_F12":                       0x7b,
+	"VK_F13":                       0x7c,
+	"VK_F14":                       0x7d,
+	"VK_F15":                       0x7e,
+	"VK_F16":                       0x7f,
+	"VK_F17":                       0x80,
+	"VK_F18":                       0x81,
+	"VK_F19":                       0x82,
+	"VK_F2":                        0x71,
+	"VK_F20":                       0x83,
+	"VK_F21":                       0x84,
+	"VK_F22":                       0x85,
+	"VK_F23":                       0x86,
+	"VK_F24":                       0x87,
+	"VK_F3":                        0x72,
+	"VK_F4":                        0x73,
+	"VK_F5":                        0x74,
+	"VK_F6":                        0x75,
+	"VK_F7":                        0x76,
+	"VK_F8":                        0x77,
+	"VK_F9":                        0x78,
+	"VK_FINAL":                     0x18,
+	"VK_HANGEUL":                   0x15,
+	"VK_HANGUL":                    0x15,
+	"VK_HANJA":                     0x19,
+	"VK_HELP":                      0x2f,
+	"VK_HOME":                      0x24,
+	"VK_ICO_00":                    0xe4,
+	"VK_ICO_CLEAR":                 0xe6,
+	"VK_ICO_HELP":                  0xe3,
+	"VK_INSERT":                    0x2d,
+	"VK_JUNJA":                     0x17,
+	"VK_KANA":                      0x15,
+	"VK_KANJI":                     0x19,
+	"VK_LAUNCH_APP1":               0xb6,
+	"VK_LAUNCH_APP2":               0xb7,
+	"VK_LAUNCH_MAIL":               0xb4,
+	"VK_LAUNCH_MEDIA_SELECT":       0xb5,
+	"VK_LBUTTON":                   1,
+	"VK_LCONTROL":                  0xa2,
+	"VK_LEFT":                      0x25,
+	"VK_LMENU":                     0xa4,
+	"VK_LSHIFT":                    0xa0,
+	"VK_LWIN":                      0x5b,
+	"VK_MBUTTON":                   4,
+	"VK_MEDIA_NEXT_TRACK":          0xb0,
+	"VK_MEDIA_PLAY_PAUSE":          0xb3,
+	"VK_MEDIA_PREV_TRACK":          0xb1,
+	"VK_MEDIA_STOP":                0xb2,
+	"VK_MENU":                      0x12,
+	"VK_MODECHANGE":                0x1f,
+	"VK_MULTIPLY":                  0x6a,
+	"VK_NEXT":                      0x22,
+	"VK_NONAME":                    0xfc,
+	"VK_NONCONVERT":                0x1d,
+	"VK_NUMLOCK":                   0x90,
+	"VK_NUMPAD0":                   0x60,
+	"VK_NUMPAD1":                   0x61,
+	"VK_NUMPAD2":                   0x62,
+	"VK_NUMPAD3":                   0x63,
+	"VK_NUMPAD4":                   0x64,
+	"VK_NUMPAD5":                   0x65,
+	"VK_NUMPAD6":                   0x66,
+	"VK_NUMPAD7":                   0x67,
+	"VK_NUMPAD8":                   0x68,
+	"VK_NUMPAD9":                   0x69,
+	"VK_OEM_1":                     0xba,
+	"VK_OEM_102":                   0xe2,
+	"VK_OEM_2":                     0xbf,
+	"VK_OEM_3":                     0xc0,
+	"VK_OEM_4":                     0xdb,
+	"VK_OEM_5":                     0xdc,
+	"VK_OEM_6":                     0xdd,
+	"VK_OEM_7":                     0xde,
+	"VK_OEM_8":                     0xdf,
+	"VK_OEM_ATTN":                  0xf0,
+	"VK_OEM_AUTO":                  0xf3,
+	"VK_OEM_AX":                    0xe1,
+	"VK_OEM_BACKTAB":               0xf5,
+	"VK_OEM_CLEAR":                 0xfe,
+	"VK_OEM_COMMA":                 0xbc,
+	"VK_OEM_COPY":                  0xf2,
+	"VK_OEM_CUSEL":                 0xef,
+	"VK_OEM_ENLW":                  0xf4,
+	"VK_OEM_FINISH":                0xf1,
+	"VK_OEM_FJ_JISHO":              0x92,
+	"VK_OEM_FJ_LOYA":               0x95,
+	"VK_OEM_FJ_MASSHOU":            0x93,
+	"VK_OEM_FJ_ROYA":               0x96,
+	"VK_OEM_FJ_TOUROKU":            0x94,
+	"VK_OEM_JUMP":                  0xea,
+	"VK_OEM_MINUS":                 0xbd,
+	"VK_OEM_NEC_EQUAL":             0x92,
+	"VK_OEM_PA1":                   0xeb,
+	"VK_OEM_PA2":                   0xec,
+	"VK_OEM_PA3":                   0xed,
+	"VK_OEM_PERIOD":                0xbe,
+	"VK_OEM_PLUS":                  0xbb,
+	"VK_OEM_RESET":                 0xe9,
+	"VK_OEM_WSCTRL":                0xee,
+	"VK_PA1":                       0xfd,
+	"VK_PACKET":                    0xe7,
+	"VK_PAUSE":                     0x13,
+	"VK_PLAY":                      0xfa,
+	"VK_PRINT":                     0x2a,
+	"VK_PRIOR":                     0x21,
+	"VK_PROCESSKEY":                0xe5,
+	"VK_RBUTTON":                   2,
+	"VK_RCONTROL":                  0xa3,
+	"VK_RETURN":                    0xd,
+	"VK_RIGHT":                     0x27,
+	"VK_RMENU":                     0xa5,
+	"VK_RSHIFT":                    0xa1,
+	"VK_RWIN":                      0x5c,
+	"VK_SCROLL":                    0x91,
+	"VK_SELECT":                    0x29,
+	"VK_SEPARATOR":                 0x6c,
+	"VK_SHIFT":                     0x10,
+	"VK_SLEEP":                     0x5f,
+	"VK_SNAPSHOT":                  0x2c,
+	"VK_SPACE":                     0x20,
+	"VK_SUBTRACT":                  0x6d,
+	"VK_TAB":                       9,
+	"VK_UP":                        0x26,
+	"VK_VOLUME_DOWN":               0xae,
+	"VK_VOLUME_MUTE":               0xad,
+	"VK_VOLUME_UP":                 0xaf,
+	"VK_XBUTTON1":                  5,
+	"VK_XBUTTON2":                  6,
+	"VK_ZOOM":                      0xfb,
+	"VOS_DOS":                      0x10000,
+	"VOS_DOS_WINDOWS32":            0x10004,
+	"VOS_NT":                       0x40000,
+	"VOS_NT_WINDOWS32":             0x40004,
+	"VOS_UNKNOWN":                  0,
+	"VOS__WINDOWS32":               4,
+	"VS_FFI_FILEFLAGSMASK":         0x3f,
+	"VS_FF_DEBUG":                  1,
+	"VS_FF_INFOINFERRED":           0x10,
+	"VS_FF_PATCHED":                4,
+	"VS_FF_PRERELEASE":             2,
+	"VS_FF_PRIVATEBUILD":           8,
+	"VS_FF_SPECIALBUILD":           0x20,
+	"VS_VERSION_INFO":              1,
+	"WS_BORDER":                    0x800000,
+	"WS_CAPTION":                   0xc00000,
+	"WS_CHILD":                     0x40000000,
+	"WS_CHILDWINDOW":               0x40000000,
+	"WS_CLIPCHILDREN":              0x2000000,
+	"WS_CLIPSIBLINGS":              0x4000000,
+	"WS_DISABLED":                  0x8000000,
+	"WS_DLGFRAME":                  0x400000,
+	"WS_EX_ACCEPTFILES":            0x10,
+	"WS_EX_APPWINDOW":              0x40000,
+	"WS_EX_CLIENTEDGE":             0x200,
+	"WS_EX_COMPOSITED":             0x2000000,
+	"WS_EX_CONTEXTHELP":            0x400,
+	"WS_EX_CONTROLPARENT":          0x10000,
+	"WS_EX_DLGMODALFRAME":          1,
+	"WS_EX_LAYERED":                0x80000,
+	"WS_EX_LAYOUTRTL":              0x400000,
+	"WS_EX_LEFT":                   0,
+	"WS_EX_LEFTSCROLLBAR":          0x4000,
+	"WS_EX_LTRREADING":             0,
+	"WS_EX_MDICHILD":               0x40,
+	"WS_EX_NOACTIVATE":             0x8000000,
+	"WS_EX_NOINHERITLAYOUT":        0x100000,
+	"WS_EX_NONE":                   0,
+	"WS_EX_NOPARENTNOTIFY":         4,
+	"WS_EX_NOREDIRECTIONBITMAP":    0x200000,
+	"WS_EX_OVERLAPPEDWINDOW":       0x300,
+	"WS_EX_PALETTEWINDOW":          0x188,
+	"WS_EX_RIGHT":                  0x1000,
+	"WS_EX_RIGHTSCROLLBAR":         0,
+	"WS_EX_RTLREADING":             0x2000,
+	"WS_EX_STATICEDGE":             0x20000,
+	"WS_EX_TOOLWINDOW":             0x80,
+	"WS_EX_TOPMOST":                8,
+	"WS_EX_TRANSPARENT":            0x20,
+	"WS_EX_WINDOWEDGE":             0x100,
+	"WS_GROUP":                     0x20000,
+	"WS_HSCROLL":                   0x100000,
+	"WS_ICONIC":                    0x20000000,
+	"WS_MAXIMIZE":                  0x1000000,
+	"WS_MAXIMIZEBOX":               0x10000,
+	"WS_MINIMIZE":                  0x20000000,
+	"WS_MINIMIZEBOX":               0x20000,
+	"WS_NONE":                      0,
+	"WS_OVERLAPPED":                0,
+	"WS_OVERLAPPEDWINDOW":          0xcf0000,
+	"WS_POPUP":                     0x80000000,
+	"WS_POPUPWINDOW":               0x80880000,
+	"WS_SIZEBOX":                   0x40000,
+	"WS_SYSMENU":                   0x80000,
+	"WS_TABSTOP":                   0x10000,
+	"WS_THICKFRAME":                0x40000,
+	"WS_TILED":                     0,
+	"WS_TILEDWINDOW":               0xcf0000,
+	"WS_VISIBLE":                   0x10000000,
+	"WS_VSCROLL":                   0x200000,
+}
+
+// String symbols of the Windows headers, usually window class names.
+var _builtinStrs = map[string]string{
+	"ANIMATE_CLASS":      "SysAnimate32",
+	"DATETIMEPICK_CLASS": "SysDateTimePick32",
+	"HOTKEY_CLASS":       "msctls_hotkey32",
+	"MONTHCAL_CLASS":     "SysMonthCal32",
+	"PROGRESS_CLASS":     "msctls_progress32",
+	"RICHEDIT_CLASS":     "RichEdit20W",
+	"STATUSCLASSNAME":    "msctls_statusbar32",
+	"TRACKBAR_CLASS":     "msctls_trackbar32",
+	"UPDOWN_CLASS":       "msctls_updown32",
+	"WC_BUTTON":          "Button",
+	"WC_COMBOBOX":        "ComboBox",
+	"WC_COMBOBOXEX":      "ComboBoxEx32",
+	"WC_EDIT":            "Edit",
+	"WC_HEADER":          "SysHeader32",
+	"WC_LINK":            "SysLink",
+	"WC_LISTBOX":         "ListBox",
+	"WC_LISTVIEW":        "SysListView32",
+	"WC_SCROLLBAR":       "ScrollBar",
+	"WC_STATIC":          "Static",
+	"WC_TABCONTROL":      "SysTabControl32",
+	"WC_TREEVIEW":        "SysTreeView32",
+}
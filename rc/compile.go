@@ -0,0 +1,188 @@
+package rc
+
+import (
+	"github.com/rodrigocfd/windigo/ico"
+	"github.com/rodrigocfd/windigo/res"
+	"github.com/rodrigocfd/windigo/versioninfo"
+)
+
+// Compiles the resources into binary form.
+//
+// The icon images are stored as RT_ICON resources with sequential IDs, after
+// the highest RT_ICON ID of Others.
+func (s *Script) File() (*res.File, error) {
+	file := &res.File{}
+	for _, r := range s.Others {
+		file.Set(r.Type, r.Name, r.Lang, r.Data)
+	}
+
+	for i := range s.Dialogs {
+		dlg := &s.Dialogs[i]
+		file.Set(res.RT_DIALOG.Id(), dlg.Name, dlg.Lang, dlg.Template.Bytes())
+	}
+	for i := range s.Menus {
+		menu := &s.Menus[i]
+		file.Set(res.RT_MENU.Id(), menu.Name, menu.Lang, menu.Template.Bytes())
+	}
+	for _, accel := range s.Accelerators {
+		file.Set(res.RT_ACCELERATOR.Id(), accel.Name, accel.Lang, accel.Table.Bytes())
+	}
+	for _, st := range s.StringTables {
+		if err := file.SetStrings(st.Lang, st.Strings); err != nil {
+			return nil, err
+		}
+	}
+	for _, icon := range s.Icons {
+		if err := file.SetIconGroup(icon.Name, icon.Lang, icon.File.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	for _, vi := range s.VersionInfos {
+		file.Set(res.RT_VERSION.Id(), vi.Name, vi.Lang, vi.Info.Bytes())
+	}
+	return file, nil
+}
+
+// Compiles the resources into a 32-bit .res file.
+func (s *Script) ResBytes() ([]byte, error) {
+	file, err := s.File()
+	if err != nil {
+		return nil, err
+	}
+	return file.ResBytes(), nil
+}
+
+// Parses a 32-bit .res file, and decodes its resources with FromFile().
+func ParseRes(src []byte) (*Script, error) {
+	file, err := res.ParseRes(src)
+	if err != nil {
+		return nil, err
+	}
+	return FromFile(file), nil
+}
+
+// Decodes compiled resources into a Script. Resources which can't be decoded,
+// like dialogs in the older DLGTEMPLATE format, are kept in Others.
+//
+// Symbol names are not stored in compiled resources, so Defines is empty.
+func FromFile(file *res.File) *Script {
+	s := &Script{Defines: make(map[string]int64)}
+	usedIcons := make(map[int]struct{}) // indexes of the RT_ICON resources referred by groups
+	var icons []int
+
+	for i := range file.Resources {
+		r := &file.Resources[i]
+		num, isNum := r.Type.Int()
+		if isNum && res.RT(num) == res.RT_ICON {
+			icons = append(icons, i) // known only after all groups are decoded
+		} else if !isNum || !s.decode(file, r, res.RT(num), usedIcons) {
+			s.Others = append(s.Others, *r)
+		}
+	}
+
+	for _, idx := range icons {
+		if _, used := usedIcons[idx]; !used {
+			s.Others = append(s.Others, file.Resources[idx])
+		}
+	}
+	return s
+}
+
+// Decodes a resource of a predefined type into the Script, returning false if
+// it must be kept raw.
+func (s *Script) decode(file *res.File, r *res.Resource,
+	rt res.RT, usedIcons map[int]struct{}) bool {
+
+	switch rt {
+	case res.RT_DIALOG:
+		dlg, err := res.ParseDialog(r.Data)
+		if err != nil {
+			return false
+		}
+		s.Dialogs = append(s.Dialogs, Dialog{r.Name, r.Lang, *dlg})
+	case res.RT_MENU:
+		menu, err := res.ParseMenu(r.Data)
+		if err != nil {
+			return false
+		}
+		s.Menus = append(s.Menus, Menu{r.Name, r.Lang, *menu})
+	case res.RT_ACCELERATOR:
+		table, err := res.ParseAccelTable(r.Data)
+		if err != nil {
+			return false
+		}
+		s.Accelerators = append(s.Accelerators, Accelerators{r.Name, r.Lang, table})
+	case res.RT_STRING:
+		blockId, isNum := r.Name.Int()
+		if !isNum || blockId == 0 {
+			return false
+		}
+		block, err := res.ParseStringBlock(r.Data)
+		if err != nil {
+			return false
+		}
+		st := s.StringTable(r.Lang)
+		for index, str := range block {
+			if str != "" {
+				st.Strings[res.StringId(blockId, index)] = str
+			}
+		}
+	case res.RT_GROUP_ICON:
+		icoFile, ok := decodeIconGroup(file, r, usedIcons)
+		if !ok {
+			return false
+		}
+		s.Icons = append(s.Icons, Icon{r.Name, r.Lang, icoFile})
+	case res.RT_VERSION:
+		vi, err := versioninfo.Parse(r.Data)
+		if err != nil {
+			return false
+		}
+		s.VersionInfos = append(s.VersionInfos, VersionInfo{r.Name, r.Lang, vi})
+	default:
+		return false
+	}
+	return true
+}
+
+// Rebuilds the .ico file of an icon group from its RT_ICON resources,
+// preferably in the same language of the group.
+func decodeIconGroup(file *res.File,
+	group *res.Resource, usedIcons map[int]struct{}) (*ico.File, bool) {
+
+	groupEntries, err := ico.ParseGroup(group.Data)
+	if err != nil {
+		return nil, false
+	}
+
+	icoFile := &ico.File{Type: ico.TYPE_ICON}
+	idxs := make([]int, 0, len(groupEntries))
+	for _, groupEntry := range groupEntries {
+		idx := -1
+		for i := range file.Resources {
+			r := &file.Resources[i]
+			if r.Type.Equals(res.RT_ICON.Id()) && r.Name.Equals(res.IdInt(groupEntry.Id)) {
+				if idx == -1 || r.Lang == group.Lang {
+					idx = i
+				}
+			}
+		}
+		if idx == -1 {
+			return nil, false // missing image
+		}
+		idxs = append(idxs, idx)
+		icoFile.Entries = append(icoFile.Entries, ico.Entry{
+			Width:    groupEntry.Width,
+			Height:   groupEntry.Height,
+			Colors:   groupEntry.Colors,
+			Planes:   groupEntry.Planes,
+			BitCount: groupEntry.BitCount,
+			Data:     file.Resources[idx].Data,
+		})
+	}
+
+	for _, idx := range idxs {
+		usedIcons[idx] = struct{}{}
+	}
+	return icoFile, true
+}
@@ -0,0 +1,112 @@
+package rc
+
+import (
+	"github.com/rodrigocfd/windigo/ico"
+	"github.com/rodrigocfd/windigo/res"
+	"github.com/rodrigocfd/windigo/versioninfo"
+)
+
+// A resource script, parsed from an .rc file with Parse(), or decoded from
+// compiled resources with FromFile().
+//
+// Supported statements are DIALOGEX, MENUEX, ACCELERATORS, STRINGTABLE, ICON,
+// VERSIONINFO and LANGUAGE, along with resources of other types loaded from
+// files, or given as raw data. The preprocessor handles #include, #define,
+// #undef, #if, #ifdef, #ifndef, #elif, #else and #endif.
+//
+// # Example
+//
+//	script, _ := rc.ParseFile("C:\\Temp\\app.rc")
+//	for _, dlg := range script.Dialogs {
+//		fmt.Println(dlg.Name, dlg.Template.Title)
+//	}
+//	resFile, _ := script.File()
+//	os.WriteFile("C:\\Temp\\app.res", resFile.ResBytes(), 0o644)
+type Script struct {
+	Defines      map[string]int64 // Integer symbols from #define, including the ones of included headers like resource.h.
+	Dialogs      []Dialog
+	Menus        []Menu
+	Accelerators []Accelerators
+	StringTables []StringTable // One table per language.
+	Icons        []Icon
+	VersionInfos []VersionInfo
+	Others       []res.Resource // Resources of any other type, or which couldn't be decoded.
+}
+
+// A DIALOGEX resource of a Script.
+type Dialog struct {
+	Name     res.Id
+	Lang     uint16
+	Template res.Dialog
+}
+
+// A MENUEX resource of a Script.
+type Menu struct {
+	Name     res.Id
+	Lang     uint16
+	Template res.Menu
+}
+
+// An ACCELERATORS resource of a Script.
+type Accelerators struct {
+	Name  res.Id
+	Lang  uint16
+	Table res.AccelTable
+}
+
+// The STRINGTABLE resources of a Script in one language.
+type StringTable struct {
+	Lang    uint16
+	Strings map[uint16]string // Strings keyed by their IDs.
+}
+
+// An ICON resource of a Script, which is an icon group.
+type Icon struct {
+	Name res.Id
+	Lang uint16
+	File *ico.File
+}
+
+// A VERSIONINFO resource of a Script.
+type VersionInfo struct {
+	Name res.Id
+	Lang uint16
+	Info *versioninfo.VersionInfo
+}
+
+// Returns the dialog with the given name, in any language.
+func (s *Script) Dialog(name res.Id) (*Dialog, bool) {
+	for i := range s.Dialogs {
+		if s.Dialogs[i].Name.Equals(name) {
+			return &s.Dialogs[i], true
+		}
+	}
+	return nil, false
+}
+
+// Returns the menu with the given name, in any language.
+func (s *Script) Menu(name res.Id) (*Menu, bool) {
+	for i := range s.Menus {
+		if s.Menus[i].Name.Equals(name) {
+			return &s.Menus[i], true
+		}
+	}
+	return nil, false
+}
+
+// Returns the string table of the given language, appending a new one if it
+// doesn't exist.
+//
+// Note that the returned pointer is invalidated when another table is added.
+func (s *Script) StringTable(lang uint16) *StringTable {
+	for i := range s.StringTables {
+		if s.StringTables[i].Lang == lang {
+			return &s.StringTables[i]
+		}
+	}
+	s.StringTables = append(s.StringTables, StringTable{
+		Lang:    lang,
+		Strings: make(map[uint16]string),
+	})
+	return &s.StringTables[len(s.StringTables)-1]
+}
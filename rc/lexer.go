@@ -0,0 +1,596 @@
+package rc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Kind of a _Token.
+type _TOK uint8
+
+const (
+	_TOK_IDENT _TOK = iota
+	_TOK_NUMBER
+	_TOK_STRING
+	_TOK_PUNCT
+)
+
+// A token of a resource script.
+type _Token struct {
+	kind _TOK
+	text string // identifier, punctuation, or the string as written, without quotes
+	num  int64  // numbers only
+	long bool   // numbers with the L suffix, or L"" strings
+	str  string // strings only, with the escape sequences resolved
+	file string // file of the token, empty for the script itself
+	line int    // 1-based line of the token
+}
+
+func (t *_Token) String() string {
+	if t.kind == _TOK_STRING {
+		return `"` + t.text + `"`
+	}
+	return t.text
+}
+
+// Headers of the Windows SDK, which are not loaded, because their common
+// symbols are built in.
+var _sdkHeaders = map[string]struct{}{
+	"afxres.h": {}, "commctrl.h": {}, "dlgs.h": {}, "richedit.h": {}, "verrsrc.h": {},
+	"windows.h": {}, "winres.h": {}, "winresrc.h": {}, "winuser.h": {}, "winver.h": {},
+}
+
+// Runs the preprocessor over the script and its included files, producing the
+// tokens of the resource statements, and collecting the #define symbols.
+type _Preprocessor struct {
+	load       func(path string) ([]byte, error)
+	defines    map[string]int64    // integer symbols
+	strDefines map[string]string   // string symbols
+	others     map[string]struct{} // symbols without a usable value, like macros with arguments
+	toks       []_Token
+	depth      int // nesting of #include
+}
+
+// A #if, #ifdef or #ifndef block.
+type _Cond struct {
+	parentActive bool // whether the enclosing block is active
+	active       bool // whether the lines of the current branch are used
+	taken        bool // whether a branch was already active
+	hasElse      bool // whether #else was already seen
+}
+
+func (pp *_Preprocessor) isDefined(name string) bool {
+	_, isInt := pp.defines[name]
+	_, isStr := pp.strDefines[name]
+	_, isOther := pp.others[name]
+	return isInt || isStr || isOther
+}
+
+func (pp *_Preprocessor) undefine(name string) {
+	delete(pp.defines, name)
+	delete(pp.strDefines, name)
+	delete(pp.others, name)
+}
+
+// Preprocesses a file. Headers, like resource.h, only have their directives
+// processed; any other lines are ignored.
+func (pp *_Preprocessor) run(src []byte, fileName string, isHeader bool) error {
+	text := stripComments(decodeText(src))
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	var conds []_Cond
+	isActive := func() bool { return len(conds) == 0 || conds[len(conds)-1].active }
+
+	for i := 0; i < len(lines); i++ {
+		lineNo := i + 1
+		line := lines[i]
+		for strings.HasSuffix(line, "\\") && i+1 < len(lines) { // line continuation
+			i++
+			line = line[:len(line)-1] + " " + lines[i]
+		}
+		errorf := func(format string, args ...interface{}) error {
+			return fmt.Errorf("%s: %s", position(fileName, lineNo), fmt.Sprintf(format, args...))
+		}
+
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "#") {
+			if isActive() && !isHeader && trimmed != "" {
+				toks, err := tokenize(line, fileName, lineNo)
+				if err != nil {
+					return err
+				}
+				pp.toks = append(pp.toks, toks...)
+			}
+			continue
+		}
+
+		directive, rest := splitWord(strings.TrimSpace(trimmed[1:]))
+		switch directive {
+		case "if", "ifdef", "ifndef":
+			cond := _Cond{parentActive: isActive()}
+			if cond.parentActive {
+				var err error
+				if cond.active, err = pp.evalCond(directive, rest, fileName, lineNo); err != nil {
+					return err
+				}
+				cond.taken = cond.active
+			}
+			conds = append(conds, cond)
+		case "elif":
+			if len(conds) == 0 {
+				return errorf("#elif without #if")
+			}
+			cond := &conds[len(conds)-1]
+			if cond.hasElse {
+				return errorf("#elif after #else")
+			}
+			cond.active = false
+			if cond.parentActive && !cond.taken {
+				var err error
+				if cond.active, err = pp.evalCond("if", rest, fileName, lineNo); err != nil {
+					return err
+				}
+				cond.taken = cond.active
+			}
+		case "else":
+			if len(conds) == 0 {
+				return errorf("#else without #if")
+			}
+			cond := &conds[len(conds)-1]
+			if cond.hasElse {
+				return errorf("#else after #else")
+			}
+			cond.active = cond.parentActive && !cond.taken
+			cond.taken = true
+			cond.hasElse = true
+		case "endif":
+			if len(conds) == 0 {
+				return errorf("#endif without #if")
+			}
+			conds = conds[:len(conds)-1]
+		default:
+			if !isActive() {
+				continue
+			}
+			if err := pp.directive(directive, rest, fileName, lineNo); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(conds) > 0 {
+		return fmt.Errorf("%s: missing #endif", position(fileName, len(lines)))
+	}
+	return nil
+}
+
+// Processes the directives which don't change the conditional blocks.
+func (pp *_Preprocessor) directive(directive, rest, fileName string, lineNo int) error {
+	errorf := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%s: %s", position(fileName, lineNo), fmt.Sprintf(format, args...))
+	}
+
+	switch directive {
+	case "define":
+		name, value := splitWord(rest)
+		if name == "" {
+			return errorf("missing #define name")
+		} else if idx := strings.IndexByte(name, '('); idx != -1 { // macro with arguments
+			pp.undefine(name[:idx])
+			pp.others[name[:idx]] = struct{}{}
+			return nil
+		}
+
+		pp.undefine(name)
+		toks, err := tokenize(value, fileName, lineNo)
+		if err != nil {
+			return err
+		}
+		if len(toks) == 1 && toks[0].kind == _TOK_STRING {
+			pp.strDefines[name] = toks[0].str
+			return nil
+		}
+		c := _Cursor{toks: toks, lookup: pp.lookup}
+		n, err := c.expr()
+		var evalErr _EvalError
+		if errors.As(err, &evalErr) {
+			return evalErr.error // a valid expression, like 1/0, which can't be evaluated
+		} else if err == nil && c.atEnd() {
+			pp.defines[name] = n
+		} else {
+			pp.others[name] = struct{}{} // empty, or not an integer
+		}
+
+	case "undef":
+		name, _ := splitWord(rest)
+		pp.undefine(name)
+
+	case "include":
+		rest = strings.TrimSpace(rest)
+		if len(rest) < 2 {
+			return errorf("invalid #include")
+		}
+		isSystem := rest[0] == '<'
+		incPath := strings.ReplaceAll(strings.Trim(rest, `<>"`), "\\", "/")
+		if _, isSdk := _sdkHeaders[strings.ToLower(path.Base(incPath))]; isSdk {
+			return nil
+		}
+
+		incPath = path.Join(path.Dir(fileName), incPath)
+		src, err := pp.loadFile(incPath)
+		if err != nil {
+			if isSystem {
+				return nil // other headers of the search path, like those of the SDK
+			}
+			return errorf("%s", err.Error())
+		}
+		if pp.depth++; pp.depth > 32 {
+			return errorf("#include nested too deeply")
+		}
+		ext := strings.ToLower(path.Ext(incPath))
+		err = pp.run(src, incPath, ext == ".h" || ext == ".hpp" || ext == ".c")
+		pp.depth--
+		return err
+
+	case "error":
+		return errorf("#error %s", rest)
+	}
+	return nil // #pragma and others are ignored
+}
+
+func (pp *_Preprocessor) loadFile(path string) ([]byte, error) {
+	if pp.load == nil {
+		return nil, fmt.Errorf("cannot load %s", path)
+	}
+	return pp.load(path)
+}
+
+// Resolves a symbol in a #define or a resource statement.
+func (pp *_Preprocessor) lookup(name string) (int64, bool) {
+	if n, ok := pp.defines[name]; ok {
+		return n, true
+	}
+	n, ok := _builtins[name]
+	return n, ok
+}
+
+// Resolves a string symbol, like a window class name.
+func (pp *_Preprocessor) lookupStr(name string) (string, bool) {
+	if s, ok := pp.strDefines[name]; ok {
+		return s, true
+	} else if pp.isDefined(name) {
+		return "", false
+	}
+	s, ok := _builtinStrs[name]
+	return s, ok
+}
+
+// Evaluates the condition of #if, #ifdef or #ifndef.
+func (pp *_Preprocessor) evalCond(directive, rest, fileName string, lineNo int) (bool, error) {
+	if directive != "if" {
+		name, _ := splitWord(rest)
+		return pp.isDefined(name) == (directive == "ifdef"), nil
+	}
+
+	toks, err := tokenize(rest, fileName, lineNo)
+	if err != nil {
+		return false, err
+	}
+
+	// Replace defined(X) and defined X with 0 or 1.
+	replaced := make([]_Token, 0, len(toks))
+	for i := 0; i < len(toks); i++ {
+		tok := toks[i]
+		if tok.kind == _TOK_IDENT && tok.text == "defined" {
+			j := i + 1
+			paren := j < len(toks) && toks[j].kind == _TOK_PUNCT && toks[j].text == "("
+			if paren {
+				j++
+			}
+			if j >= len(toks) || toks[j].kind != _TOK_IDENT {
+				return false, fmt.Errorf("%s: invalid defined()", position(fileName, lineNo))
+			}
+			tok.kind, tok.text, tok.num = _TOK_NUMBER, "0", 0
+			if pp.isDefined(toks[j].text) {
+				tok.text, tok.num = "1", 1
+			}
+			if paren {
+				j++
+			}
+			i = j
+		}
+		replaced = append(replaced, tok)
+	}
+
+	c := _Cursor{
+		toks: replaced,
+		lookup: func(name string) (int64, bool) {
+			n, _ := pp.lookup(name)
+			return n, true // undefined symbols are zero
+		},
+	}
+	n, err := c.expr()
+	if err != nil {
+		return false, err
+	} else if !c.atEnd() {
+		return false, c.errorf("unexpected %s in #if", c.peek())
+	}
+	return n != 0, nil
+}
+
+// Returns "line 10", or "resource.h, line 10" for included files.
+func position(fileName string, lineNo int) string {
+	if fileName == "" {
+		return fmt.Sprintf("line %d", lineNo)
+	}
+	return fmt.Sprintf("%s, line %d", fileName, lineNo)
+}
+
+// Splits the first word, delimited by spaces, from the rest.
+func splitWord(s string) (string, string) {
+	s = strings.TrimSpace(s)
+	if idx := strings.IndexAny(s, " \t"); idx != -1 {
+		return s[:idx], strings.TrimSpace(s[idx+1:])
+	}
+	return s, ""
+}
+
+// Decodes a script encoded as UTF-16 or UTF-8 with BOM, or as UTF-8 or ANSI
+// without BOM.
+func decodeText(src []byte) string {
+	if bytes.HasPrefix(src, []byte{0xff, 0xfe}) {
+		return decodeUtf16(src[2:], binary.LittleEndian)
+	} else if bytes.HasPrefix(src, []byte{0xfe, 0xff}) {
+		return decodeUtf16(src[2:], binary.BigEndian)
+	} else if bytes.HasPrefix(src, []byte{0xef, 0xbb, 0xbf}) {
+		return string(src[3:])
+	} else if utf8.Valid(src) {
+		return string(src)
+	}
+
+	runes := make([]rune, len(src)) // Latin-1
+	for i, b := range src {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+func decodeUtf16(src []byte, order binary.ByteOrder) string {
+	chars := make([]uint16, len(src)/2)
+	for i := range chars {
+		chars[i] = order.Uint16(src[i*2:])
+	}
+	return string(utf16.Decode(chars))
+}
+
+// Replaces the comments with spaces, keeping the line breaks, so the line
+// numbers are preserved.
+func stripComments(text string) string {
+	var buf strings.Builder
+	buf.Grow(len(text))
+	inString := false
+	for i := 0; i < len(text); i++ {
+		ch := text[i]
+		switch {
+		case inString:
+			buf.WriteByte(ch)
+			if ch == '\\' && i+1 < len(text) && text[i+1] != '\n' {
+				i++
+				buf.WriteByte(text[i])
+			} else if ch == '"' || ch == '\n' {
+				inString = false
+			}
+		case ch == '"':
+			inString = true
+			buf.WriteByte(ch)
+		case ch == '/' && i+1 < len(text) && text[i+1] == '/':
+			for i < len(text) && text[i] != '\n' {
+				i++
+			}
+			if i < len(text) {
+				buf.WriteByte('\n')
+			}
+		case ch == '/' && i+1 < len(text) && text[i+1] == '*':
+			i += 2
+			for i < len(text) && !(text[i] == '*' && i+1 < len(text) && text[i+1] == '/') {
+				if text[i] == '\n' {
+					buf.WriteByte('\n')
+				}
+				i++
+			}
+			i++ // skip the slash
+			buf.WriteByte(' ')
+		default:
+			buf.WriteByte(ch)
+		}
+	}
+	return buf.String()
+}
+
+var _twoCharPuncts = []string{"||", "&&", "==", "!=", "<=", ">=", "<<", ">>"}
+
+// Splits a line into tokens.
+func tokenize(line, fileName string, lineNo int) ([]_Token, error) {
+	var toks []_Token
+	for i := 0; i < len(line); {
+		ch := line[i]
+		tok := _Token{file: fileName, line: lineNo}
+
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\r' || ch == '\f' || ch == '\v':
+			i++
+			continue
+
+		case ch == '"' || (ch == 'L' && i+1 < len(line) && line[i+1] == '"'):
+			if ch == 'L' {
+				tok.long = true
+				i++
+			}
+			start := i + 1
+			end, err := scanString(line, start)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s", position(fileName, lineNo), err.Error())
+			}
+			tok.kind = _TOK_STRING
+			tok.text = line[start:end]
+			tok.str = unescape(tok.text)
+			i = end + 1
+
+		case isDigit(ch):
+			start := i
+			if ch == '0' && i+1 < len(line) && (line[i+1] == 'x' || line[i+1] == 'X') {
+				i += 2
+				for i < len(line) && isHexDigit(line[i]) {
+					i++
+				}
+			} else {
+				for i < len(line) && isDigit(line[i]) {
+					i++
+				}
+			}
+			digits := line[start:i]
+			for i < len(line) && strings.IndexByte("lLuU", line[i]) != -1 {
+				tok.long = tok.long || line[i] == 'l' || line[i] == 'L'
+				i++
+			}
+			var n uint64
+			var err error
+			if len(digits) > 2 && (digits[1] == 'x' || digits[1] == 'X') {
+				n, err = strconv.ParseUint(digits[2:], 16, 64)
+			} else {
+				n, err = strconv.ParseUint(digits, 10, 64) // leading zeros are not octal
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%s: invalid number %q", position(fileName, lineNo), digits)
+			}
+			tok.kind = _TOK_NUMBER
+			tok.text = line[start:i]
+			tok.num = int64(n)
+
+		case isIdentStart(ch):
+			start := i
+			for i < len(line) && (isIdentStart(line[i]) || isDigit(line[i])) {
+				i++
+			}
+			tok.kind = _TOK_IDENT
+			tok.text = line[start:i]
+
+		default:
+			tok.kind = _TOK_PUNCT
+			for _, p := range _twoCharPuncts {
+				if strings.HasPrefix(line[i:], p) {
+					tok.text = p
+					break
+				}
+			}
+			if tok.text == "" {
+				if strings.IndexByte(",|&^+-*/%~!(){}<>=", ch) == -1 {
+					return nil, fmt.Errorf("%s: unexpected character %q", position(fileName, lineNo), ch)
+				}
+				tok.text = string(ch)
+			}
+			i += len(tok.text)
+		}
+
+		toks = append(toks, tok)
+	}
+	return toks, nil
+}
+
+// Returns the index of the closing quote of a string. Quotes are escaped by
+// doubling them, or with a backslash.
+func scanString(line string, start int) (int, error) {
+	for i := start; i < len(line); i++ {
+		if line[i] == '\\' {
+			i++
+		} else if line[i] == '"' {
+			if i+1 < len(line) && line[i+1] == '"' {
+				i++ // doubled quote
+			} else {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("unterminated string")
+}
+
+// Resolves the escape sequences of a string, as written between quotes.
+func unescape(s string) string {
+	if !strings.ContainsAny(s, `\"`) {
+		return s
+	}
+
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if ch == '"' { // doubled quote
+			buf.WriteByte('"')
+			i++
+			continue
+		} else if ch != '\\' || i+1 == len(s) {
+			buf.WriteByte(ch)
+			continue
+		}
+
+		i++
+		switch esc := s[i]; esc {
+		case 'n':
+			buf.WriteByte('\n')
+		case 'r':
+			buf.WriteByte('\r')
+		case 't':
+			buf.WriteByte('\t')
+		case 'a':
+			buf.WriteByte('\a')
+		case 'b':
+			buf.WriteByte('\b')
+		case 'f':
+			buf.WriteByte('\f')
+		case 'v':
+			buf.WriteByte('\v')
+		case '\\', '"', '\'', '?':
+			buf.WriteByte(esc)
+		case 'x', 'X':
+			j := i + 1
+			for j < len(s) && j < i+5 && isHexDigit(s[j]) {
+				j++
+			}
+			n, _ := strconv.ParseUint(s[i+1:j], 16, 32)
+			buf.WriteRune(rune(n))
+			i = j - 1
+		default:
+			if esc >= '0' && esc <= '7' {
+				j := i
+				for j < len(s) && j < i+3 && s[j] >= '0' && s[j] <= '7' {
+					j++
+				}
+				n, _ := strconv.ParseUint(s[i:j], 8, 32)
+				buf.WriteRune(rune(n))
+				i = j - 1
+			} else {
+				buf.WriteByte('\\') // unknown escapes are kept
+				buf.WriteByte(esc)
+			}
+		}
+	}
+	return buf.String()
+}
+
+func isDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
+}
+
+func isHexDigit(ch byte) bool {
+	return isDigit(ch) || (ch >= 'a' && ch <= 'f') || (ch >= 'A' && ch <= 'F')
+}
+
+func isIdentStart(ch byte) bool {
+	return ch == '_' || (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z')
+}
@@ -0,0 +1,305 @@
+package rc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Reads an .rc file and parses it with Parse(). The included files and the
+// files of the resources are loaded relative to the folder of the script.
+func ParseFile(scriptPath string) (*Script, error) {
+	src, err := os.ReadFile(scriptPath)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Dir(scriptPath)
+	return Parse(src, func(path string) ([]byte, error) {
+		return os.ReadFile(filepath.Join(dir, filepath.FromSlash(path)))
+	})
+}
+
+// Parses an .rc resource script, encoded as UTF-16 or UTF-8 with BOM, or as
+// UTF-8 or ANSI without BOM.
+//
+// The load function is called to read the files referred by #include, and by
+// resources like ICON, with slash-separated paths relative to the script. It
+// can be nil if there are no such files. The headers of the Windows SDK, like
+// windows.h, are not loaded, because their common symbols are built in.
+//
+// Resources are stored in LANG_EN_US, unless a LANGUAGE statement is given.
+// String names are converted to uppercase, like rc.exe does.
+func Parse(src []byte, load func(path string) ([]byte, error)) (*Script, error) {
+	pp := _Preprocessor{
+		load:       load,
+		defines:    map[string]int64{"RC_INVOKED": 1, "_WIN32": 1},
+		strDefines: make(map[string]string),
+		others:     make(map[string]struct{}),
+	}
+	if err := pp.run(src, "", false); err != nil {
+		return nil, err
+	}
+	delete(pp.defines, "RC_INVOKED")
+	delete(pp.defines, "_WIN32")
+
+	p := _Parser{
+		_Cursor: _Cursor{toks: pp.toks, lookup: pp.lookup},
+		pp:      &pp,
+		script:  &Script{Defines: pp.defines},
+		lang:    0x0409, // LANG_ENGLISH, SUBLANG_ENGLISH_US
+	}
+	for !p.atEnd() {
+		if err := p.statement(); err != nil {
+			return nil, err
+		}
+	}
+	return p.script, nil
+}
+
+// Iterates over the tokens, evaluating expressions.
+type _Cursor struct {
+	toks   []_Token
+	pos    int
+	lookup func(name string) (int64, bool) // resolves symbols in expressions
+}
+
+func (c *_Cursor) atEnd() bool {
+	return c.pos >= len(c.toks)
+}
+
+// Returns the current token, or nil at the end.
+func (c *_Cursor) peek() *_Token {
+	if c.atEnd() {
+		return nil
+	}
+	return &c.toks[c.pos]
+}
+
+func (c *_Cursor) next() *_Token {
+	tok := c.peek()
+	if tok != nil {
+		c.pos++
+	}
+	return tok
+}
+
+func (c *_Cursor) isPunct(punct string) bool {
+	tok := c.peek()
+	return tok != nil && tok.kind == _TOK_PUNCT && tok.text == punct
+}
+
+func (c *_Cursor) accept(punct string) bool {
+	if c.isPunct(punct) {
+		c.pos++
+		return true
+	}
+	return false
+}
+
+// Tells whether the current token is the given case-insensitive keyword.
+func (c *_Cursor) isKeyword(keyword string) bool {
+	tok := c.peek()
+	return tok != nil && tok.kind == _TOK_IDENT && strings.EqualFold(tok.text, keyword)
+}
+
+func (c *_Cursor) acceptKeyword(keyword string) bool {
+	if c.isKeyword(keyword) {
+		c.pos++
+		return true
+	}
+	return false
+}
+
+// Returns an error at the position of the current token.
+func (c *_Cursor) errorf(format string, args ...interface{}) error {
+	var tok *_Token
+	if !c.atEnd() {
+		tok = &c.toks[c.pos]
+	} else if len(c.toks) > 0 {
+		tok = &c.toks[len(c.toks)-1]
+	}
+	msg := fmt.Sprintf(format, args...)
+	if tok == nil {
+		return fmt.Errorf("%s", msg)
+	}
+	return fmt.Errorf("%s: %s", position(tok.file, tok.line), msg)
+}
+
+func (c *_Cursor) expect(punct string) error {
+	if !c.accept(punct) {
+		return c.unexpected("'" + punct + "'")
+	}
+	return nil
+}
+
+func (c *_Cursor) unexpected(expected string) error {
+	if c.atEnd() {
+		return c.errorf("unexpected end of file, expected %s", expected)
+	}
+	return c.errorf("unexpected %s, expected %s", c.peek(), expected)
+}
+
+// Binary operators and their precedence, like in C.
+var _binaryOps = map[string]int{
+	"||": 1, "&&": 2, "|": 3, "^": 4, "&": 5,
+	"==": 6, "!=": 6, "<": 7, ">": 7, "<=": 7, ">=": 7,
+	"<<": 8, ">>": 8, "+": 9, "-": 9, "*": 10, "/": 10, "%": 10,
+}
+
+// An error in the evaluation of a well-formed expression, like a division by
+// zero.
+type _EvalError struct{ error }
+
+// Parses an integer expression.
+func (c *_Cursor) expr() (int64, error) {
+	return c.binary(1)
+}
+
+// Parses a binary expression whose operators have at least the given
+// precedence.
+func (c *_Cursor) binary(minPrec int) (int64, error) {
+	lhs, err := c.unary()
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		tok := c.peek()
+		if tok == nil || tok.kind != _TOK_PUNCT {
+			return lhs, nil
+		}
+		prec, isOp := _binaryOps[tok.text]
+		if !isOp || prec < minPrec {
+			return lhs, nil
+		}
+		c.pos++
+
+		rhs, err := c.binary(prec + 1)
+		if err != nil {
+			return 0, err
+		}
+		if lhs, err = applyOp(tok.text, lhs, rhs); err != nil {
+			return 0, _EvalError{c.errorf("%s", err.Error())}
+		}
+	}
+}
+
+func applyOp(op string, a, b int64) (int64, error) {
+	boolInt := func(v bool) int64 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	switch op {
+	case "||":
+		return boolInt(a != 0 || b != 0), nil
+	case "&&":
+		return boolInt(a != 0 && b != 0), nil
+	case "|":
+		return a | b, nil
+	case "^":
+		return a ^ b, nil
+	case "&":
+		return a & b, nil
+	case "==":
+		return boolInt(a == b), nil
+	case "!=":
+		return boolInt(a != b), nil
+	case "<":
+		return boolInt(a < b), nil
+	case ">":
+		return boolInt(a > b), nil
+	case "<=":
+		return boolInt(a <= b), nil
+	case ">=":
+		return boolInt(a >= b), nil
+	case "<<":
+		return a << uint(b), nil
+	case ">>":
+		return a >> uint(b), nil
+	case "+":
+		return a + b, nil
+	case "-":
+		return a - b, nil
+	case "*":
+		return a * b, nil
+	}
+	if b == 0 {
+		return 0, fmt.Errorf("division by zero")
+	} else if op == "/" {
+		return a / b, nil
+	}
+	return a % b, nil
+}
+
+func (c *_Cursor) unary() (int64, error) {
+	tok := c.next()
+	if tok == nil {
+		return 0, c.errorf("unexpected end of file, expected a number")
+	}
+
+	switch tok.kind {
+	case _TOK_NUMBER:
+		return tok.num, nil
+	case _TOK_IDENT:
+		if strings.EqualFold(tok.text, "NOT") {
+			n, err := c.unary()
+			return ^n, err
+		}
+		n, ok := c.lookup(tok.text)
+		if !ok {
+			c.pos--
+			return 0, c.errorf("undefined symbol %s", tok.text)
+		}
+		return n, nil
+	case _TOK_PUNCT:
+		switch tok.text {
+		case "(":
+			n, err := c.expr()
+			if err != nil {
+				return 0, err
+			}
+			return n, c.expect(")")
+		case "-", "~", "!", "+":
+			n, err := c.unary()
+			switch tok.text {
+			case "-":
+				n = -n
+			case "~":
+				n = ^n
+			case "!":
+				if n == 0 {
+					n = 1
+				} else {
+					n = 0
+				}
+			}
+			return n, err
+		}
+	}
+	c.pos--
+	return 0, c.unexpected("a number")
+}
+
+// Parses a style expression, which is ORed with the default style. Terms
+// preceded by NOT remove their bits, like WS_CHILD | NOT WS_VISIBLE.
+func (c *_Cursor) style(defStyle uint32) (uint32, error) {
+	style := defStyle
+	for {
+		clear := c.acceptKeyword("NOT")
+		n, err := c.binary(_binaryOps["|"] + 1)
+		if err != nil {
+			return 0, err
+		}
+		if clear {
+			style &^= uint32(n)
+		} else {
+			style |= uint32(n)
+		}
+		if !c.accept("|") {
+			return style, nil
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package rc
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/rodrigocfd/windigo/res"
+)
+
+// Reads the compiled testdata/app.res.
+//
+// It was generated from testdata/app.rc with cpp and llvm-rc 14. Since this
+// version doesn't support MENUEX, the menu was written as raw RT_MENU data,
+// encoded by hand from the MENUEX_TEMPLATE_HEADER and MENUEX_TEMPLATE_ITEM
+// documentation.
+func golden(t *testing.T) *res.File {
+	t.Helper()
+	data, err := os.ReadFile("testdata/app.res")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := res.ParseRes(data)
+	if err != nil {
+		t.Fatalf("ParseRes: %v", err)
+	}
+	return file
+}
+
+// Checks that both files have the same resources, in any order.
+func sameResources(t *testing.T, got, want *res.File) {
+	t.Helper()
+	if len(got.Resources) != len(want.Resources) {
+		t.Errorf("%d resources, want %d", len(got.Resources), len(want.Resources))
+	}
+	for _, w := range want.Resources {
+		g, ok := got.Find(w.Type, w.Name, w.Lang)
+		if !ok {
+			t.Errorf("resource %s/%s/%04x not found", w.Type, w.Name, w.Lang)
+		} else if !bytes.Equal(g.Data, w.Data) {
+			t.Errorf("resource %s/%s/%04x\ngot  % x\nwant % x", w.Type, w.Name, w.Lang, g.Data, w.Data)
+		}
+	}
+}
+
+func TestGolden(t *testing.T) {
+	script, err := ParseFile("testdata/app.rc")
+	if err != nil {
+		t.Fatalf("ParseFile: %v", err)
+	}
+
+	for name, val := range map[string]int64{
+		"IDD_MAIN":   101,  // from resource.h
+		"IDC_LIST":   1002, // expression
+		"IDC_CHECK":  1010, // redefined after #undef
+		"MAIN_WIDTH": 200,  // from app.rc
+	} {
+		if got, ok := script.Defines[name]; !ok || got != val {
+			t.Errorf("Defines[%s] = %d %v, want %d", name, got, ok, val)
+		}
+	}
+	if _, ok := script.Defines["VS_VERSION_INFO"]; ok {
+		t.Errorf("built-in symbols should not be in Defines")
+	}
+
+	file, err := script.File()
+	if err != nil {
+		t.Fatalf("File: %v", err)
+	}
+	sameResources(t, file, golden(t))
+
+	data, err := script.ResBytes()
+	if err != nil {
+		t.Fatalf("ResBytes: %v", err)
+	}
+	again, err := res.ParseRes(data)
+	if err != nil {
+		t.Fatalf("ParseRes: %v", err)
+	}
+	sameResources(t, again, file)
+}
+
+func TestFromFile(t *testing.T) {
+	want, err := ParseFile("testdata/app.rc")
+	if err != nil {
+		t.Fatalf("ParseFile: %v", err)
+	}
+	got := FromFile(golden(t))
+
+	if len(got.Defines) != 0 {
+		t.Errorf("Defines should be empty, got %v", got.Defines)
+	}
+	if len(got.Others) != 0 {
+		t.Errorf("%d resources were not decoded", len(got.Others))
+	}
+	if !reflect.DeepEqual(got.Dialogs, want.Dialogs) {
+		t.Errorf("Dialogs\ngot  %+v\nwant %+v", got.Dialogs, want.Dialogs)
+	}
+	if !reflect.DeepEqual(got.Menus, want.Menus) {
+		t.Errorf("Menus\ngot  %+v\nwant %+v", got.Menus, want.Menus)
+	}
+	if !reflect.DeepEqual(got.Accelerators, want.Accelerators) {
+		t.Errorf("Accelerators\ngot  %+v\nwant %+v", got.Accelerators, want.Accelerators)
+	}
+	if !reflect.DeepEqual(got.StringTables, want.StringTables) {
+		t.Errorf("StringTables\ngot  %+v\nwant %+v", got.StringTables, want.StringTables)
+	}
+	if !reflect.DeepEqual(got.VersionInfos, want.VersionInfos) {
+		t.Errorf("VersionInfos\ngot  %+v\nwant %+v", got.VersionInfos, want.VersionInfos)
+	}
+}
+
+func TestParse(t *testing.T) {
+	script, err := ParseFile("testdata/app.rc")
+	if err != nil {
+		t.Fatalf("ParseFile: %v", err)
+	}
+
+	dlg, ok := script.Dialog(res.IdInt(101))
+	if !ok {
+		t.Fatalf("dialog not found")
+	}
+	if dlg.Template.Title != "Windigo – test" || dlg.Template.Font.Typeface != "Segoe UI" {
+		t.Errorf("dialog title %q, font %q", dlg.Template.Title, dlg.Template.Font.Typeface)
+	}
+	if n := len(dlg.Template.Items); n != 7 {
+		t.Fatalf("%d dialog items, want 7", n)
+	}
+	if class := dlg.Template.Items[3].Class; !class.Equals(res.IdStr("msctls_progress32")) {
+		t.Errorf("CONTROL class %s", class)
+	}
+
+	menu, ok := script.Menu(res.IdInt(102))
+	if !ok || len(menu.Template.Items) != 2 || len(menu.Template.Items[0].Items) != 3 {
+		t.Fatalf("bad menu: %+v", menu)
+	}
+
+	wantAccel := res.AccelTable{
+		{Key: 0x0f, Cmd: 40001}, // ^O
+		{Flags: res.ACCELF_VIRTKEY | res.ACCELF_CONTROL, Key: 'O', Cmd: 40001},
+		{Flags: res.ACCELF_VIRTKEY, Key: 0x70, Cmd: 40003}, // VK_F1
+		{Flags: res.ACCELF_VIRTKEY | res.ACCELF_ALT | res.ACCELF_SHIFT | res.ACCELF_NOINVERT, Key: 'X', Cmd: 40002},
+		{Key: '?', Cmd: 40003},
+	}
+	if len(script.Accelerators) != 1 || !reflect.DeepEqual(script.Accelerators[0].Table, wantAccel) {
+		t.Errorf("accelerators\ngot  %+v\nwant %+v", script.Accelerators, wantAccel)
+	}
+
+	wantStrings := []StringTable{
+		{0x0409, map[uint16]string{1: "Windigo", 2: "Hello, \"world\"\n", 300: "In another block"}},
+		{0x0416, map[uint16]string{1: "Aplicação"}},
+	}
+	if !reflect.DeepEqual(script.StringTables, wantStrings) {
+		t.Errorf("string tables\ngot  %+v\nwant %+v", script.StringTables, wantStrings)
+	}
+
+	if len(script.VersionInfos) != 1 {
+		t.Fatalf("%d VERSIONINFO, want 1", len(script.VersionInfos))
+	}
+	fixed := script.VersionInfos[0].Info.Fixed
+	if fixed.FileVersion != [4]uint16{1, 2, 0, 0} || fixed.FileFlags != 0 { // _DEBUG is not defined
+		t.Errorf("bad VERSIONINFO: %+v", fixed)
+	}
+}
+
+func TestStringDefines(t *testing.T) {
+	src := "#define APP \"Windigo\"\n" +
+		"#define ID_APP 1\n" +
+		"STRINGTABLE BEGIN\n" +
+		"ID_APP, APP\n" +
+		"2, APP \" app\"\n" +
+		"END\n"
+	script, err := Parse([]byte(src), nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	want := []StringTable{{0x0409, map[uint16]string{1: "Windigo", 2: "Windigo app"}}}
+	if !reflect.DeepEqual(script.StringTables, want) {
+		t.Errorf("got  %+v\nwant %+v", script.StringTables, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{"missing include", "#include \"missing.h\"\n"},
+		{"unterminated #if", "#if 1\n"},
+		{"unterminated string", "1 RCDATA BEGIN \"abc END\n"},
+		{"missing END", "STRINGTABLE BEGIN 1, \"a\"\n"},
+		{"unsupported DIALOG", "1 DIALOG 0, 0, 10, 10 BEGIN END\n"},
+		{"unknown control", "1 DIALOGEX 0, 0, 10, 10 BEGIN FOO 1, 0, 0, 1, 1 END\n"},
+		{"unknown accelerator option", "1 ACCELERATORS BEGIN \"a\", 1, FOO END\n"},
+		{"define division by zero", "#define A (1 / 0)\n"},
+		{"duplicate #else", "#if 0\n#else\n#else\n#endif\n"},
+		{"#elif after #else", "#if 0\n#else\n#elif 1\n#endif\n"},
+		{"bad version table", "1 VERSIONINFO BEGIN BLOCK \"StringFileInfo\" BEGIN BLOCK \"0409\" BEGIN END END END\n"},
+	}
+
+	load := func(path string) ([]byte, error) {
+		return nil, os.ErrNotExist
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse([]byte(tt.src), load); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
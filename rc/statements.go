@@ -0,0 +1,1029 @@
+package rc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/rodrigocfd/windigo/ico"
+	"github.com/rodrigocfd/windigo/res"
+	"github.com/rodrigocfd/windigo/versioninfo"
+)
+
+// Parses the resource statements.
+type _Parser struct {
+	_Cursor
+	pp     *_Preprocessor
+	script *Script
+	lang   uint16 // current language, set by top-level LANGUAGE statements
+}
+
+const (
+	_WS_CHILD   uint32 = 0x4000_0000
+	_WS_VISIBLE uint32 = 0x1000_0000
+	_WS_BORDER  uint32 = 0x0080_0000
+	_WS_CAPTION uint32 = 0x00c0_0000
+	_WS_POPUP   uint32 = 0x8000_0000
+	_WS_SYSMENU uint32 = 0x0008_0000
+	_WS_GROUP   uint32 = 0x0002_0000
+	_WS_TABSTOP uint32 = 0x0001_0000
+	_DS_SETFONT uint32 = 0x0000_0040
+
+	_MFT_SEPARATOR uint32 = 0x0000_0800
+)
+
+// Predefined controls of DIALOGEX, with their class and default style, as
+// produced by rc.exe.
+var _controls = map[string]struct {
+	class   uint16
+	style   uint32
+	hasText bool
+}{
+	"LTEXT":           {res.CLASS_STATIC, 0x0000 | _WS_GROUP, true}, // SS_LEFT
+	"CTEXT":           {res.CLASS_STATIC, 0x0001 | _WS_GROUP, true}, // SS_CENTER
+	"RTEXT":           {res.CLASS_STATIC, 0x0002 | _WS_GROUP, true}, // SS_RIGHT
+	"PUSHBUTTON":      {res.CLASS_BUTTON, 0x0000 | _WS_TABSTOP, true},
+	"DEFPUSHBUTTON":   {res.CLASS_BUTTON, 0x0001 | _WS_TABSTOP, true},
+	"CHECKBOX":        {res.CLASS_BUTTON, 0x0002 | _WS_TABSTOP, true},
+	"AUTOCHECKBOX":    {res.CLASS_BUTTON, 0x0003 | _WS_TABSTOP, true},
+	"RADIOBUTTON":     {res.CLASS_BUTTON, 0x0004, true},
+	"STATE3":          {res.CLASS_BUTTON, 0x0005 | _WS_TABSTOP, true},
+	"AUTO3STATE":      {res.CLASS_BUTTON, 0x0006 | _WS_TABSTOP, true},
+	"GROUPBOX":        {res.CLASS_BUTTON, 0x0007, true},
+	"AUTORADIOBUTTON": {res.CLASS_BUTTON, 0x0009, true},
+	"PUSHBOX":         {res.CLASS_BUTTON, 0x000a | _WS_TABSTOP, true},
+	"EDITTEXT":        {res.CLASS_EDIT, 0x0000 | _WS_BORDER | _WS_TABSTOP, false}, // ES_LEFT
+	"LISTBOX":         {res.CLASS_LISTBOX, 0x0001 | _WS_BORDER, false},            // LBS_NOTIFY
+	"SCROLLBAR":       {res.CLASS_SCROLLBAR, 0x0000, false},                       // SBS_HORZ
+	"COMBOBOX":        {res.CLASS_COMBOBOX, 0x0000, false},
+	"ICON":            {res.CLASS_STATIC, 0x0003, true}, // SS_ICON
+	"CONTROL":         {0, 0, true},                     // class and style are given
+}
+
+// Window classes which are stored as ordinals.
+var _classOrdinals = map[string]uint16{
+	"BUTTON":    res.CLASS_BUTTON,
+	"EDIT":      res.CLASS_EDIT,
+	"STATIC":    res.CLASS_STATIC,
+	"LISTBOX":   res.CLASS_LISTBOX,
+	"SCROLLBAR": res.CLASS_SCROLLBAR,
+	"COMBOBOX":  res.CLASS_COMBOBOX,
+}
+
+// Memory options, which are accepted after the resource type and ignored.
+var _memoryOptions = []string{"DISCARDABLE", "FIXED", "IMPURE", "LOADONCALL",
+	"MOVEABLE", "NONSHARED", "PRELOAD", "PURE", "SHARED"}
+
+// Parses a top-level statement.
+func (p *_Parser) statement() error {
+	if p.acceptKeyword("LANGUAGE") {
+		lang, err := p.language()
+		p.lang = lang
+		return err
+	} else if p.acceptKeyword("STRINGTABLE") {
+		return p.stringTable()
+	}
+
+	name, err := p.resName()
+	if err != nil {
+		return err
+	}
+
+	tok := p.next()
+	if tok == nil {
+		return p.unexpected("resource type")
+	}
+	switch tok.kind {
+	case _TOK_NUMBER:
+		return p.rawResource(name, res.IdInt(uint16(tok.num)), false)
+	case _TOK_STRING:
+		return p.rawResource(name, res.IdStr(strings.ToUpper(tok.str)), false)
+	case _TOK_IDENT:
+		switch keyword := strings.ToUpper(tok.text); keyword {
+		case "DIALOGEX":
+			return p.dialogEx(name)
+		case "MENUEX":
+			return p.menuEx(name)
+		case "ACCELERATORS":
+			return p.accelerators(name)
+		case "ICON":
+			return p.icon(name)
+		case "VERSIONINFO":
+			return p.versionInfo(name)
+		case "BITMAP":
+			return p.rawResource(name, res.RT_BITMAP.Id(), true)
+		case "RCDATA":
+			return p.rawResource(name, res.RT_RCDATA.Id(), false)
+		case "HTML":
+			return p.rawResource(name, res.RT_HTML.Id(), false)
+		case "DIALOG", "MENU", "CURSOR", "FONT", "MESSAGETABLE", "TOOLBAR":
+			p.pos--
+			return p.errorf("%s resources are not supported", keyword)
+		default:
+			if n, ok := p.lookup(tok.text); ok {
+				return p.rawResource(name, res.IdInt(uint16(n)), false)
+			}
+			return p.rawResource(name, res.IdStr(keyword), false)
+		}
+	}
+	p.pos--
+	return p.unexpected("resource type")
+}
+
+// Parses the name of a resource: an integer, or a string.
+func (p *_Parser) resName() (res.Id, error) {
+	tok := p.peek()
+	if tok == nil {
+		return res.Id{}, p.unexpected("resource name")
+	}
+	switch tok.kind {
+	case _TOK_STRING:
+		p.pos++
+		return res.IdStr(strings.ToUpper(tok.str)), nil
+	case _TOK_IDENT:
+		if _, ok := p.lookup(tok.text); !ok {
+			p.pos++
+			return res.IdStr(strings.ToUpper(tok.text)), nil
+		}
+	}
+	n, err := p.expr()
+	return res.IdInt(uint16(n)), err
+}
+
+// Parses the arguments of LANGUAGE.
+func (p *_Parser) language() (uint16, error) {
+	primary, err := p.expr()
+	if err != nil {
+		return 0, err
+	}
+	if err := p.expect(","); err != nil {
+		return 0, err
+	}
+	sub, err := p.expr()
+	return uint16(sub<<10 | primary), err
+}
+
+// Skips the memory options, then parses the optional statements before BEGIN,
+// returning the language of the resource.
+func (p *_Parser) options() (uint16, error) {
+	p.memoryOptions()
+	lang := p.lang
+	for {
+		if p.acceptKeyword("LANGUAGE") {
+			var err error
+			if lang, err = p.language(); err != nil {
+				return 0, err
+			}
+		} else if p.acceptKeyword("CHARACTERISTICS") || p.acceptKeyword("VERSION") {
+			if _, err := p.expr(); err != nil {
+				return 0, err
+			}
+		} else {
+			return lang, nil
+		}
+	}
+}
+
+func (p *_Parser) memoryOptions() {
+	for found := true; found; {
+		found = false
+		for _, opt := range _memoryOptions {
+			if p.acceptKeyword(opt) {
+				found = true
+			}
+		}
+	}
+}
+
+func (p *_Parser) begin() error {
+	if !p.acceptKeyword("BEGIN") && !p.accept("{") {
+		return p.unexpected("BEGIN")
+	}
+	return nil
+}
+
+func (p *_Parser) isBegin() bool {
+	return p.isKeyword("BEGIN") || p.isPunct("{")
+}
+
+// Accepts END, failing at the end of the file.
+func (p *_Parser) acceptEnd() (bool, error) {
+	if p.atEnd() {
+		return false, p.unexpected("END")
+	}
+	return p.acceptKeyword("END") || p.accept("}"), nil
+}
+
+// Parses a string, concatenating adjacent ones. String symbols, like
+// #define NAME "text", are expanded.
+func (p *_Parser) str() (string, error) {
+	s, ok := p.strPiece()
+	if !ok {
+		return "", p.unexpected("string")
+	}
+	var buf strings.Builder
+	for ok {
+		buf.WriteString(s)
+		p.pos++
+		s, ok = p.strPiece()
+	}
+	return buf.String(), nil
+}
+
+// Returns the text of the current token, if it's a string or a string symbol.
+func (p *_Parser) strPiece() (string, bool) {
+	tok := p.peek()
+	if tok == nil {
+		return "", false
+	} else if tok.kind == _TOK_IDENT {
+		return p.pp.lookupStr(tok.text)
+	}
+	return tok.str, tok.kind == _TOK_STRING
+}
+
+// Parses a file name, with backslashes converted to slashes, and loads it.
+func (p *_Parser) loadFile() ([]byte, error) {
+	tok := p.peek()
+	if tok == nil || tok.kind != _TOK_STRING {
+		return nil, p.unexpected("file name")
+	}
+	fileName := strings.ReplaceAll(strings.ReplaceAll(tok.text, `\\`, `\`), `\`, "/")
+	data, err := p.pp.loadFile(fileName)
+	if err != nil {
+		return nil, p.errorf("%s", err.Error())
+	}
+	p.pos++
+	return data, nil
+}
+
+// Parses comma-separated integer arguments.
+func (p *_Parser) ints(count int) ([]int64, error) {
+	nums := make([]int64, count)
+	for i := range nums {
+		if i > 0 {
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if nums[i], err = p.expr(); err != nil {
+			return nil, err
+		}
+	}
+	return nums, nil
+}
+
+// Parses up to count optional arguments, each one preceded by a comma, which
+// may be empty.
+func (p *_Parser) optionalInts(count int) ([]int64, error) {
+	nums := make([]int64, count)
+	for i := range nums {
+		if !p.accept(",") {
+			break
+		} else if p.isPunct(",") {
+			continue // empty argument
+		}
+		var err error
+		if nums[i], err = p.expr(); err != nil {
+			return nil, err
+		}
+	}
+	return nums, nil
+}
+
+// Parses a text which can also be an ordinal, like the icon of a static
+// control.
+func (p *_Parser) textOrOrdinal() (res.Id, error) {
+	tok := p.peek()
+	if tok == nil {
+		return res.Id{}, p.unexpected("text")
+	} else if tok.kind == _TOK_STRING {
+		s, err := p.str()
+		return res.IdStr(s), err
+	}
+	return p.resName()
+}
+
+// Parses a STRINGTABLE.
+func (p *_Parser) stringTable() error {
+	lang, err := p.options()
+	if err != nil {
+		return err
+	}
+	if err := p.begin(); err != nil {
+		return err
+	}
+
+	st := p.script.StringTable(lang)
+	for {
+		if isEnd, err := p.acceptEnd(); err != nil {
+			return err
+		} else if isEnd {
+			return nil
+		}
+
+		strId, err := p.expr()
+		if err != nil {
+			return err
+		}
+		p.accept(",")
+		s, err := p.str()
+		if err != nil {
+			return err
+		}
+		st.Strings[uint16(strId)] = s
+	}
+}
+
+// Parses a DIALOGEX.
+func (p *_Parser) dialogEx(name res.Id) error {
+	p.memoryOptions()
+	coords, err := p.ints(4)
+	if err != nil {
+		return err
+	}
+	helpId, err := p.optionalInts(1)
+	if err != nil {
+		return err
+	}
+
+	dlg := Dialog{
+		Name: name,
+		Lang: p.lang,
+		Template: res.Dialog{
+			HelpId: uint32(helpId[0]),
+			Style:  _WS_POPUP | _WS_BORDER | _WS_SYSMENU, // default if no STYLE is given
+			X:      int16(coords[0]),
+			Y:      int16(coords[1]),
+			Cx:     int16(coords[2]),
+			Cy:     int16(coords[3]),
+		},
+	}
+	tmpl := &dlg.Template
+	var extraStyle uint32 // added by CAPTION and FONT
+
+	for !p.isBegin() {
+		tok := p.next()
+		if tok == nil || tok.kind != _TOK_IDENT {
+			p.pos--
+			return p.unexpected("BEGIN")
+		}
+		switch strings.ToUpper(tok.text) {
+		case "STYLE":
+			tmpl.Style, err = p.style(0)
+		case "EXSTYLE":
+			tmpl.ExStyle, err = p.style(0)
+		case "CAPTION":
+			tmpl.Title, err = p.str()
+			extraStyle |= _WS_CAPTION
+		case "FONT":
+			err = p.dialogFont(&tmpl.Font)
+			extraStyle |= _DS_SETFONT
+		case "MENU":
+			tmpl.Menu, err = p.resName()
+		case "CLASS":
+			var class res.Id
+			class, err = p.textOrOrdinal()
+			tmpl.Class = class.String()
+		case "LANGUAGE":
+			dlg.Lang, err = p.language()
+		case "CHARACTERISTICS", "VERSION":
+			_, err = p.expr()
+		default:
+			p.pos--
+			return p.errorf("unknown DIALOGEX statement %s", tok.text)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	tmpl.Style |= extraStyle
+
+	p.begin()
+	for {
+		if isEnd, err := p.acceptEnd(); err != nil {
+			return err
+		} else if isEnd {
+			break
+		}
+		item, err := p.control()
+		if err != nil {
+			return err
+		}
+		tmpl.Items = append(tmpl.Items, item)
+	}
+
+	p.script.Dialogs = append(p.script.Dialogs, dlg)
+	return nil
+}
+
+// Parses the arguments of the FONT statement of DIALOGEX.
+func (p *_Parser) dialogFont(font *res.DialogFont) error {
+	pointSize, err := p.expr()
+	if err != nil {
+		return err
+	}
+	if err := p.expect(","); err != nil {
+		return err
+	}
+	if font.Typeface, err = p.str(); err != nil {
+		return err
+	}
+	opts, err := p.optionalInts(3)
+	if err != nil {
+		return err
+	}
+	font.PointSize = uint16(pointSize)
+	font.Weight = uint16(opts[0])
+	font.Italic = opts[1] != 0
+	font.CharSet = uint8(opts[2])
+	return nil
+}
+
+// Parses a control of a DIALOGEX.
+func (p *_Parser) control() (res.DialogItem, error) {
+	var item res.DialogItem
+	tok := p.next()
+	if tok == nil || tok.kind != _TOK_IDENT {
+		p.pos--
+		return item, p.unexpected("control")
+	}
+	keyword := strings.ToUpper(tok.text)
+	ctrl, ok := _controls[keyword]
+	if !ok {
+		p.pos--
+		return item, p.errorf("unknown control %s", tok.text)
+	}
+	item.Class = res.IdInt(ctrl.class)
+
+	var err error
+	if ctrl.hasText {
+		if item.Title, err = p.textOrOrdinal(); err != nil {
+			return item, err
+		}
+		if err := p.expect(","); err != nil {
+			return item, err
+		}
+	} else {
+		item.Title = res.IdStr("")
+	}
+
+	ctrlId, err := p.expr()
+	if err != nil {
+		return item, err
+	}
+	item.Id = uint32(ctrlId)
+	if err := p.expect(","); err != nil {
+		return item, err
+	}
+
+	defStyle := _WS_CHILD | _WS_VISIBLE | ctrl.style
+	var opts []int64 // x, y, cx, cy, exstyle, helpId
+	hasStyle := false
+
+	switch keyword {
+	case "CONTROL":
+		if item.Class, err = p.controlClass(); err != nil {
+			return item, err
+		}
+		if err := p.expect(","); err != nil {
+			return item, err
+		}
+		if item.Style, err = p.style(defStyle); err != nil {
+			return item, err
+		}
+		hasStyle = true
+		if err := p.expect(","); err != nil {
+			return item, err
+		}
+		coords, err := p.ints(4)
+		if err != nil {
+			return item, err
+		}
+		if opts, err = p.optionalInts(2); err != nil { // exstyle, helpId
+			return item, err
+		}
+		opts = append(coords, opts...)
+
+	case "ICON":
+		coords, err := p.ints(2)
+		if err != nil {
+			return item, err
+		}
+		if opts, err = p.optionalInts(2); err != nil { // cx, cy
+			return item, err
+		}
+		if p.accept(",") {
+			if item.Style, err = p.style(defStyle); err != nil {
+				return item, err
+			}
+			hasStyle = true
+		}
+		exStyle, err := p.optionalInts(1)
+		if err != nil {
+			return item, err
+		}
+		opts = append(coords, opts[0], opts[1], exStyle[0], 0)
+
+	default:
+		coords, err := p.ints(4)
+		if err != nil {
+			return item, err
+		}
+		if p.accept(",") {
+			if item.Style, err = p.style(defStyle); err != nil {
+				return item, err
+			}
+			hasStyle = true
+		}
+		if opts, err = p.optionalInts(2); err != nil { // exstyle, helpId
+			return item, err
+		}
+		opts = append(coords, opts...)
+	}
+
+	if !hasStyle {
+		item.Style = defStyle
+	}
+	item.X, item.Y, item.Cx, item.Cy = int16(opts[0]), int16(opts[1]), int16(opts[2]), int16(opts[3])
+	item.ExStyle = uint32(opts[4])
+	item.HelpId = uint32(opts[5])
+	return item, nil
+}
+
+// Parses the class of a CONTROL, converting the predefined ones to ordinals.
+func (p *_Parser) controlClass() (res.Id, error) {
+	tok := p.peek()
+	if tok == nil {
+		return res.Id{}, p.unexpected("window class")
+	}
+
+	var class string
+	switch tok.kind {
+	case _TOK_STRING:
+		class, _ = p.str()
+	case _TOK_IDENT:
+		if s, ok := p.pp.lookupStr(tok.text); ok {
+			class = s
+		} else if _, ok := _classOrdinals[strings.ToUpper(tok.text)]; ok {
+			class = tok.text
+		} else {
+			n, err := p.expr()
+			return res.IdInt(uint16(n)), err
+		}
+		p.pos++
+	default:
+		n, err := p.expr()
+		return res.IdInt(uint16(n)), err
+	}
+
+	if ord, ok := _classOrdinals[strings.ToUpper(class)]; ok {
+		return res.IdInt(ord), nil
+	}
+	return res.IdStr(class), nil
+}
+
+// Parses a MENUEX.
+func (p *_Parser) menuEx(name res.Id) error {
+	lang, err := p.options()
+	if err != nil {
+		return err
+	}
+	if err := p.begin(); err != nil {
+		return err
+	}
+	items, err := p.menuItems()
+	if err != nil {
+		return err
+	}
+	p.script.Menus = append(p.script.Menus, Menu{
+		Name:     name,
+		Lang:     lang,
+		Template: res.Menu{Items: items},
+	})
+	return nil
+}
+
+// Parses the MENUITEM and POPUP statements until END.
+func (p *_Parser) menuItems() ([]res.MenuItem, error) {
+	var items []res.MenuItem
+	for {
+		if isEnd, err := p.acceptEnd(); err != nil {
+			return nil, err
+		} else if isEnd {
+			return items, nil
+		}
+
+		var item res.MenuItem
+		if p.acceptKeyword("MENUITEM") {
+			if p.acceptKeyword("SEPARATOR") {
+				item.Type = _MFT_SEPARATOR
+			} else {
+				var err error
+				if item.Text, err = p.str(); err != nil {
+					return nil, err
+				}
+				opts, err := p.optionalInts(3)
+				if err != nil {
+					return nil, err
+				}
+				item.Id, item.Type, item.State = uint32(opts[0]), uint32(opts[1]), uint32(opts[2])
+			}
+		} else if p.acceptKeyword("POPUP") {
+			var err error
+			if item.Text, err = p.str(); err != nil {
+				return nil, err
+			}
+			opts, err := p.optionalInts(4)
+			if err != nil {
+				return nil, err
+			}
+			item.Id, item.Type, item.State = uint32(opts[0]), uint32(opts[1]), uint32(opts[2])
+			item.HelpId = uint32(opts[3])
+			if err := p.begin(); err != nil {
+				return nil, err
+			}
+			if item.Items, err = p.menuItems(); err != nil {
+				return nil, err
+			}
+		} else {
+			return nil, p.unexpected("MENUITEM or POPUP")
+		}
+		items = append(items, item)
+	}
+}
+
+// Parses an ACCELERATORS.
+func (p *_Parser) accelerators(name res.Id) error {
+	lang, err := p.options()
+	if err != nil {
+		return err
+	}
+	if err := p.begin(); err != nil {
+		return err
+	}
+
+	var table res.AccelTable
+	for {
+		if isEnd, err := p.acceptEnd(); err != nil {
+			return err
+		} else if isEnd {
+			break
+		}
+
+		var event string
+		var key int64
+		isString := p.peek().kind == _TOK_STRING
+		if isString {
+			event, _ = p.str()
+		} else if key, err = p.expr(); err != nil {
+			return err
+		}
+		if err := p.expect(","); err != nil {
+			return err
+		}
+		cmd, err := p.expr()
+		if err != nil {
+			return err
+		}
+
+		var flags res.ACCELF
+		for p.accept(",") {
+			tok := p.next()
+			if tok == nil || tok.kind != _TOK_IDENT {
+				p.pos--
+				return p.unexpected("accelerator option")
+			}
+			switch strings.ToUpper(tok.text) {
+			case "ASCII":
+			case "VIRTKEY":
+				flags |= res.ACCELF_VIRTKEY
+			case "NOINVERT":
+				flags |= res.ACCELF_NOINVERT
+			case "SHIFT":
+				flags |= res.ACCELF_SHIFT
+			case "CONTROL":
+				flags |= res.ACCELF_CONTROL
+			case "ALT":
+				flags |= res.ACCELF_ALT
+			default:
+				p.pos--
+				return p.errorf("unknown accelerator option %s", tok.text)
+			}
+		}
+
+		if isString {
+			if key, err = accelKey(event, flags); err != nil {
+				return p.errorf("%s", err.Error())
+			}
+		}
+		table = append(table, res.Accel{Flags: flags, Key: uint16(key), Cmd: uint16(cmd)})
+	}
+
+	p.script.Accelerators = append(p.script.Accelerators, Accelerators{name, lang, table})
+	return nil
+}
+
+// Converts the event string of an accelerator, like "a" or "^C", to a key.
+func accelKey(event string, flags res.ACCELF) (int64, error) {
+	chars := utf16.Encode([]rune(event))
+	if len(chars) == 2 && chars[0] == '^' && flags&res.ACCELF_VIRTKEY == 0 {
+		ch := chars[1]
+		if ch >= 'a' && ch <= 'z' {
+			ch -= 'a' - 'A'
+		}
+		if ch < 'A' || ch > 'Z' {
+			return 0, strconv.ErrSyntax
+		}
+		return int64(ch - '@'), nil // control character
+	} else if len(chars) != 1 {
+		return 0, strconv.ErrSyntax
+	}
+
+	ch := chars[0]
+	if flags&res.ACCELF_VIRTKEY != 0 && ch >= 'a' && ch <= 'z' {
+		ch -= 'a' - 'A' // virtual keys of letters are uppercase
+	}
+	return int64(ch), nil
+}
+
+// Parses an ICON, loading the .ico file.
+func (p *_Parser) icon(name res.Id) error {
+	p.memoryOptions()
+	data, err := p.loadFile()
+	if err != nil {
+		return err
+	}
+	icoFile, err := ico.Parse(data)
+	if err != nil {
+		return p.errorf("%s", err.Error())
+	} else if icoFile.Type != ico.TYPE_ICON {
+		return p.errorf("not an .ico file")
+	}
+	p.script.Icons = append(p.script.Icons, Icon{name, p.lang, icoFile})
+	return nil
+}
+
+// Parses a VERSIONINFO.
+func (p *_Parser) versionInfo(name res.Id) error {
+	vi := &versioninfo.VersionInfo{}
+	lang := p.lang
+
+	for !p.isBegin() {
+		tok := p.next()
+		if tok == nil || tok.kind != _TOK_IDENT {
+			p.pos--
+			return p.unexpected("BEGIN")
+		}
+		var err error
+		var n int64
+		switch strings.ToUpper(tok.text) {
+		case "FILEVERSION":
+			vi.Fixed.FileVersion, err = p.version()
+		case "PRODUCTVERSION":
+			vi.Fixed.ProductVersion, err = p.version()
+		case "FILEFLAGSMASK":
+			n, err = p.expr()
+			vi.Fixed.FileFlagsMask = versioninfo.FF(n)
+		case "FILEFLAGS":
+			n, err = p.expr()
+			vi.Fixed.FileFlags = versioninfo.FF(n)
+		case "FILEOS":
+			n, err = p.expr()
+			vi.Fixed.FileOS = versioninfo.OS(n)
+		case "FILETYPE":
+			n, err = p.expr()
+			vi.Fixed.FileType = versioninfo.FT(n)
+		case "FILESUBTYPE":
+			n, err = p.expr()
+			vi.Fixed.FileSubtype = uint32(n)
+		case "LANGUAGE":
+			lang, err = p.language()
+		default:
+			p.pos--
+			return p.errorf("unknown VERSIONINFO statement %s", tok.text)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	p.begin()
+	for {
+		if isEnd, err := p.acceptEnd(); err != nil {
+			return err
+		} else if isEnd {
+			break
+		}
+		if !p.acceptKeyword("BLOCK") {
+			return p.unexpected("BLOCK")
+		}
+		blockName, err := p.str()
+		if err != nil {
+			return err
+		}
+		if err := p.begin(); err != nil {
+			return err
+		}
+
+		switch strings.ToLower(blockName) {
+		case "stringfileinfo":
+			err = p.versionStrings(vi)
+		case "varfileinfo":
+			err = p.versionVars(vi)
+		default:
+			return p.errorf("unknown VERSIONINFO block %q", blockName)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	p.script.VersionInfos = append(p.script.VersionInfos, VersionInfo{name, lang, vi})
+	return nil
+}
+
+// Parses the FILEVERSION or PRODUCTVERSION numbers.
+func (p *_Parser) version() ([4]uint16, error) {
+	var ver [4]uint16
+	for i := range ver {
+		if i > 0 && !p.accept(",") {
+			break
+		}
+		n, err := p.expr()
+		if err != nil {
+			return ver, err
+		}
+		ver[i] = uint16(n)
+	}
+	return ver, nil
+}
+
+// Parses the string tables of the StringFileInfo block, until END.
+func (p *_Parser) versionStrings(vi *versioninfo.VersionInfo) error {
+	for {
+		if isEnd, err := p.acceptEnd(); err != nil {
+			return err
+		} else if isEnd {
+			return nil
+		}
+		if !p.acceptKeyword("BLOCK") {
+			return p.unexpected("BLOCK")
+		}
+		tableName, err := p.str()
+		if err != nil {
+			return err
+		}
+		langCp, err := strconv.ParseUint(tableName, 16, 32)
+		if err != nil || len(tableName) != 8 {
+			return p.errorf("invalid string table %q, expected language and code page in hex", tableName)
+		}
+		if err := p.begin(); err != nil {
+			return err
+		}
+
+		st := versioninfo.StringTable{
+			LangId:   uint16(langCp >> 16),
+			CodePage: uint16(langCp),
+		}
+		for {
+			if isEnd, err := p.acceptEnd(); err != nil {
+				return err
+			} else if isEnd {
+				break
+			}
+			if !p.acceptKeyword("VALUE") {
+				return p.unexpected("VALUE")
+			}
+			key, err := p.str()
+			if err != nil {
+				return err
+			}
+			var value string
+			for p.accept(",") {
+				s, err := p.str()
+				if err != nil {
+					return err
+				}
+				value += s
+			}
+			st.Strings = append(st.Strings, versioninfo.String{
+				Key:   key,
+				Value: strings.TrimRight(value, "\x00"),
+			})
+		}
+		vi.StringTables = append(vi.StringTables, st)
+	}
+}
+
+// Parses the Translation value of the VarFileInfo block, until END.
+func (p *_Parser) versionVars(vi *versioninfo.VersionInfo) error {
+	for {
+		if isEnd, err := p.acceptEnd(); err != nil {
+			return err
+		} else if isEnd {
+			return nil
+		}
+		if !p.acceptKeyword("VALUE") {
+			return p.unexpected("VALUE")
+		}
+		key, err := p.str()
+		if err != nil {
+			return err
+		}
+
+		var nums []int64
+		for p.accept(",") {
+			n, err := p.expr()
+			if err != nil {
+				return err
+			}
+			nums = append(nums, n)
+		}
+		if !strings.EqualFold(key, "Translation") {
+			continue // other values are not stored
+		} else if len(nums)%2 != 0 {
+			return p.errorf("Translation must have pairs of language and code page")
+		}
+		for i := 0; i < len(nums); i += 2 {
+			vi.Translations = append(vi.Translations, versioninfo.Translation{
+				LangId:   uint16(nums[i]),
+				CodePage: uint16(nums[i+1]),
+			})
+		}
+	}
+}
+
+// Parses a resource of any other type, either loaded from a file, or given as
+// raw data between BEGIN and END.
+func (p *_Parser) rawResource(name, ty res.Id, isBitmap bool) error {
+	lang, err := p.options()
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if p.isBegin() {
+		p.begin()
+		if data, err = p.rawData(); err != nil {
+			return err
+		}
+	} else {
+		if data, err = p.loadFile(); err != nil {
+			return err
+		}
+		if isBitmap {
+			if len(data) < 14 || !bytes.HasPrefix(data, []byte("BM")) {
+				return p.errorf("not a .bmp file")
+			}
+			data = data[14:] // BITMAPFILEHEADER is not stored
+		}
+	}
+
+	p.script.Others = append(p.script.Others, res.Resource{
+		Type: ty,
+		Name: name,
+		Lang: lang,
+		Data: data,
+	})
+	return nil
+}
+
+// Parses raw data until END: numbers are stored as WORD, or as DWORD with the L
+// suffix; strings are stored without terminator, as UTF-16 if prefixed with L.
+func (p *_Parser) rawData() ([]byte, error) {
+	le := binary.LittleEndian
+	var data []byte
+	for {
+		if isEnd, err := p.acceptEnd(); err != nil {
+			return nil, err
+		} else if isEnd {
+			return data, nil
+		}
+
+		if tok := p.peek(); tok.kind == _TOK_STRING {
+			p.pos++
+			if tok.long {
+				for _, ch := range utf16.Encode([]rune(tok.str)) {
+					data = le.AppendUint16(data, ch)
+				}
+			} else {
+				data = append(data, tok.str...)
+			}
+		} else {
+			isLong := tok.kind == _TOK_NUMBER && tok.long
+			n, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			if isLong {
+				data = le.AppendUint32(data, uint32(n))
+			} else {
+				data = le.AppendUint16(data, uint16(n))
+			}
+		}
+		p.accept(",")
+	}
+}
@@ -0,0 +1,25 @@
+// Symbols shared by app.rc and the program.
+#ifndef RESOURCE_H
+#define RESOURCE_H
+
+#define IDD_MAIN        101
+#define IDR_MENU        102
+#define IDR_ACCEL       103
+
+#define IDC_NAME        1001
+#define IDC_LIST        (IDC_NAME + 1)
+#define IDC_PROGRESS    1003
+#define IDC_CHECK       1004
+
+#define ID_FILE_OPEN    40001
+#define ID_FILE_EXIT    40002
+#define ID_HELP_ABOUT   40003
+
+#define IDS_APP_TITLE   1
+#define IDS_HELLO       2
+#define IDS_OTHER_BLOCK 300
+
+#define VER_MAJOR       1
+#define VER_MINOR       2
+
+#endif
@@ -0,0 +1,176 @@
+package regfile
+
+import (
+	"encoding/binary"
+	"strings"
+	"unicode/utf16"
+)
+
+// A .reg file, as written by regedit, made of registry keys and their values.
+//
+// # Example
+//
+//	file := regfile.File{
+//		Keys: []regfile.Key{
+//			{
+//				Path: "HKEY_CURRENT_USER\\Software\\MyApp",
+//				Values: []regfile.Value{
+//					regfile.ValueSz("Server", "localhost"),
+//					regfile.ValueDword("Port", 8080),
+//				},
+//			},
+//			{Path: "HKEY_CURRENT_USER\\Software\\MyApp\\Cache", Delete: true},
+//		},
+//	}
+//	data := file.Bytes()
+type File struct {
+	Keys []Key // Keys in file order; each key is created, unless it's deleted.
+}
+
+// A registry key of a File.
+type Key struct {
+	Path   string  // Full path, starting with the root key, like HKEY_CURRENT_USER\Software.
+	Delete bool    // If true, the key and all its subkeys are deleted, written as [-path].
+	Values []Value // Values to be set or deleted.
+}
+
+// A registry value of a Key.
+type Value struct {
+	Name   string // Name of the value; empty for the default value, written as @.
+	Delete bool   // If true, the value is deleted, written as "name"=-.
+	Type   TYPE   // Type of the data.
+	Data   []byte // Raw data, as stored in the registry; strings are null-terminated UTF-16.
+}
+
+// Registry value type, same as the [REG] constants.
+//
+// [REG]: https://learn.microsoft.com/en-us/windows/win32/sysinfo/registry-value-types
+type TYPE uint32
+
+const (
+	TYPE_NONE                       TYPE = 0
+	TYPE_SZ                         TYPE = 1
+	TYPE_EXPAND_SZ                  TYPE = 2
+	TYPE_BINARY                     TYPE = 3
+	TYPE_DWORD                      TYPE = 4
+	TYPE_DWORD_BIG_ENDIAN           TYPE = 5
+	TYPE_LINK                       TYPE = 6
+	TYPE_MULTI_SZ                   TYPE = 7
+	TYPE_RESOURCE_LIST              TYPE = 8
+	TYPE_FULL_RESOURCE_DESCRIPTOR   TYPE = 9
+	TYPE_RESOURCE_REQUIREMENTS_LIST TYPE = 10
+	TYPE_QWORD                      TYPE = 11
+)
+
+// Creates a value which deletes the named value.
+func ValueDelete(name string) Value {
+	return Value{Name: name, Delete: true}
+}
+
+// Creates a TYPE_BINARY value.
+func ValueBinary(name string, data []byte) Value {
+	return Value{Name: name, Type: TYPE_BINARY, Data: append([]byte{}, data...)}
+}
+
+// Creates a TYPE_DWORD value.
+func ValueDword(name string, n uint32) Value {
+	return Value{Name: name, Type: TYPE_DWORD, Data: binary.LittleEndian.AppendUint32(nil, n)}
+}
+
+// Creates a TYPE_EXPAND_SZ value.
+func ValueExpandSz(name, s string) Value {
+	return Value{Name: name, Type: TYPE_EXPAND_SZ, Data: encodeSz(nil, s)}
+}
+
+// Creates a TYPE_MULTI_SZ value. The strings must not contain null chars.
+func ValueMultiSz(name string, strs []string) Value {
+	var data []byte
+	for _, s := range strs {
+		data = encodeSz(data, s)
+	}
+	data = append(data, 0, 0) // final terminator
+	return Value{Name: name, Type: TYPE_MULTI_SZ, Data: data}
+}
+
+// Creates a TYPE_QWORD value.
+func ValueQword(name string, n uint64) Value {
+	return Value{Name: name, Type: TYPE_QWORD, Data: binary.LittleEndian.AppendUint64(nil, n)}
+}
+
+// Creates a TYPE_SZ value.
+func ValueSz(name, s string) Value {
+	return Value{Name: name, Type: TYPE_SZ, Data: encodeSz(nil, s)}
+}
+
+// If the value is TYPE_DWORD with 4 bytes, returns it and true; otherwise 0 and
+// false.
+func (v *Value) Dword() (uint32, bool) {
+	if v.Type != TYPE_DWORD || len(v.Data) != 4 {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint32(v.Data), true
+}
+
+// If the value is TYPE_EXPAND_SZ, returns the string, not expanded, and true;
+// otherwise "" and false.
+func (v *Value) ExpandSz() (string, bool) {
+	if v.Type != TYPE_EXPAND_SZ {
+		return "", false
+	}
+	return splitSz(decodeUtf16(v.Data))[0], true
+}
+
+// If the value is TYPE_MULTI_SZ, returns the strings and true; otherwise nil
+// and false.
+func (v *Value) MultiSz() ([]string, bool) {
+	if v.Type != TYPE_MULTI_SZ {
+		return nil, false
+	}
+	strs := make([]string, 0)
+	for _, s := range splitSz(decodeUtf16(v.Data)) {
+		if s == "" {
+			break // final terminator
+		}
+		strs = append(strs, s)
+	}
+	return strs, true
+}
+
+// If the value is TYPE_QWORD with 8 bytes, returns it and true; otherwise 0 and
+// false.
+func (v *Value) Qword() (uint64, bool) {
+	if v.Type != TYPE_QWORD || len(v.Data) != 8 {
+		return 0, false
+	}
+	return binary.LittleEndian.Uint64(v.Data), true
+}
+
+// If the value is TYPE_SZ, returns the string and true; otherwise "" and false.
+func (v *Value) Sz() (string, bool) {
+	if v.Type != TYPE_SZ {
+		return "", false
+	}
+	return splitSz(decodeUtf16(v.Data))[0], true
+}
+
+// Appends the string as null-terminated UTF-16 bytes.
+func encodeSz(dest []byte, s string) []byte {
+	for _, ch := range utf16.Encode([]rune(s)) {
+		dest = binary.LittleEndian.AppendUint16(dest, ch)
+	}
+	return append(dest, 0, 0)
+}
+
+// Decodes UTF-16 bytes, ignoring a trailing odd byte.
+func decodeUtf16(data []byte) string {
+	chars := make([]uint16, len(data)/2)
+	for i := range chars {
+		chars[i] = binary.LittleEndian.Uint16(data[i*2:])
+	}
+	return string(utf16.Decode(chars))
+}
+
+// Splits the string at the null chars; the result has at least one element.
+func splitSz(s string) []string {
+	return strings.Split(s, "\x00")
+}
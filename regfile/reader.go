@@ -0,0 +1,260 @@
+package regfile
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Reads all the .reg content from r and parses it.
+func Read(r io.Reader) (*File, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(src)
+}
+
+// Parses a .reg file, either in the regedit 5.00 format, encoded as UTF-16 or
+// UTF-8, or in the older REGEDIT4 format.
+//
+// REGEDIT4 files are ANSI, so non-UTF-8 text, as well as the hex(2) and hex(7)
+// data, is read as Latin-1.
+func Parse(src []byte) (*File, error) {
+	var text string
+	if bytes.HasPrefix(src, []byte{0xff, 0xfe}) {
+		text = decodeUtf16(src[2:])
+	} else if bytes.HasPrefix(src, []byte{0xef, 0xbb, 0xbf}) {
+		text = string(src[3:])
+	} else if utf8.Valid(src) {
+		text = string(src)
+	} else {
+		text = latin1(src)
+	}
+
+	p := _Parser{
+		lines: strings.Split(text, "\n"),
+		file:  &File{},
+	}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	return p.file, nil
+}
+
+type _Parser struct {
+	lines  []string
+	lineNo int // 1-based number of the current line
+	ansi   bool
+	file   *File
+	curKey *Key
+}
+
+// Returns the next logical line, with the continuation lines joined, and false
+// if there are no more lines.
+func (p *_Parser) next() (string, bool) {
+	if p.lineNo >= len(p.lines) {
+		return "", false
+	}
+	line := strings.TrimSpace(p.lines[p.lineNo])
+	p.lineNo++
+	if strings.HasPrefix(line, ";") {
+		return "", true // comment
+	}
+
+	for strings.HasSuffix(line, "\\") && p.lineNo < len(p.lines) {
+		line = line[:len(line)-1] + strings.TrimSpace(p.lines[p.lineNo])
+		p.lineNo++
+	}
+	return line, true
+}
+
+func (p *_Parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: %s", p.lineNo, fmt.Sprintf(format, args...))
+}
+
+func (p *_Parser) parse() error {
+	var header string
+	for header == "" {
+		line, ok := p.next()
+		if !ok {
+			return errors.New("not a .reg file")
+		}
+		header = line
+	}
+	switch header {
+	case "Windows Registry Editor Version 5.00":
+	case "REGEDIT4":
+		p.ansi = true
+	default:
+		return errors.New("not a .reg file")
+	}
+
+	for {
+		line, ok := p.next()
+		if !ok {
+			return nil
+		}
+
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "["):
+			if err := p.parseKey(line); err != nil {
+				return err
+			}
+		default:
+			if p.curKey == nil {
+				return p.errorf("value outside of a key")
+			}
+			val, err := p.parseValue(line)
+			if err != nil {
+				return err
+			}
+			p.curKey.Values = append(p.curKey.Values, val)
+		}
+	}
+}
+
+func (p *_Parser) parseKey(line string) error {
+	if !strings.HasSuffix(line, "]") {
+		return p.errorf("unterminated key")
+	}
+	path := line[1 : len(line)-1]
+	key := Key{}
+	if strings.HasPrefix(path, "-") {
+		key.Delete = true
+		path = path[1:]
+	}
+	if path == "" {
+		return p.errorf("empty key path")
+	}
+	key.Path = path
+
+	p.file.Keys = append(p.file.Keys, key)
+	p.curKey = &p.file.Keys[len(p.file.Keys)-1]
+	return nil
+}
+
+func (p *_Parser) parseValue(line string) (Value, error) {
+	val := Value{}
+	var rest string
+	if strings.HasPrefix(line, "@") {
+		rest = line[1:]
+	} else if strings.HasPrefix(line, "\"") {
+		name, after, ok := unquote(line)
+		if !ok {
+			return val, p.errorf("unterminated value name")
+		}
+		val.Name, rest = name, after
+	} else {
+		return val, p.errorf("invalid line")
+	}
+
+	rest = strings.TrimSpace(rest)
+	if !strings.HasPrefix(rest, "=") {
+		return val, p.errorf("missing \"=\" after value name")
+	}
+	data := strings.TrimSpace(rest[1:])
+
+	switch {
+	case data == "-":
+		val.Delete = true
+
+	case strings.HasPrefix(data, "\""):
+		s, after, ok := unquote(data)
+		if !ok || strings.TrimSpace(after) != "" {
+			return val, p.errorf("invalid string data")
+		}
+		val.Type, val.Data = TYPE_SZ, encodeSz(nil, s)
+
+	case strings.HasPrefix(data, "dword:"):
+		n, err := strconv.ParseUint(data[len("dword:"):], 16, 32)
+		if err != nil {
+			return val, p.errorf("invalid dword data")
+		}
+		val = ValueDword(val.Name, uint32(n))
+
+	case strings.HasPrefix(data, "hex:"):
+		raw, err := p.parseHex(data[len("hex:"):])
+		if err != nil {
+			return val, err
+		}
+		val.Type, val.Data = TYPE_BINARY, raw
+
+	case strings.HasPrefix(data, "hex("):
+		tyStr, hexStr, ok := strings.Cut(data[len("hex("):], "):")
+		ty, err := strconv.ParseUint(tyStr, 16, 32)
+		if !ok || err != nil {
+			return val, p.errorf("invalid hex type")
+		}
+		raw, err := p.parseHex(hexStr)
+		if err != nil {
+			return val, err
+		}
+		val.Type, val.Data = TYPE(ty), raw
+		if p.ansi && (val.Type == TYPE_EXPAND_SZ || val.Type == TYPE_MULTI_SZ) {
+			val.Data = encodeLatin1(raw)
+		}
+
+	default:
+		return val, p.errorf("invalid value data")
+	}
+	return val, nil
+}
+
+// Parses comma-separated hex bytes.
+func (p *_Parser) parseHex(s string) ([]byte, error) {
+	raw := make([]byte, 0, len(s)/3+1)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue // empty data, or trailing comma
+		}
+		b, err := strconv.ParseUint(part, 16, 8)
+		if err != nil {
+			return nil, p.errorf("invalid hex byte %q", part)
+		}
+		raw = append(raw, byte(b))
+	}
+	return raw, nil
+}
+
+// Parses a quoted string at the beginning of s, returning the unescaped string
+// and the text after the closing quote.
+func unquote(s string) (str, after string, ok bool) {
+	var buf strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			return buf.String(), s[i+1:], true
+		case '\\':
+			if i+1 < len(s) && (s[i+1] == '\\' || s[i+1] == '"') {
+				i++
+			}
+		}
+		buf.WriteByte(s[i])
+	}
+	return "", "", false
+}
+
+// Converts Latin-1 bytes to a string.
+func latin1(src []byte) string {
+	runes := make([]rune, len(src))
+	for i, b := range src {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+// Widens Latin-1 bytes to UTF-16 bytes.
+func encodeLatin1(src []byte) []byte {
+	dest := make([]byte, 0, len(src)*2)
+	for _, b := range src {
+		dest = append(dest, b, 0)
+	}
+	return dest
+}
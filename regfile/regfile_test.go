@@ -0,0 +1,272 @@
+package regfile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+// Encodes the text as UTF-16LE with BOM, like regedit does.
+func utf16Bom(text string) []byte {
+	buf := []byte{0xff, 0xfe}
+	for _, ch := range utf16.Encode([]rune(text)) {
+		buf = binary.LittleEndian.AppendUint16(buf, ch)
+	}
+	return buf
+}
+
+func sampleFile() *File {
+	long := make([]byte, 100)
+	for i := range long {
+		long[i] = byte(i)
+	}
+	return &File{
+		Keys: []Key{
+			{
+				Path: `HKEY_CURRENT_USER\Software\Windigo`,
+				Values: []Value{
+					ValueSz("", "default"),
+					ValueSz("Quoted \"name\"", `C:\Program Files\"App"`),
+					ValueSz("Unicode", "ação 日本 😀"),
+					ValueSz("Multi-line", "first\r\nsecond"),
+					ValueDword("Port", 0xdead_beef),
+					ValueQword("Big", 0x0102_0304_0506_0708),
+					ValueExpandSz("Path", `%SystemRoot%\system32`),
+					ValueMultiSz("List", []string{"one", "two", "three"}),
+					ValueMultiSz("Empty list", []string{}),
+					ValueBinary("Long", long),
+					ValueBinary("Empty", []byte{}),
+					{Name: "Custom", Type: TYPE(0x123), Data: []byte{1, 2, 3}},
+					ValueDelete("Old"),
+				},
+			},
+			{Path: `HKEY_CURRENT_USER\Software\Windigo\Obsolete`, Delete: true},
+			{Path: `HKEY_LOCAL_MACHINE\SOFTWARE\Windigo`},
+		},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	file := sampleFile()
+	data := file.Bytes()
+	if !bytes.HasPrefix(data, []byte{0xff, 0xfe}) {
+		t.Fatalf("missing UTF-16LE BOM: % x", data[:4])
+	}
+
+	parsed, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, file) {
+		t.Errorf("round trip mismatch\ngot:  %+v\nwant: %+v", parsed, file)
+	}
+
+	if again := parsed.Bytes(); !bytes.Equal(again, data) {
+		t.Errorf("second serialization differs")
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	file := File{
+		Keys: []Key{
+			{
+				Path: `HKEY_CURRENT_USER\Software\Windigo`,
+				Values: []Value{
+					ValueSz("", "x"),
+					ValueSz("Dir", `C:\Temp`),
+					ValueDword("N", 10),
+					ValueExpandSz("E", "%A%"),
+					ValueQword("Q", 1),
+					ValueDelete("Gone"),
+				},
+			},
+			{Path: `HKEY_CURRENT_USER\Software\Windigo\Old`, Delete: true},
+		},
+	}
+
+	want := "Windows Registry Editor Version 5.00\r\n" +
+		"\r\n" +
+		"[HKEY_CURRENT_USER\\Software\\Windigo]\r\n" +
+		"@=\"x\"\r\n" +
+		"\"Dir\"=\"C:\\\\Temp\"\r\n" +
+		"\"N\"=dword:0000000a\r\n" +
+		"\"E\"=hex(2):25,00,41,00,25,00,00,00\r\n" +
+		"\"Q\"=hex(b):01,00,00,00,00,00,00,00\r\n" +
+		"\"Gone\"=-\r\n" +
+		"\r\n" +
+		"[-HKEY_CURRENT_USER\\Software\\Windigo\\Old]\r\n" +
+		"\r\n"
+
+	if got := file.Bytes(); !bytes.Equal(got, utf16Bom(want)) {
+		t.Errorf("got:\n%s\nwant:\n%s", decodeUtf16(got[2:]), want)
+	}
+}
+
+func TestWriteHexWrap(t *testing.T) {
+	file := File{Keys: []Key{{
+		Path:   `HKEY_CURRENT_USER\Software\Windigo`,
+		Values: []Value{ValueBinary("Data", bytes.Repeat([]byte{0xab}, 40))},
+	}}}
+
+	text := decodeUtf16(file.Bytes()[2:])
+	lines := strings.Split(text, "\r\n")[3:]
+	if !strings.HasPrefix(lines[0], `"Data"=hex:ab,`) || !strings.HasSuffix(lines[0], `,\`) {
+		t.Errorf("bad first hex line: %q", lines[0])
+	}
+	if !strings.HasPrefix(lines[1], "  ab,") || strings.HasSuffix(lines[1], `\`) {
+		t.Errorf("bad continuation line: %q", lines[1])
+	}
+	for _, line := range lines {
+		if len(line) > _MAX_LINE_LEN {
+			t.Errorf("line with %d chars: %q", len(line), line)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	src := "Windows Registry Editor Version 5.00\r\n" +
+		"\r\n" +
+		"; a comment\r\n" +
+		"[HKEY_CURRENT_USER\\Software\\Windigo]\r\n" +
+		"@=\"default\"\r\n" +
+		"\"Escaped\"=\"a \\\"quoted\\\" \\\\ path\"\r\n" +
+		"\"Port\"=dword:00001F90\r\n" +
+		"\"Path\"=hex(2):25,00,41,00,\\\r\n" +
+		"  25,00,00,00\r\n" +
+		"\"List\"=hex(7):61,00,00,00,62,00,\\\r\n" +
+		"  63,00,00,00,\\\r\n" +
+		"  00,00\r\n" +
+		"\"Big\"=hex(b):ff,00,00,00,00,00,00,80\r\n" +
+		"\"Bin\"=hex:\r\n" +
+		"\"Gone\" = -\r\n" +
+		"@=-\r\n" +
+		"\r\n" +
+		"[-HKEY_CURRENT_USER\\Software\\Windigo\\Old]\r\n"
+
+	want := &File{
+		Keys: []Key{
+			{
+				Path: `HKEY_CURRENT_USER\Software\Windigo`,
+				Values: []Value{
+					ValueSz("", "default"),
+					ValueSz("Escaped", `a "quoted" \ path`),
+					ValueDword("Port", 8080),
+					ValueExpandSz("Path", "%A%"),
+					ValueMultiSz("List", []string{"a", "bc"}),
+					ValueQword("Big", 0x8000_0000_0000_00ff),
+					ValueBinary("Bin", nil),
+					ValueDelete("Gone"),
+					ValueDelete(""),
+				},
+			},
+			{Path: `HKEY_CURRENT_USER\Software\Windigo\Old`, Delete: true},
+		},
+	}
+
+	for name, data := range map[string][]byte{
+		"UTF-8":       []byte(src),
+		"UTF-8 BOM":   append([]byte{0xef, 0xbb, 0xbf}, src...),
+		"UTF-16 BOM":  utf16Bom(src),
+		"LF newlines": []byte(strings.ReplaceAll(src, "\r\n", "\n")),
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := Parse(data)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got:  %+v\nwant: %+v", got, want)
+			}
+		})
+	}
+
+	parsed, _ := Parse([]byte(src))
+	vals := parsed.Keys[0].Values
+	if n, ok := vals[2].Dword(); !ok || n != 8080 {
+		t.Errorf("Dword: %d %v", n, ok)
+	}
+	if s, ok := vals[3].ExpandSz(); !ok || s != "%A%" {
+		t.Errorf("ExpandSz: %q %v", s, ok)
+	}
+	if strs, ok := vals[4].MultiSz(); !ok || !reflect.DeepEqual(strs, []string{"a", "bc"}) {
+		t.Errorf("MultiSz: %q %v", strs, ok)
+	}
+	if n, ok := vals[5].Qword(); !ok || n != 0x8000_0000_0000_00ff {
+		t.Errorf("Qword: %x %v", n, ok)
+	}
+	if _, ok := vals[0].Dword(); ok {
+		t.Errorf("Dword of a TYPE_SZ value returned true")
+	}
+}
+
+func TestParseRegedit4(t *testing.T) {
+	// REGEDIT4 files are ANSI, and their hex(2) and hex(7) data are single-byte.
+	src := []byte("REGEDIT4\r\n" +
+		"\r\n" +
+		"[HKEY_CURRENT_USER\\Software\\Caf\xe9]\r\n" +
+		"\"Name\"=\"Jos\xe9\"\r\n" +
+		"\"Path\"=hex(2):25,41,25,5c,e9,00\r\n" +
+		"\"List\"=hex(7):61,00,e7,00,00\r\n" +
+		"\"Bin\"=hex:e9,00\r\n")
+
+	want := &File{
+		Keys: []Key{{
+			Path: "HKEY_CURRENT_USER\\Software\\Café",
+			Values: []Value{
+				ValueSz("Name", "José"),
+				ValueExpandSz("Path", `%A%\é`),
+				ValueMultiSz("List", []string{"a", "ç"}),
+				ValueBinary("Bin", []byte{0xe9, 0x00}),
+			},
+		}},
+	}
+
+	got, err := Parse(src)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got:  %+v\nwant: %+v", got, want)
+	}
+
+	// Written back in the 5.00 format, as UTF-16.
+	again, err := Parse(got.Bytes())
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if !reflect.DeepEqual(again, want) {
+		t.Errorf("after rewrite:\ngot:  %+v\nwant: %+v", again, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	const header = "Windows Registry Editor Version 5.00\r\n"
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{"empty", ""},
+		{"no header", "[HKEY_CURRENT_USER\\Software]\r\n"},
+		{"value outside key", header + "\"a\"=\"b\"\r\n"},
+		{"unterminated key", header + "[HKEY_CURRENT_USER\r\n"},
+		{"empty key", header + "[]\r\n"},
+		{"unterminated name", header + "[HKEY_CURRENT_USER]\r\n\"a=1\r\n"},
+		{"missing equal", header + "[HKEY_CURRENT_USER]\r\n\"a\"\"b\"\r\n"},
+		{"bad dword", header + "[HKEY_CURRENT_USER]\r\n\"a\"=dword:xyz\r\n"},
+		{"dword overflow", header + "[HKEY_CURRENT_USER]\r\n\"a\"=dword:100000000\r\n"},
+		{"bad hex byte", header + "[HKEY_CURRENT_USER]\r\n\"a\"=hex:01,zz\r\n"},
+		{"bad hex type", header + "[HKEY_CURRENT_USER]\r\n\"a\"=hex(q):01\r\n"},
+		{"trailing garbage", header + "[HKEY_CURRENT_USER]\r\n\"a\"=\"b\"c\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse([]byte(tt.src)); err == nil {
+				t.Errorf("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package regfile
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+)
+
+// Serializes the file in the regedit 5.00 format, returning the bytes.
+func (f *File) Bytes() []byte {
+	var buf bytes.Buffer
+	f.WriteTo(&buf)
+	return buf.Bytes()
+}
+
+// Serializes the file in the regedit 5.00 format, writing it to w. The text is
+// UTF-16 with BOM and CRLF line breaks, like the files written by regedit.
+//
+// Strings which can't be represented between quotes, like the ones with line
+// breaks, are written in hex(1) form. Long hex data is wrapped in multiple
+// lines.
+//
+// Implements io.WriterTo.
+func (f *File) WriteTo(w io.Writer) (int64, error) {
+	wr := _Writer{}
+	wr.line("Windows Registry Editor Version 5.00")
+	for i := range f.Keys {
+		wr.line("")
+		wr.writeKey(&f.Keys[i])
+	}
+	wr.line("")
+
+	chars := utf16.Encode([]rune(wr.text.String()))
+	buf := make([]byte, 0, 2+len(chars)*2)
+	buf = append(buf, 0xff, 0xfe) // BOM
+	for _, ch := range chars {
+		buf = binary.LittleEndian.AppendUint16(buf, ch)
+	}
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+const _MAX_LINE_LEN = 80 // hex data is wrapped before reaching it
+
+type _Writer struct {
+	text strings.Builder
+	col  int // length of the current line
+}
+
+func (wr *_Writer) write(s string) {
+	wr.text.WriteString(s)
+	wr.col += len(s)
+}
+
+func (wr *_Writer) line(s string) {
+	wr.text.WriteString(s)
+	wr.text.WriteString("\r\n")
+	wr.col = 0
+}
+
+func (wr *_Writer) writeKey(key *Key) {
+	if key.Delete {
+		wr.line("[-" + key.Path + "]")
+		return
+	}
+
+	wr.line("[" + key.Path + "]")
+	for i := range key.Values {
+		wr.writeValue(&key.Values[i])
+	}
+}
+
+func (wr *_Writer) writeValue(val *Value) {
+	if val.Name == "" {
+		wr.write("@=")
+	} else {
+		wr.write("\"" + escape(val.Name) + "\"=")
+	}
+
+	switch {
+	case val.Delete:
+		wr.line("-")
+	case val.Type == TYPE_SZ && isQuotable(val.Data):
+		s, _ := val.Sz()
+		wr.line("\"" + escape(s) + "\"")
+	case val.Type == TYPE_DWORD && len(val.Data) == 4:
+		n, _ := val.Dword()
+		wr.line(fmt.Sprintf("dword:%08x", n))
+	case val.Type == TYPE_BINARY:
+		wr.writeHex("hex:", val.Data)
+	default:
+		wr.writeHex(fmt.Sprintf("hex(%x):", uint32(val.Type)), val.Data)
+	}
+}
+
+// Writes the bytes as comma-separated hex pairs, wrapping the lines with a
+// trailing backslash.
+func (wr *_Writer) writeHex(prefix string, data []byte) {
+	wr.write(prefix)
+	for i, b := range data {
+		wr.write(fmt.Sprintf("%02x", b))
+		if i < len(data)-1 {
+			wr.write(",")
+			if wr.col > _MAX_LINE_LEN-4 {
+				wr.line("\\")
+				wr.write("  ")
+			}
+		}
+	}
+	wr.line("")
+}
+
+// Escapes backslashes and quotes.
+func escape(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "\"", "\\\"").Replace(s)
+}
+
+// Tells whether the REG_SZ data can be written between quotes, and read back
+// unchanged: valid UTF-16 with a single null terminator, and no line breaks.
+func isQuotable(data []byte) bool {
+	if len(data) < 2 {
+		return false
+	}
+	s := decodeUtf16(data[:len(data)-2])
+	return !strings.ContainsAny(s, "\x00\r\n") &&
+		bytes.Equal(encodeSz(nil, s), data)
+}
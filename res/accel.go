@@ -0,0 +1,70 @@
+package res
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// [ACCELTABLEENTRY] fFlags.
+//
+// [ACCELTABLEENTRY]: https://learn.microsoft.com/en-us/windows/win32/menurc/acceltableentry
+type ACCELF uint16
+
+const (
+	ACCELF_VIRTKEY  ACCELF = 0x01
+	ACCELF_NOINVERT ACCELF = 0x02
+	ACCELF_SHIFT    ACCELF = 0x04
+	ACCELF_CONTROL  ACCELF = 0x08
+	ACCELF_ALT      ACCELF = 0x10
+)
+
+const _ACCEL_ENTRY_SIZE = 8 // sizeof(ACCELTABLEENTRY)
+
+// An entry of an AccelTable.
+type Accel struct {
+	Flags ACCELF
+	Key   uint16 // Virtual key code if ACCELF_VIRTKEY is set, otherwise a character code.
+	Cmd   uint16 // Command ID sent in WM_COMMAND.
+}
+
+// An accelerator table, stored as an RT_ACCELERATOR resource.
+type AccelTable []Accel
+
+// Parses an RT_ACCELERATOR resource.
+func ParseAccelTable(src []byte) (AccelTable, error) {
+	if len(src)%_ACCEL_ENTRY_SIZE != 0 {
+		return nil, errors.New("invalid RT_ACCELERATOR size")
+	}
+
+	le := binary.LittleEndian
+	table := make(AccelTable, 0, len(src)/_ACCEL_ENTRY_SIZE)
+	for pos := 0; pos < len(src); pos += _ACCEL_ENTRY_SIZE {
+		flags := le.Uint16(src[pos:])
+		table = append(table, Accel{
+			Flags: ACCELF(flags &^ 0x80),
+			Key:   le.Uint16(src[pos+2:]),
+			Cmd:   le.Uint16(src[pos+4:]),
+		})
+		if flags&0x80 != 0 { // last entry
+			break
+		}
+	}
+	return table, nil
+}
+
+// Serializes the table as an RT_ACCELERATOR resource.
+func (t AccelTable) Bytes() []byte {
+	le := binary.LittleEndian
+	buf := make([]byte, 0, len(t)*_ACCEL_ENTRY_SIZE)
+	for i, accel := range t {
+		flags := uint16(accel.Flags)
+		if i == len(t)-1 {
+			flags |= 0x80
+		}
+		buf = le.AppendUint16(buf, flags)
+		buf = le.AppendUint16(buf, accel.Key)
+		buf = le.AppendUint16(buf, accel.Cmd)
+		buf = le.AppendUint16(buf, 0) // padding
+	}
+	return buf
+}
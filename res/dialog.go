@@ -0,0 +1,227 @@
+package res
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Ordinals of the predefined window classes of dialog items.
+const (
+	CLASS_BUTTON    uint16 = 0x0080
+	CLASS_EDIT      uint16 = 0x0081
+	CLASS_STATIC    uint16 = 0x0082
+	CLASS_LISTBOX   uint16 = 0x0083
+	CLASS_SCROLLBAR uint16 = 0x0084
+	CLASS_COMBOBOX  uint16 = 0x0085
+)
+
+const _DS_SETFONT uint32 = 0x0000_0040
+
+// A [DLGTEMPLATEEX] dialog template, stored as an RT_DIALOG resource, and
+// passed to functions like DialogBoxIndirectParam.
+//
+// Position and sizes are in dialog units, which scale with the dialog font.
+//
+// # Example
+//
+//	dlg := res.Dialog{
+//		Style: 0x80c8_08c8, // WS_POPUP | WS_CAPTION | WS_SYSMENU | DS_MODALFRAME | DS_SHELLFONT | DS_CENTER
+//		Cx:    200,
+//		Cy:    80,
+//		Title: "Hello",
+//		Font:  res.DialogFont{PointSize: 9, Typeface: "Segoe UI"},
+//		Items: []res.DialogItem{{
+//			Style: 0x5001_0001, // WS_CHILD | WS_VISIBLE | WS_TABSTOP | BS_DEFPUSHBUTTON
+//			X:     140, Y: 58, Cx: 50, Cy: 14,
+//			Id:    1,
+//			Class: res.IdInt(res.CLASS_BUTTON),
+//			Title: res.IdStr("OK"),
+//		}},
+//	}
+//	data := dlg.Bytes()
+//
+// [DLGTEMPLATEEX]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/dlgtemplateex
+type Dialog struct {
+	HelpId       uint32
+	ExStyle      uint32
+	Style        uint32 // The Font is stored only if DS_SETFONT or DS_SHELLFONT is set.
+	X, Y, Cx, Cy int16
+	Menu         Id     // Menu resource; the zero value, or an empty string, means no menu.
+	Class        string // Window class; empty for the default dialog class.
+	Title        string
+	Font         DialogFont
+	Items        []DialogItem
+}
+
+// Font of a Dialog.
+type DialogFont struct {
+	PointSize uint16
+	Weight    uint16 // Like 400 for normal, or 700 for bold.
+	Italic    bool
+	CharSet   uint8
+	Typeface  string
+}
+
+// A [DLGITEMTEMPLATEEX] control of a Dialog.
+//
+// [DLGITEMTEMPLATEEX]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/dlgitemtemplateex
+type DialogItem struct {
+	HelpId       uint32
+	ExStyle      uint32
+	Style        uint32 // Must include WS_CHILD, and usually WS_VISIBLE.
+	X, Y, Cx, Cy int16
+	Id           uint32
+	Class        Id     // One of the CLASS ordinals, or a window class name.
+	Title        Id     // Text, or the ordinal of an icon or bitmap resource for static controls.
+	Extra        []byte // Creation data passed in the WM_CREATE message.
+}
+
+// Parses an RT_DIALOG resource in the DLGTEMPLATEEX format. The older
+// DLGTEMPLATE format, produced by DIALOG statements, is not supported.
+func ParseDialog(src []byte) (*Dialog, error) {
+	le := binary.LittleEndian
+	if len(src) < 26 || le.Uint16(src[0:]) != 1 || le.Uint16(src[2:]) != 0xffff {
+		return nil, errors.New("not a DLGTEMPLATEEX resource")
+	}
+
+	dlg := &Dialog{
+		HelpId:  le.Uint32(src[4:]),
+		ExStyle: le.Uint32(src[8:]),
+		Style:   le.Uint32(src[12:]),
+		X:       int16(le.Uint16(src[18:])),
+		Y:       int16(le.Uint16(src[20:])),
+		Cx:      int16(le.Uint16(src[22:])),
+		Cy:      int16(le.Uint16(src[24:])),
+	}
+	numItems := int(le.Uint16(src[16:]))
+
+	menu, pos, err := readSzOrOrd(src, 26)
+	if err != nil {
+		return nil, err
+	}
+	if str, ok := menu.Str(); !ok || str != "" {
+		dlg.Menu = menu
+	}
+	class, pos, err := readSzOrOrd(src, pos)
+	if err != nil {
+		return nil, err
+	}
+	dlg.Class, _ = class.Str() // predefined class ordinals are not used by dialogs
+	if dlg.Title, pos, err = readSz(src, pos); err != nil {
+		return nil, err
+	}
+
+	if dlg.Style&_DS_SETFONT != 0 {
+		if pos+6 > len(src) {
+			return nil, errors.New("truncated dialog font")
+		}
+		dlg.Font.PointSize = le.Uint16(src[pos:])
+		dlg.Font.Weight = le.Uint16(src[pos+2:])
+		dlg.Font.Italic = src[pos+4] != 0
+		dlg.Font.CharSet = src[pos+5]
+		if dlg.Font.Typeface, pos, err = readSz(src, pos+6); err != nil {
+			return nil, err
+		}
+	}
+
+	dlg.Items = make([]DialogItem, numItems)
+	for i := range dlg.Items {
+		if pos, err = dlg.Items[i].parse(src, align4(pos)); err != nil {
+			return nil, err
+		}
+	}
+	return dlg, nil
+}
+
+func (item *DialogItem) parse(src []byte, pos int) (int, error) {
+	le := binary.LittleEndian
+	if pos+24 > len(src) {
+		return pos, errors.New("truncated dialog item")
+	}
+	item.HelpId = le.Uint32(src[pos:])
+	item.ExStyle = le.Uint32(src[pos+4:])
+	item.Style = le.Uint32(src[pos+8:])
+	item.X = int16(le.Uint16(src[pos+12:]))
+	item.Y = int16(le.Uint16(src[pos+14:]))
+	item.Cx = int16(le.Uint16(src[pos+16:]))
+	item.Cy = int16(le.Uint16(src[pos+18:]))
+	item.Id = le.Uint32(src[pos+20:])
+
+	var err error
+	if item.Class, pos, err = readSzOrOrd(src, pos+24); err != nil {
+		return pos, err
+	}
+	if item.Title, pos, err = readSzOrOrd(src, pos); err != nil {
+		return pos, err
+	}
+
+	if pos+2 > len(src) {
+		return pos, errors.New("truncated dialog item")
+	}
+	extraSize := int(le.Uint16(src[pos:]))
+	pos += 2
+	if extraSize > 0 {
+		if pos+extraSize > len(src) {
+			return pos, errors.New("truncated dialog item creation data")
+		}
+		item.Extra = append([]byte(nil), src[pos:pos+extraSize]...)
+		pos += extraSize
+	}
+	return pos, nil
+}
+
+// Serializes the dialog as an RT_DIALOG resource in the DLGTEMPLATEEX format.
+func (dlg *Dialog) Bytes() []byte {
+	le := binary.LittleEndian
+	buf := make([]byte, 0, 64+len(dlg.Items)*32)
+	buf = le.AppendUint16(buf, 1) // dlgVer
+	buf = le.AppendUint16(buf, 0xffff)
+	buf = le.AppendUint32(buf, dlg.HelpId)
+	buf = le.AppendUint32(buf, dlg.ExStyle)
+	buf = le.AppendUint32(buf, dlg.Style)
+	buf = le.AppendUint16(buf, uint16(len(dlg.Items)))
+	buf = le.AppendUint16(buf, uint16(dlg.X))
+	buf = le.AppendUint16(buf, uint16(dlg.Y))
+	buf = le.AppendUint16(buf, uint16(dlg.Cx))
+	buf = le.AppendUint16(buf, uint16(dlg.Cy))
+
+	if num, ok := dlg.Menu.Int(); ok && num == 0 {
+		buf = le.AppendUint16(buf, 0) // no menu
+	} else {
+		buf = appendSzOrOrd(buf, dlg.Menu)
+	}
+	buf = appendSz(buf, dlg.Class)
+	buf = appendSz(buf, dlg.Title)
+
+	if dlg.Style&_DS_SETFONT != 0 {
+		buf = le.AppendUint16(buf, dlg.Font.PointSize)
+		buf = le.AppendUint16(buf, dlg.Font.Weight)
+		var italic uint8
+		if dlg.Font.Italic {
+			italic = 1
+		}
+		buf = append(buf, italic, dlg.Font.CharSet)
+		buf = appendSz(buf, dlg.Font.Typeface)
+	}
+
+	for i := range dlg.Items {
+		buf = dlg.Items[i].appendBytes(pad4(buf))
+	}
+	return buf
+}
+
+func (item *DialogItem) appendBytes(buf []byte) []byte {
+	le := binary.LittleEndian
+	buf = le.AppendUint32(buf, item.HelpId)
+	buf = le.AppendUint32(buf, item.ExStyle)
+	buf = le.AppendUint32(buf, item.Style)
+	buf = le.AppendUint16(buf, uint16(item.X))
+	buf = le.AppendUint16(buf, uint16(item.Y))
+	buf = le.AppendUint16(buf, uint16(item.Cx))
+	buf = le.AppendUint16(buf, uint16(item.Cy))
+	buf = le.AppendUint32(buf, item.Id)
+	buf = appendSzOrOrd(buf, item.Class)
+	buf = appendSzOrOrd(buf, item.Title)
+	buf = le.AppendUint16(buf, uint16(len(item.Extra)))
+	return append(buf, item.Extra...)
+}
@@ -0,0 +1,155 @@
+package res
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A set of Windows resources, which can be compiled into a .syso object with
+// SysoBytes(), to be linked into a Go executable, or saved as a .res file with
+// ResBytes().
+//
+// # Example
+//
+//	manifest, _ := os.ReadFile("app.exe.manifest")
+//	icoData, _ := os.ReadFile("app.ico")
+//
+//	var file res.File
+//	file.SetManifest(res.LANG_NEUTRAL, manifest)
+//	file.SetIconGroup(res.IdInt(1), res.LANG_NEUTRAL, icoData)
+//	os.WriteFile("rsrc_windows_amd64.syso", file.SysoBytes(res.ARCH_AMD64), 0o644)
+type File struct {
+	Resources []Resource // Resources in no particular order.
+}
+
+// A resource of a File.
+type Resource struct {
+	Type Id     // Type of the resource, usually one of the RT constants.
+	Name Id     // Name of the resource.
+	Lang uint16 // Language identifier.
+	Data []byte // Raw resource data.
+}
+
+// A resource type or name, which is either an integer or a string.
+//
+// The zero value is the integer zero.
+type Id struct {
+	isStr bool
+	num   uint16
+	str   string
+}
+
+// Creates a new integer Id.
+func IdInt(num uint16) Id {
+	return Id{num: num}
+}
+
+// Creates a new string Id. Strings are case-insensitive.
+func IdStr(str string) Id {
+	return Id{isStr: true, str: str}
+}
+
+func (id Id) Int() (uint16, bool) { return id.num, !id.isStr }
+func (id Id) Str() (string, bool) { return id.str, id.isStr }
+
+// Tells whether both are the same integer, or the same case-insensitive
+// string.
+func (id Id) Equals(other Id) bool {
+	if id.isStr != other.isStr {
+		return false
+	} else if id.isStr {
+		return strings.EqualFold(id.str, other.str)
+	}
+	return id.num == other.num
+}
+
+// Returns the integer as #123, like in .rc files, or the string itself.
+func (id Id) String() string {
+	if id.isStr {
+		return id.str
+	}
+	return fmt.Sprintf("#%d", id.num)
+}
+
+// Predefined [resource types].
+//
+// [resource types]: https://learn.microsoft.com/en-us/windows/win32/menurc/resource-types
+type RT uint16
+
+const (
+	RT_CURSOR       RT = 1
+	RT_BITMAP       RT = 2
+	RT_ICON         RT = 3
+	RT_MENU         RT = 4
+	RT_DIALOG       RT = 5
+	RT_STRING       RT = 6
+	RT_FONTDIR      RT = 7
+	RT_FONT         RT = 8
+	RT_ACCELERATOR  RT = 9
+	RT_RCDATA       RT = 10
+	RT_MESSAGETABLE RT = 11
+	RT_GROUP_CURSOR RT = 12
+	RT_GROUP_ICON   RT = 14
+	RT_VERSION      RT = 16
+	RT_DLGINCLUDE   RT = 17
+	RT_PLUGPLAY     RT = 19
+	RT_VXD          RT = 20
+	RT_ANICURSOR    RT = 21
+	RT_ANIICON      RT = 22
+	RT_HTML         RT = 23
+	RT_MANIFEST     RT = 24
+)
+
+// Returns the resource type as an integer Id.
+func (rt RT) Id() Id {
+	return IdInt(uint16(rt))
+}
+
+const (
+	LANG_NEUTRAL uint16 = 0x0000 // Language neutral.
+	LANG_EN_US   uint16 = 0x0409 // English (United States).
+)
+
+// Returns the resource with the given type, name and language, if any.
+func (f *File) Find(ty, name Id, lang uint16) (*Resource, bool) {
+	for i := range f.Resources {
+		r := &f.Resources[i]
+		if r.Type.Equals(ty) && r.Name.Equals(name) && r.Lang == lang {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// Returns all the resources with the given type and name, in any language.
+func (f *File) FindAll(ty, name Id) []Resource {
+	var found []Resource
+	for _, r := range f.Resources {
+		if r.Type.Equals(ty) && r.Name.Equals(name) {
+			found = append(found, r)
+		}
+	}
+	return found
+}
+
+// Adds or replaces the resource with the given type, name and language.
+func (f *File) Set(ty, name Id, lang uint16, data []byte) {
+	if r, ok := f.Find(ty, name, lang); ok {
+		r.Data = data
+	} else {
+		f.Resources = append(f.Resources, Resource{ty, name, lang, data})
+	}
+}
+
+// Deletes the resource with the given type, name and language, returning true
+// if it existed.
+func (f *File) Delete(ty, name Id, lang uint16) bool {
+	for i := range f.Resources {
+		r := &f.Resources[i]
+		if r.Type.Equals(ty) && r.Name.Equals(name) && r.Lang == lang {
+			f.Resources = append(f.Resources[:i], f.Resources[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
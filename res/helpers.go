@@ -0,0 +1,138 @@
+package res
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/rodrigocfd/windigo/ico"
+	"github.com/rodrigocfd/windigo/versioninfo"
+)
+
+// Sets the application manifest, stored as the RT_MANIFEST resource with ID 1,
+// replacing any existing one, in any language.
+func (f *File) SetManifest(lang uint16, manifest []byte) {
+	f.replace(RT_MANIFEST.Id(), IdInt(1), lang, manifest)
+}
+
+// Sets the version information, stored as the RT_VERSION resource with ID 1,
+// replacing any existing one, in any language.
+func (f *File) SetVersionInfo(lang uint16, vi *versioninfo.VersionInfo) {
+	f.replace(RT_VERSION.Id(), IdInt(1), lang, vi.Bytes())
+}
+
+// Sets an icon group from the contents of an .ico file, replacing any existing
+// group with the same name, in any language.
+//
+// Each image is stored as an RT_ICON resource, and the group refers to them in
+// an RT_GROUP_ICON resource. The RT_ICON resources of the replaced group are
+// deleted, and their IDs are reused.
+//
+// The application icon, shown by Windows Explorer, is the group with the
+// lowest ID.
+func (f *File) SetIconGroup(name Id, lang uint16, icoData []byte) error {
+	icoFile, err := ico.Parse(icoData)
+	if err != nil {
+		return err
+	} else if icoFile.Type != ico.TYPE_ICON {
+		return errors.New("not an .ico file")
+	}
+
+	var freeIds []uint16
+	for _, group := range f.FindAll(RT_GROUP_ICON.Id(), name) {
+		groupEntries, err := ico.ParseGroup(group.Data)
+		if err != nil {
+			return err
+		}
+		for _, groupEntry := range groupEntries {
+			for _, icon := range f.FindAll(RT_ICON.Id(), IdInt(groupEntry.Id)) {
+				f.Delete(RT_ICON.Id(), icon.Name, icon.Lang)
+			}
+			freeIds = append(freeIds, groupEntry.Id)
+		}
+		f.Delete(RT_GROUP_ICON.Id(), group.Name, group.Lang)
+	}
+
+	ids := f.allocIds(RT_ICON.Id(), freeIds, len(icoFile.Entries))
+	for i, entry := range icoFile.Entries {
+		f.Set(RT_ICON.Id(), IdInt(ids[i]), lang, entry.Data)
+	}
+	f.Set(RT_GROUP_ICON.Id(), name, lang, icoFile.GroupBytes(ids))
+	return nil
+}
+
+// Sets strings of the string table, keyed by their IDs, merging them with the
+// existing ones of the same language. An empty string deletes the string.
+//
+// The strings are stored in RT_STRING blocks of 16 strings each, as described
+// in StringBlock.
+func (f *File) SetStrings(lang uint16, strs map[uint16]string) error {
+	blocks := make(map[uint16]*StringBlock)
+	for strId, s := range strs {
+		blockId, index := StringBlockId(strId)
+		block, ok := blocks[blockId]
+		if !ok {
+			block = &StringBlock{}
+			if r, ok := f.Find(RT_STRING.Id(), IdInt(blockId), lang); ok {
+				existing, err := ParseStringBlock(r.Data)
+				if err != nil {
+					return err
+				}
+				*block = existing
+			}
+			blocks[blockId] = block
+		}
+		block[index] = s
+	}
+
+	blockIds := make([]uint16, 0, len(blocks))
+	for blockId := range blocks {
+		blockIds = append(blockIds, blockId)
+	}
+	sort.Slice(blockIds, func(a, b int) bool { return blockIds[a] < blockIds[b] })
+
+	for _, blockId := range blockIds {
+		block := blocks[blockId]
+		if block.IsEmpty() {
+			f.Delete(RT_STRING.Id(), IdInt(blockId), lang)
+		} else {
+			f.Set(RT_STRING.Id(), IdInt(blockId), lang, block.Bytes())
+		}
+	}
+	return nil
+}
+
+// Deletes the resources with the given type and name in all languages, then
+// sets the new one.
+func (f *File) replace(ty, name Id, lang uint16, data []byte) {
+	for _, r := range f.FindAll(ty, name) {
+		f.Delete(ty, name, r.Lang)
+	}
+	f.Set(ty, name, lang, data)
+}
+
+// Returns the given number of integer IDs for the resource type, first from
+// the free ones, then after the highest ID in use.
+func (f *File) allocIds(ty Id, freeIds []uint16, count int) []uint16 {
+	sort.Slice(freeIds, func(a, b int) bool { return freeIds[a] < freeIds[b] })
+	ids := make([]uint16, 0, count)
+	for _, id := range freeIds {
+		if len(ids) < count && (len(ids) == 0 || ids[len(ids)-1] != id) {
+			ids = append(ids, id)
+		}
+	}
+
+	var maxId uint16
+	for _, r := range f.Resources {
+		if id, ok := r.Name.Int(); ok && r.Type.Equals(ty) {
+			maxId = max(maxId, id)
+		}
+	}
+	for _, id := range ids {
+		maxId = max(maxId, id)
+	}
+	for len(ids) < count {
+		maxId++
+		ids = append(ids, maxId)
+	}
+	return ids
+}
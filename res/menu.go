@@ -0,0 +1,132 @@
+package res
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// A [MENUEX] menu template, stored as an RT_MENU resource.
+//
+// # Example
+//
+//	menu := res.Menu{
+//		Items: []res.MenuItem{{
+//			Text: "&File",
+//			Items: []res.MenuItem{
+//				{Text: "&Open", Id: 1001},
+//				{Type: 0x800}, // MFT_SEPARATOR
+//				{Text: "E&xit", Id: 1002},
+//			},
+//		}},
+//	}
+//	data := menu.Bytes()
+//
+// [MENUEX]: https://learn.microsoft.com/en-us/windows/win32/menurc/menuex-template-header
+type Menu struct {
+	HelpId uint32
+	Items  []MenuItem
+}
+
+// An item of a Menu. If it has sub-items, it's a popup.
+type MenuItem struct {
+	Type   uint32 // MFT flags.
+	State  uint32 // MFS flags.
+	Id     uint32
+	Text   string
+	HelpId uint32 // Stored only for popups.
+	Items  []MenuItem
+}
+
+// Parses an RT_MENU resource in the MENUEX format. The older format, produced
+// by MENU statements, is not supported.
+func ParseMenu(src []byte) (*Menu, error) {
+	le := binary.LittleEndian
+	if len(src) < 4 || le.Uint16(src[0:]) != 1 {
+		return nil, errors.New("not a MENUEX resource")
+	}
+	offset := int(le.Uint16(src[2:]))
+	if offset < 4 || 4+offset > len(src) {
+		return nil, errors.New("invalid MENUEX header")
+	}
+
+	menu := &Menu{HelpId: le.Uint32(src[4:])}
+	var err error
+	menu.Items, _, err = parseMenuItems(src, 4+offset)
+	return menu, err
+}
+
+func parseMenuItems(src []byte, pos int) ([]MenuItem, int, error) {
+	le := binary.LittleEndian
+	var items []MenuItem
+	for {
+		pos = align4(pos)
+		if pos+14 > len(src) {
+			return nil, pos, errors.New("truncated menu item")
+		}
+		item := MenuItem{
+			Type:  le.Uint32(src[pos:]),
+			State: le.Uint32(src[pos+4:]),
+			Id:    le.Uint32(src[pos+8:]),
+		}
+		resInfo := le.Uint16(src[pos+12:])
+
+		var err error
+		if item.Text, pos, err = readSz(src, pos+14); err != nil {
+			return nil, pos, err
+		}
+
+		if resInfo&0x01 != 0 { // popup
+			pos = align4(pos)
+			if pos+4 > len(src) {
+				return nil, pos, errors.New("truncated menu item")
+			}
+			item.HelpId = le.Uint32(src[pos:])
+			if item.Items, pos, err = parseMenuItems(src, pos+4); err != nil {
+				return nil, pos, err
+			}
+		}
+
+		items = append(items, item)
+		if resInfo&0x80 != 0 { // last item of this level
+			return items, pos, nil
+		}
+	}
+}
+
+// Serializes the menu as an RT_MENU resource in the MENUEX format.
+func (m *Menu) Bytes() []byte {
+	le := binary.LittleEndian
+	buf := make([]byte, 0, 64)
+	buf = le.AppendUint16(buf, 1) // wVersion
+	buf = le.AppendUint16(buf, 4) // wOffset, skipping dwHelpId
+	buf = le.AppendUint32(buf, m.HelpId)
+	return appendMenuItems(buf, m.Items)
+}
+
+func appendMenuItems(buf []byte, items []MenuItem) []byte {
+	le := binary.LittleEndian
+	for i := range items {
+		item := &items[i]
+		var resInfo uint16
+		if len(item.Items) > 0 {
+			resInfo |= 0x01
+		}
+		if i == len(items)-1 {
+			resInfo |= 0x80
+		}
+
+		buf = pad4(buf)
+		buf = le.AppendUint32(buf, item.Type)
+		buf = le.AppendUint32(buf, item.State)
+		buf = le.AppendUint32(buf, item.Id)
+		buf = le.AppendUint16(buf, resInfo)
+		buf = appendSz(buf, item.Text)
+
+		if len(item.Items) > 0 {
+			buf = pad4(buf)
+			buf = le.AppendUint32(buf, item.HelpId)
+			buf = appendMenuItems(buf, item.Items)
+		}
+	}
+	return buf
+}
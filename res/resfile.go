@@ -0,0 +1,174 @@
+package res
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf16"
+)
+
+// Reads a 32-bit .res file, as produced by resource compilers like rc.exe.
+func ReadRes(r io.Reader) (*File, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRes(src)
+}
+
+// Parses a 32-bit .res file, as produced by resource compilers like rc.exe.
+//
+// The memory flags, versions and characteristics of the resources are
+// ignored.
+func ParseRes(src []byte) (*File, error) {
+	le := binary.LittleEndian
+	file := &File{}
+	pos := 0
+
+	for pos < len(src) {
+		if pos+8 > len(src) {
+			return nil, fmt.Errorf("truncated resource header at offset %d", pos)
+		}
+		dataSize := int(le.Uint32(src[pos:]))
+		headerSize := int(le.Uint32(src[pos+4:]))
+		if headerSize < 8 || pos+headerSize > len(src) || pos+headerSize+dataSize > len(src) {
+			return nil, fmt.Errorf("invalid resource header at offset %d", pos)
+		}
+		header := src[pos : pos+headerSize]
+
+		ty, hPos, err := readSzOrOrd(header, 8)
+		if err != nil {
+			return nil, err
+		}
+		name, hPos, err := readSzOrOrd(header, hPos)
+		if err != nil {
+			return nil, err
+		}
+		hPos = align4(hPos)
+		if hPos+16 > len(header) {
+			return nil, fmt.Errorf("truncated resource header at offset %d", pos)
+		}
+		lang := le.Uint16(header[hPos+6:]) // after DataVersion and MemoryFlags
+
+		data := src[pos+headerSize : pos+headerSize+dataSize]
+		if !(dataSize == 0 && ty.Equals(IdInt(0))) { // skip the null resource
+			file.Resources = append(file.Resources, Resource{
+				Type: ty,
+				Name: name,
+				Lang: lang,
+				Data: append([]byte(nil), data...),
+			})
+		}
+		pos = align4(pos + headerSize + dataSize)
+	}
+	return file, nil
+}
+
+// Serializes the resources as a 32-bit .res file, which can be linked by
+// Windows toolchains, or read back with ParseRes().
+func (f *File) ResBytes() []byte {
+	var buf bytes.Buffer
+	f.WriteRes(&buf)
+	return buf.Bytes()
+}
+
+// Serializes the resources as a 32-bit .res file, writing it to w.
+func (f *File) WriteRes(w io.Writer) (int64, error) {
+	buf := appendResHeader(nil, IdInt(0), IdInt(0), 0, 0, 0) // null resource, which identifies the format
+
+	for _, r := range f.Resources {
+		var memFlags uint16 = 0x1030 // MOVEABLE | PURE | DISCARDABLE
+		if r.Type.Equals(RT_ICON.Id()) || r.Type.Equals(RT_CURSOR.Id()) {
+			memFlags = 0x1010 // MOVEABLE | DISCARDABLE
+		}
+		buf = appendResHeader(buf, r.Type, r.Name, r.Lang, memFlags, len(r.Data))
+		buf = append(buf, r.Data...)
+		buf = pad4(buf)
+	}
+
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+func appendResHeader(buf []byte, ty, name Id, lang, memFlags uint16, dataSize int) []byte {
+	header := make([]byte, 8, 64)
+	header = appendSzOrOrd(header, ty)
+	header = appendSzOrOrd(header, name)
+	header = pad4(header)
+
+	le := binary.LittleEndian
+	header = le.AppendUint32(header, 0) // DataVersion
+	header = le.AppendUint16(header, memFlags)
+	header = le.AppendUint16(header, lang)
+	header = le.AppendUint32(header, 0) // Version
+	header = le.AppendUint32(header, 0) // Characteristics
+	le.PutUint32(header[0:], uint32(dataSize))
+	le.PutUint32(header[4:], uint32(len(header)))
+	return append(buf, header...)
+}
+
+// Appends a string or an ordinal, as used in resource headers and templates:
+// 0xffff followed by the integer, or a null-terminated UTF-16 string.
+func appendSzOrOrd(buf []byte, id Id) []byte {
+	if str, ok := id.Str(); ok {
+		return appendSz(buf, str)
+	}
+	num, _ := id.Int()
+	buf = binary.LittleEndian.AppendUint16(buf, 0xffff)
+	return binary.LittleEndian.AppendUint16(buf, num)
+}
+
+// Reads a string or an ordinal, returning the position after it. A lone null
+// terminator is returned as an empty string.
+func readSzOrOrd(src []byte, pos int) (Id, int, error) {
+	if pos+2 > len(src) {
+		return Id{}, pos, errors.New("truncated string or ordinal")
+	}
+	if binary.LittleEndian.Uint16(src[pos:]) == 0xffff {
+		if pos+4 > len(src) {
+			return Id{}, pos, errors.New("truncated ordinal")
+		}
+		return IdInt(binary.LittleEndian.Uint16(src[pos+2:])), pos + 4, nil
+	}
+	str, pos, err := readSz(src, pos)
+	return IdStr(str), pos, err
+}
+
+// Appends a null-terminated UTF-16 string.
+func appendSz(buf []byte, s string) []byte {
+	for _, ch := range utf16.Encode([]rune(s)) {
+		buf = binary.LittleEndian.AppendUint16(buf, ch)
+	}
+	return binary.LittleEndian.AppendUint16(buf, 0)
+}
+
+// Reads a null-terminated UTF-16 string, returning the position after the
+// terminator.
+func readSz(src []byte, pos int) (string, int, error) {
+	var chars []uint16
+	for {
+		if pos+2 > len(src) {
+			return "", pos, errors.New("unterminated string")
+		}
+		ch := binary.LittleEndian.Uint16(src[pos:])
+		pos += 2
+		if ch == 0 {
+			return string(utf16.Decode(chars)), pos, nil
+		}
+		chars = append(chars, ch)
+	}
+}
+
+func align4(n int) int {
+	return (n + 3) &^ 3
+}
+
+// Appends zeros until the length is a multiple of 4.
+func pad4(buf []byte) []byte {
+	for len(buf)%4 != 0 {
+		buf = append(buf, 0)
+	}
+	return buf
+}
@@ -0,0 +1,240 @@
+package res
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+func TestResRoundTrip(t *testing.T) {
+	file := sampleFile()
+	data := file.ResBytes()
+	if !bytes.HasPrefix(data, []byte{0, 0, 0, 0, 32, 0, 0, 0, 0xff, 0xff, 0, 0, 0xff, 0xff, 0, 0}) {
+		t.Errorf("missing null resource: % x", data[:16])
+	}
+
+	parsed, err := ParseRes(data)
+	if err != nil {
+		t.Fatalf("ParseRes: %v", err)
+	}
+	if len(parsed.Resources) != len(file.Resources) {
+		t.Fatalf("%d resources, want %d", len(parsed.Resources), len(file.Resources))
+	}
+	for i, want := range file.Resources {
+		got := parsed.Resources[i]
+		if !reflect.DeepEqual(got.Type, want.Type) || !reflect.DeepEqual(got.Name, want.Name) ||
+			got.Lang != want.Lang || !bytes.Equal(got.Data, want.Data) {
+			t.Errorf("resource %d\ngot  %+v\nwant %+v", i, got, want)
+		}
+	}
+
+	if again := parsed.ResBytes(); !bytes.Equal(again, data) {
+		t.Errorf("second serialization differs")
+	}
+}
+
+func TestResHeader(t *testing.T) {
+	var file File
+	file.Set(IdStr("Abc"), IdInt(7), LANG_EN_US, []byte{1, 2, 3, 4, 5})
+	data := file.ResBytes()[32:]
+
+	le := binary.LittleEndian
+	want := le.AppendUint32(nil, 5)  // DataSize
+	want = le.AppendUint32(want, 36) // HeaderSize
+	want = append(want, 'A', 0, 'b', 0, 'c', 0, 0, 0)
+	want = append(want, 0xff, 0xff, 7, 0)
+	want = le.AppendUint32(want, 0)      // DataVersion
+	want = le.AppendUint16(want, 0x1030) // MemoryFlags
+	want = le.AppendUint16(want, LANG_EN_US)
+	want = le.AppendUint32(want, 0) // Version
+	want = le.AppendUint32(want, 0) // Characteristics
+	want = append(want, 1, 2, 3, 4, 5, 0, 0, 0)
+
+	if !bytes.Equal(data, want) {
+		t.Errorf("got  % x\nwant % x", data, want)
+	}
+}
+
+func TestParseResErrors(t *testing.T) {
+	valid := sampleFile().ResBytes()
+
+	badSize := append([]byte(nil), valid...)
+	binary.LittleEndian.PutUint32(badSize[32:], 0xffff)
+
+	unterminated := append([]byte(nil), valid[:32]...)
+	unterminated = binary.LittleEndian.AppendUint32(unterminated, 0)
+	unterminated = binary.LittleEndian.AppendUint32(unterminated, 12)
+	unterminated = append(unterminated, 'A', 0, 'B', 0)
+
+	for name, data := range map[string][]byte{
+		"truncated header": valid[:36],
+		"truncated data":   valid[:len(valid)-8],
+		"bad data size":    badSize,
+		"unterminated":     unterminated,
+	} {
+		if _, err := ParseRes(data); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestDialogRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		dlg  *Dialog
+	}{
+		{"no font", &Dialog{
+			Style: 0x80c8_0080, // WS_POPUP | WS_CAPTION | WS_SYSMENU | DS_MODALFRAME
+			Cx:    100, Cy: 50,
+			Title: "Title",
+			Items: []DialogItem{},
+		}},
+		{"full", &Dialog{
+			HelpId:  9,
+			ExStyle: 0x0004_0000,
+			Style:   0x80c8_08c8,
+			X:       -10, Y: 20, Cx: 200, Cy: 80,
+			Menu:  IdStr("MAINMENU"),
+			Class: "MyDialog",
+			Title: "Título",
+			Font:  DialogFont{PointSize: 9, Weight: 700, Italic: true, CharSet: 1, Typeface: "Segoe UI"},
+			Items: []DialogItem{
+				{
+					Style: 0x5001_0001,
+					X:     140, Y: 58, Cx: 50, Cy: 14,
+					Id:    1,
+					Class: IdInt(CLASS_BUTTON),
+					Title: IdStr("OK"),
+				},
+				{
+					HelpId:  3,
+					ExStyle: 0x200,
+					Style:   0x5000_0003,
+					Id:      0xffff_ffff,
+					Class:   IdStr("Static"),
+					Title:   IdInt(101), // icon
+					Extra:   []byte{1, 2, 3},
+				},
+				{
+					Style: 0x5081_0080,
+					Id:    1001,
+					Class: IdInt(CLASS_EDIT),
+					Title: IdStr(""),
+				},
+			},
+		}},
+		{"menu ordinal", &Dialog{
+			Menu:  IdInt(102),
+			Items: []DialogItem{},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.dlg.Bytes()
+			parsed, err := ParseDialog(data)
+			if err != nil {
+				t.Fatalf("ParseDialog: %v", err)
+			}
+			if !reflect.DeepEqual(parsed, tt.dlg) {
+				t.Errorf("got  %+v\nwant %+v", parsed, tt.dlg)
+			}
+			if again := parsed.Bytes(); !bytes.Equal(again, data) {
+				t.Errorf("second serialization differs")
+			}
+		})
+	}
+}
+
+func TestMenuRoundTrip(t *testing.T) {
+	menu := &Menu{
+		HelpId: 5,
+		Items: []MenuItem{
+			{
+				Text:   "&File",
+				HelpId: 7,
+				Items: []MenuItem{
+					{Text: "&Open", Id: 1001},
+					{Type: 0x800}, // MFT_SEPARATOR
+					{Text: "E&xit", Id: 1002, State: 0x1000},
+				},
+			},
+			{
+				Text: "&View",
+				Items: []MenuItem{
+					{
+						Text: "&Zoom",
+						Items: []MenuItem{
+							{Text: "In", Id: 2001},
+							{Text: "Out", Id: 2002},
+						},
+					},
+				},
+			},
+			{Text: "&Help", Id: 3001, Type: 0x4000}, // MFT_RIGHTJUSTIFY
+		},
+	}
+
+	data := menu.Bytes()
+	parsed, err := ParseMenu(data)
+	if err != nil {
+		t.Fatalf("ParseMenu: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, menu) {
+		t.Errorf("got  %+v\nwant %+v", parsed, menu)
+	}
+
+	if _, err := ParseMenu(data[:len(data)-6]); err == nil {
+		t.Errorf("truncated menu: expected error")
+	}
+}
+
+func TestAccelRoundTrip(t *testing.T) {
+	table := AccelTable{
+		{Flags: ACCELF_VIRTKEY | ACCELF_CONTROL, Key: 'O', Cmd: 1001},
+		{Flags: ACCELF_VIRTKEY | ACCELF_ALT | ACCELF_SHIFT | ACCELF_NOINVERT, Key: 0x70, Cmd: 1002},
+		{Key: '?', Cmd: 1003},
+	}
+
+	data := table.Bytes()
+	if len(data) != 3*_ACCEL_ENTRY_SIZE || data[2*_ACCEL_ENTRY_SIZE]&0x80 == 0 {
+		t.Errorf("last entry not flagged: % x", data)
+	}
+	parsed, err := ParseAccelTable(data)
+	if err != nil {
+		t.Fatalf("ParseAccelTable: %v", err)
+	}
+	if !reflect.DeepEqual(parsed, table) {
+		t.Errorf("got  %+v\nwant %+v", parsed, table)
+	}
+
+	if _, err := ParseAccelTable(data[:5]); err == nil {
+		t.Errorf("bad size: expected error")
+	}
+}
+
+func TestSetStrings(t *testing.T) {
+	var file File
+	file.SetStrings(LANG_EN_US, map[uint16]string{
+		300: "c", 1: "a", 100: "b", 17: "x", 2: "d", 40: "e",
+	})
+
+	var names []uint16
+	for _, r := range file.Resources {
+		id, _ := r.Name.Int()
+		names = append(names, id)
+	}
+	if want := []uint16{1, 2, 3, 7, 19}; !reflect.DeepEqual(names, want) {
+		t.Errorf("blocks %v, want %v", names, want)
+	}
+
+	file.SetStrings(LANG_EN_US, map[uint16]string{17: "", 1: "A"})
+	if _, ok := file.Find(RT_STRING.Id(), IdInt(2), LANG_EN_US); ok {
+		t.Errorf("empty block not deleted")
+	}
+	r, _ := file.Find(RT_STRING.Id(), IdInt(1), LANG_EN_US)
+	if block, err := ParseStringBlock(r.Data); err != nil || block[1] != "A" || block[2] != "d" {
+		t.Errorf("block not merged: %q %v", block, err)
+	}
+}
@@ -0,0 +1,80 @@
+package res
+
+import (
+	"encoding/binary"
+	"errors"
+	"unicode/utf16"
+)
+
+// A block of 16 strings, as stored in an RT_STRING resource.
+//
+// String tables are split in blocks: the string with ID n is stored at index
+// n%16 of the block whose resource ID is n/16+1, as returned by StringBlockId().
+// Empty strings are absent.
+//
+// # Example
+//
+//	blockId, index := res.StringBlockId(1001)
+//	var block res.StringBlock
+//	block[index] = "Hello"
+//	data := block.Bytes() // store as RT_STRING with blockId
+type StringBlock [16]string
+
+// Returns the resource ID of the block which stores the given string ID, and
+// the index of the string within the block.
+func StringBlockId(strId uint16) (blockId uint16, index int) {
+	return strId/16 + 1, int(strId % 16)
+}
+
+// Returns the string ID stored at the given index of the block.
+func StringId(blockId uint16, index int) uint16 {
+	return (blockId-1)*16 + uint16(index)
+}
+
+// Parses an RT_STRING resource, made of 16 length-prefixed UTF-16 strings.
+func ParseStringBlock(src []byte) (StringBlock, error) {
+	var block StringBlock
+	pos := 0
+	for i := range block {
+		if pos+2 > len(src) {
+			return block, errors.New("truncated RT_STRING block")
+		}
+		length := int(binary.LittleEndian.Uint16(src[pos:]))
+		pos += 2
+		if pos+length*2 > len(src) {
+			return block, errors.New("truncated RT_STRING block")
+		}
+
+		chars := make([]uint16, length)
+		for c := range chars {
+			chars[c] = binary.LittleEndian.Uint16(src[pos+c*2:])
+		}
+		block[i] = string(utf16.Decode(chars))
+		pos += length * 2
+	}
+	return block, nil
+}
+
+// Serializes the block as an RT_STRING resource.
+func (b *StringBlock) Bytes() []byte {
+	var buf []byte
+	for _, s := range b {
+		chars := utf16.Encode([]rune(s))
+		buf = binary.LittleEndian.AppendUint16(buf, uint16(len(chars)))
+		for _, ch := range chars {
+			buf = binary.LittleEndian.AppendUint16(buf, ch)
+		}
+	}
+	return buf
+}
+
+// Returns true if all the strings are empty, so the block doesn't need to be
+// stored.
+func (b *StringBlock) IsEmpty() bool {
+	for _, s := range b {
+		if s != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,296 @@
+package res
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf16"
+)
+
+// Target architecture of a .syso object.
+type ARCH uint8
+
+const (
+	ARCH_386 ARCH = iota
+	ARCH_AMD64
+	ARCH_ARM64
+)
+
+// Returns the GOARCH name of the architecture, which is used in the .syso file
+// name, like rsrc_windows_amd64.syso.
+func (a ARCH) String() string {
+	switch a {
+	case ARCH_386:
+		return "386"
+	case ARCH_AMD64:
+		return "amd64"
+	case ARCH_ARM64:
+		return "arm64"
+	default:
+		return "unknown"
+	}
+}
+
+// Returns the COFF machine and the 32-bit image-relative relocation type.
+func (a ARCH) coff() (machine, relocType uint16) {
+	switch a {
+	case ARCH_386:
+		return 0x014c, 0x0007 // IMAGE_FILE_MACHINE_I386, IMAGE_REL_I386_DIR32NB
+	case ARCH_AMD64:
+		return 0x8664, 0x0003 // IMAGE_FILE_MACHINE_AMD64, IMAGE_REL_AMD64_ADDR32NB
+	case ARCH_ARM64:
+		return 0xaa64, 0x0002 // IMAGE_FILE_MACHINE_ARM64, IMAGE_REL_ARM64_ADDR32NB
+	default:
+		panic("Invalid ARCH value.")
+	}
+}
+
+const (
+	_COFF_HEADER_SIZE    = 20
+	_SECTION_HEADER_SIZE = 40
+	_RELOC_SIZE          = 10
+	_SYMBOL_SIZE         = 18
+	_DIR_SIZE            = 16 // IMAGE_RESOURCE_DIRECTORY
+	_DIR_ENTRY_SIZE      = 8  // IMAGE_RESOURCE_DIRECTORY_ENTRY
+	_DATA_ENTRY_SIZE     = 16 // IMAGE_RESOURCE_DATA_ENTRY
+)
+
+// Compiles the resources into a COFF object with a .rsrc section, returning the
+// bytes. When saved with the .syso extension in the package folder, the object
+// is linked by the Go toolchain into Windows executables.
+//
+// Name the file with the architecture suffix, like rsrc_windows_amd64.syso, so
+// it's only linked into executables of that architecture.
+func (f *File) SysoBytes(arch ARCH) []byte {
+	var buf bytes.Buffer
+	f.WriteSyso(&buf, arch)
+	return buf.Bytes()
+}
+
+// Compiles the resources into a COFF object with a .rsrc section, writing it to
+// w, as described in SysoBytes().
+func (f *File) WriteSyso(w io.Writer, arch ARCH) (int64, error) {
+	machine, relocType := arch.coff()
+	section, relocs := f.rsrcSection()
+
+	le := binary.LittleEndian
+	var characteristics uint16 = 0x0004 // IMAGE_FILE_LINE_NUMS_STRIPPED
+	if arch == ARCH_386 {
+		characteristics |= 0x0100 // IMAGE_FILE_32BIT_MACHINE
+	}
+	ptrRawData := _COFF_HEADER_SIZE + _SECTION_HEADER_SIZE
+	ptrRelocs := ptrRawData + len(section)
+	ptrSymbols := ptrRelocs + len(relocs)*_RELOC_SIZE
+
+	buf := make([]byte, 0, ptrSymbols+_SYMBOL_SIZE+4)
+	buf = le.AppendUint16(buf, machine)
+	buf = le.AppendUint16(buf, 1) // NumberOfSections
+	buf = le.AppendUint32(buf, 0) // TimeDateStamp
+	buf = le.AppendUint32(buf, uint32(ptrSymbols))
+	buf = le.AppendUint32(buf, 1) // NumberOfSymbols
+	buf = le.AppendUint16(buf, 0) // SizeOfOptionalHeader
+	buf = le.AppendUint16(buf, characteristics)
+
+	buf = append(buf, ".rsrc\x00\x00\x00"...)
+	buf = le.AppendUint32(buf, 0) // VirtualSize
+	buf = le.AppendUint32(buf, 0) // VirtualAddress
+	buf = le.AppendUint32(buf, uint32(len(section)))
+	buf = le.AppendUint32(buf, uint32(ptrRawData))
+	buf = le.AppendUint32(buf, uint32(ptrRelocs))
+	buf = le.AppendUint32(buf, 0) // PointerToLinenumbers
+	buf = le.AppendUint16(buf, uint16(len(relocs)))
+	buf = le.AppendUint16(buf, 0)           // NumberOfLinenumbers
+	buf = le.AppendUint32(buf, 0x4040_0040) // CNT_INITIALIZED_DATA | ALIGN_8BYTES | MEM_READ
+
+	buf = append(buf, section...)
+
+	for _, offset := range relocs {
+		buf = le.AppendUint32(buf, offset)
+		buf = le.AppendUint32(buf, 0) // SymbolTableIndex of .rsrc
+		buf = le.AppendUint16(buf, relocType)
+	}
+
+	buf = append(buf, ".rsrc\x00\x00\x00"...)
+	buf = le.AppendUint32(buf, 0) // Value
+	buf = le.AppendUint16(buf, 1) // SectionNumber
+	buf = le.AppendUint16(buf, 0) // Type
+	buf = append(buf, 3, 0)       // IMAGE_SYM_CLASS_STATIC, NumberOfAuxSymbols
+	buf = le.AppendUint32(buf, 4) // empty string table
+
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// A node of the resource directory tree: type, name or language.
+type _DirNode struct {
+	id       Id
+	children []_DirNode
+	res      *Resource // language nodes only
+}
+
+// Builds the resource directory tree: types, then names, then languages, each
+// level sorted with named entries first, as required by the loader.
+func (f *File) dirTree() []_DirNode {
+	var types []_DirNode
+	for i := range f.Resources {
+		r := &f.Resources[i]
+		tyNode := findOrAddNode(&types, r.Type)
+		nameNode := findOrAddNode(&tyNode.children, r.Name)
+		nameNode.children = append(nameNode.children, _DirNode{id: IdInt(r.Lang), res: r})
+	}
+
+	sortNodes(types)
+	for t := range types {
+		sortNodes(types[t].children)
+		for n := range types[t].children {
+			sortNodes(types[t].children[n].children)
+		}
+	}
+	return types
+}
+
+func findOrAddNode(nodes *[]_DirNode, id Id) *_DirNode {
+	for i := range *nodes {
+		if (*nodes)[i].id.Equals(id) {
+			return &(*nodes)[i]
+		}
+	}
+	*nodes = append(*nodes, _DirNode{id: id})
+	return &(*nodes)[len(*nodes)-1]
+}
+
+func sortNodes(nodes []_DirNode) {
+	sort.SliceStable(nodes, func(a, b int) bool {
+		aStr, aIsStr := nodes[a].id.Str()
+		bStr, bIsStr := nodes[b].id.Str()
+		if aIsStr != bIsStr {
+			return aIsStr // named entries come first
+		} else if aIsStr {
+			return strings.ToUpper(aStr) < strings.ToUpper(bStr)
+		}
+		aNum, _ := nodes[a].id.Int()
+		bNum, _ := nodes[b].id.Int()
+		return aNum < bNum
+	})
+}
+
+// Serializes the .rsrc section: directories, data entries, name strings and
+// resource data, in this order. Returns the section and the offsets of the
+// data entry fields which must be relocated.
+func (f *File) rsrcSection() ([]byte, []uint32) {
+	types := f.dirTree()
+
+	// Flatten the directories, level by level.
+	dirs := [][]_DirNode{types}
+	for _, ty := range types {
+		dirs = append(dirs, ty.children)
+	}
+	for _, ty := range types {
+		for _, name := range ty.children {
+			dirs = append(dirs, name.children)
+		}
+	}
+
+	dirOffsets := make([]int, len(dirs))
+	pos := 0
+	for i, dir := range dirs {
+		dirOffsets[i] = pos
+		pos += _DIR_SIZE + len(dir)*_DIR_ENTRY_SIZE
+	}
+
+	dataEntriesOffset := pos
+	pos += len(f.Resources) * _DATA_ENTRY_SIZE
+
+	strOffsets := make(map[string]int)
+	var strs []string
+	for _, dir := range dirs {
+		for _, node := range dir {
+			if str, ok := node.id.Str(); ok {
+				str = strings.ToUpper(str)
+				if _, ok := strOffsets[str]; !ok {
+					strOffsets[str] = pos
+					strs = append(strs, str)
+					pos += 2 + len(utf16.Encode([]rune(str)))*2
+				}
+			}
+		}
+	}
+
+	le := binary.LittleEndian
+	buf := make([]byte, 0, pos)
+	var dataEntries []*Resource
+
+	// Directories point to the directories of the next level, in the same order
+	// they were flattened, or to the data entries.
+	nextDir := 1
+	for _, dir := range dirs {
+		numNamed := 0
+		for _, node := range dir {
+			if _, ok := node.id.Str(); ok {
+				numNamed++
+			}
+		}
+		buf = le.AppendUint32(buf, 0) // Characteristics
+		buf = le.AppendUint32(buf, 0) // TimeDateStamp
+		buf = le.AppendUint32(buf, 0) // MajorVersion and MinorVersion
+		buf = le.AppendUint16(buf, uint16(numNamed))
+		buf = le.AppendUint16(buf, uint16(len(dir)-numNamed))
+
+		for _, node := range dir {
+			if str, ok := node.id.Str(); ok {
+				buf = le.AppendUint32(buf, 0x8000_0000|uint32(strOffsets[strings.ToUpper(str)]))
+			} else {
+				num, _ := node.id.Int()
+				buf = le.AppendUint32(buf, uint32(num))
+			}
+
+			if node.res != nil {
+				buf = le.AppendUint32(buf,
+					uint32(dataEntriesOffset+len(dataEntries)*_DATA_ENTRY_SIZE))
+				dataEntries = append(dataEntries, node.res)
+			} else {
+				buf = le.AppendUint32(buf, 0x8000_0000|uint32(dirOffsets[nextDir]))
+				nextDir++
+			}
+		}
+	}
+
+	dataOffset := align8(pos)
+	relocs := make([]uint32, 0, len(dataEntries))
+	for _, r := range dataEntries {
+		relocs = append(relocs, uint32(len(buf)))
+		buf = le.AppendUint32(buf, uint32(dataOffset)) // relocated to an RVA
+		buf = le.AppendUint32(buf, uint32(len(r.Data)))
+		buf = le.AppendUint32(buf, 0) // CodePage
+		buf = le.AppendUint32(buf, 0) // Reserved
+		dataOffset = align8(dataOffset + len(r.Data))
+	}
+
+	for _, str := range strs {
+		chars := utf16.Encode([]rune(str))
+		buf = le.AppendUint16(buf, uint16(len(chars)))
+		for _, ch := range chars {
+			buf = le.AppendUint16(buf, ch)
+		}
+	}
+
+	for _, r := range dataEntries {
+		buf = pad8(buf)
+		buf = append(buf, r.Data...)
+	}
+	return pad8(buf), relocs
+}
+
+func align8(n int) int {
+	return (n + 7) &^ 7
+}
+
+// Appends zeros until the length is a multiple of 8.
+func pad8(buf []byte) []byte {
+	for len(buf)%8 != 0 {
+		buf = append(buf, 0)
+	}
+	return buf
+}
@@ -0,0 +1,191 @@
+package res
+
+import (
+	"bytes"
+	"debug/pe"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+func sampleFile() *File {
+	var f File
+	f.Set(RT_MANIFEST.Id(), IdInt(1), LANG_NEUTRAL, []byte("<assembly/>"))
+	f.Set(RT_RCDATA.Id(), IdStr("data"), LANG_EN_US, []byte{1, 2, 3})
+	f.Set(RT_RCDATA.Id(), IdStr("data"), LANG_NEUTRAL, []byte{4, 5, 6, 7, 8, 9, 10, 11, 12})
+	f.Set(RT_RCDATA.Id(), IdInt(7), LANG_NEUTRAL, []byte{})
+	f.Set(IdStr("MyType"), IdStr("b"), LANG_NEUTRAL, []byte("second"))
+	f.Set(IdStr("MyType"), IdStr("A"), LANG_NEUTRAL, []byte("first"))
+	f.Set(RT_VERSION.Id(), IdInt(1), LANG_EN_US, bytes.Repeat([]byte{0xab}, 100))
+	return &f
+}
+
+func TestSyso(t *testing.T) {
+	tests := []struct {
+		arch      ARCH
+		machine   uint16
+		relocType uint16
+	}{
+		{ARCH_386, pe.IMAGE_FILE_MACHINE_I386, 0x0007},    // IMAGE_REL_I386_DIR32NB
+		{ARCH_AMD64, pe.IMAGE_FILE_MACHINE_AMD64, 0x0003}, // IMAGE_REL_AMD64_ADDR32NB
+		{ARCH_ARM64, pe.IMAGE_FILE_MACHINE_ARM64, 0x0002}, // IMAGE_REL_ARM64_ADDR32NB
+	}
+
+	file := sampleFile()
+	for _, tt := range tests {
+		t.Run(tt.arch.String(), func(t *testing.T) {
+			obj, err := pe.NewFile(bytes.NewReader(file.SysoBytes(tt.arch)))
+			if err != nil {
+				t.Fatalf("debug/pe: %v", err)
+			}
+			defer obj.Close()
+
+			if obj.Machine != tt.machine {
+				t.Errorf("machine %#04x, want %#04x", obj.Machine, tt.machine)
+			}
+			if len(obj.Sections) != 1 || obj.Sections[0].Name != ".rsrc" {
+				t.Fatalf("expected a single .rsrc section, got %d", len(obj.Sections))
+			}
+			sec := obj.Sections[0]
+			if sec.Characteristics != pe.IMAGE_SCN_CNT_INITIALIZED_DATA|
+				pe.IMAGE_SCN_MEM_READ|0x0040_0000 { // IMAGE_SCN_ALIGN_8BYTES
+				t.Errorf("section characteristics %#08x", sec.Characteristics)
+			}
+			if len(obj.Symbols) != 1 || obj.Symbols[0].Name != ".rsrc" ||
+				obj.Symbols[0].SectionNumber != 1 || obj.Symbols[0].StorageClass != 3 {
+				t.Errorf("bad symbol table: %+v", obj.Symbols)
+			}
+
+			data, err := sec.Data()
+			if err != nil {
+				t.Fatalf("section data: %v", err)
+			}
+			leaves := walkDir(t, data)
+			if len(leaves) != len(file.Resources) {
+				t.Errorf("%d data entries, want %d", len(leaves), len(file.Resources))
+			}
+
+			// Each data entry must be relocated, and only them.
+			if len(sec.Relocs) != len(file.Resources) {
+				t.Fatalf("%d relocations, want %d", len(sec.Relocs), len(file.Resources))
+			}
+			relocated := make(map[uint32]bool)
+			for _, rel := range sec.Relocs {
+				if rel.Type != tt.relocType || rel.SymbolTableIndex != 0 {
+					t.Errorf("relocation %+v, want type %#x to symbol 0", rel, tt.relocType)
+				}
+				relocated[rel.VirtualAddress] = true
+			}
+
+			found := make(map[string][]byte)
+			for _, leaf := range leaves {
+				if !relocated[leaf.entryOffset] {
+					t.Errorf("data entry at %#x is not relocated", leaf.entryOffset)
+				}
+				if leaf.dataOffset%8 != 0 {
+					t.Errorf("data of %s is not 8-byte aligned", leaf.path)
+				}
+				found[leaf.path] = data[leaf.dataOffset : leaf.dataOffset+leaf.size]
+			}
+			for _, r := range file.Resources {
+				path := fmt.Sprintf("%s/%s/%d", dirName(r.Type), dirName(r.Name), r.Lang)
+				if got, ok := found[path]; !ok {
+					t.Errorf("resource %s not found", path)
+				} else if !bytes.Equal(got, r.Data) {
+					t.Errorf("resource %s has data % x, want % x", path, got, r.Data)
+				}
+			}
+		})
+	}
+}
+
+// Returns the name of the Id as stored in the resource directory, where strings
+// are uppercase.
+func dirName(id Id) string {
+	if str, ok := id.Str(); ok {
+		return strings.ToUpper(str)
+	}
+	return id.String()
+}
+
+// A data entry found in the resource directory.
+type _Leaf struct {
+	path        string // type/name/lang
+	entryOffset uint32 // offset of the IMAGE_RESOURCE_DATA_ENTRY
+	dataOffset  uint32 // OffsetToData, before the relocation
+	size        uint32
+}
+
+// Walks the resource directory tree, checking that named entries come first
+// and that each level is sorted.
+func walkDir(t *testing.T, data []byte) []_Leaf {
+	t.Helper()
+	le := binary.LittleEndian
+	var leaves []_Leaf
+
+	var walk func(offset uint32, prefix string, level int)
+	walk = func(offset uint32, prefix string, level int) {
+		numNamed := int(le.Uint16(data[offset+12:]))
+		numIds := int(le.Uint16(data[offset+14:]))
+		var prevName string
+		var prevId uint32
+
+		for i := 0; i < numNamed+numIds; i++ {
+			entry := offset + _DIR_SIZE + uint32(i*_DIR_ENTRY_SIZE)
+			nameField := le.Uint32(data[entry:])
+			target := le.Uint32(data[entry+4:])
+
+			var name string
+			if i < numNamed {
+				if nameField&0x8000_0000 == 0 {
+					t.Fatalf("%s: entry %d should be named", prefix, i)
+				}
+				strPos := nameField &^ 0x8000_0000
+				strLen := uint32(le.Uint16(data[strPos:]))
+				chars := make([]uint16, strLen)
+				for c := range chars {
+					chars[c] = le.Uint16(data[strPos+2+uint32(c)*2:])
+				}
+				name = string(utf16.Decode(chars))
+				if i > 0 && name <= prevName {
+					t.Errorf("%s: named entries not sorted: %q after %q", prefix, name, prevName)
+				}
+				prevName = name
+			} else {
+				if nameField&0x8000_0000 != 0 {
+					t.Fatalf("%s: entry %d should be an ID", prefix, i)
+				}
+				if i > numNamed && nameField <= prevId {
+					t.Errorf("%s: ID entries not sorted: %d after %d", prefix, nameField, prevId)
+				}
+				prevId = nameField
+				name = fmt.Sprintf("#%d", nameField)
+				if level == 2 {
+					name = fmt.Sprintf("%d", nameField) // language
+				}
+			}
+
+			path := prefix + name
+			if level < 2 {
+				if target&0x8000_0000 == 0 {
+					t.Fatalf("%s: expected a subdirectory", path)
+				}
+				walk(target&^0x8000_0000, path+"/", level+1)
+			} else {
+				if target&0x8000_0000 != 0 {
+					t.Fatalf("%s: expected a data entry", path)
+				}
+				leaves = append(leaves, _Leaf{
+					path:        path,
+					entryOffset: target,
+					dataOffset:  le.Uint32(data[target:]),
+					size:        le.Uint32(data[target+4:]),
+				})
+			}
+		}
+	}
+	walk(0, "", 0)
+	return leaves
+}
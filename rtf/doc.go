@@ -0,0 +1,124 @@
+package rtf
+
+// An RTF document, made of paragraphs and tables.
+//
+// # Example
+//
+//	doc := rtf.Document{
+//		Blocks: []rtf.Block{
+//			&rtf.Paragraph{
+//				Runs: []rtf.Run{
+//					{Text: "Hello, "},
+//					{Text: "world", Bold: true, Color: &rtf.Color{R: 200}},
+//				},
+//			},
+//		},
+//	}
+//	data := doc.Bytes()
+type Document struct {
+	DefaultFont string  // Font used when a run has no font. If empty, "Segoe UI" is used.
+	DefaultSize float64 // Size, in points, used when a run has no size. If zero, 9 is used.
+	Blocks      []Block // Paragraphs and tables, in document order.
+}
+
+// A block of a Document: either a *Paragraph or a *Table.
+type Block interface {
+	implBlock() // prevent public implementation
+}
+
+// A paragraph, made of runs of text sharing the same formatting.
+//
+// Indentations and spacings are measured in twips, 1/1440 of an inch.
+type Paragraph struct {
+	Runs        []Run
+	Align       ALIGN
+	IndentLeft  int
+	IndentRight int
+	IndentFirst int // Indentation of the first line, relative to IndentLeft; can be negative.
+	SpaceBefore int
+	SpaceAfter  int
+}
+
+// Implements Block.
+func (*Paragraph) implBlock() {}
+
+// Returns the concatenated text of all runs.
+func (p Paragraph) Text() string {
+	n := 0
+	for _, run := range p.Runs {
+		n += len(run.Text)
+	}
+	buf := make([]byte, 0, n)
+	for _, run := range p.Runs {
+		buf = append(buf, run.Text...)
+	}
+	return string(buf)
+}
+
+// A table, made of rows of cells.
+type Table struct {
+	Rows []Row
+}
+
+// Implements Block.
+func (*Table) implBlock() {}
+
+// A row of a Table.
+type Row struct {
+	Cells []Cell
+}
+
+// A cell of a Row, which holds its own paragraphs.
+type Cell struct {
+	Width      int // Cell width, in twips. If zero, 2000 is used.
+	Paragraphs []Paragraph
+}
+
+// A run of text with uniform character formatting.
+//
+// Line breaks and tabs are written as RTF \line and \tab.
+type Run struct {
+	Text      string
+	Font      string  // Font face name. If empty, Document.DefaultFont is used.
+	Size      float64 // Size in points, rounded to half points. If zero, Document.DefaultSize is used.
+	Color     *Color  // Text color. If nil, the automatic color is used.
+	BgColor   *Color  // Highlight color. If nil, there is no highlight.
+	Bold      bool
+	Italic    bool
+	Underline bool
+	Strike    bool
+}
+
+// Tells whether both runs have the same formatting, ignoring the text.
+func (r Run) SameFormat(other Run) bool {
+	return r.Font == other.Font &&
+		r.Size == other.Size &&
+		r.Color.equals(other.Color) &&
+		r.BgColor.equals(other.BgColor) &&
+		r.Bold == other.Bold &&
+		r.Italic == other.Italic &&
+		r.Underline == other.Underline &&
+		r.Strike == other.Strike
+}
+
+// An RGB color.
+type Color struct {
+	R, G, B uint8
+}
+
+func (c *Color) equals(other *Color) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	return *c == *other
+}
+
+// Paragraph alignment.
+type ALIGN uint8
+
+const (
+	ALIGN_LEFT    ALIGN = iota // Paragraph is left-aligned; RTF \ql.
+	ALIGN_CENTER               // Paragraph is centered; RTF \qc.
+	ALIGN_RIGHT                // Paragraph is right-aligned; RTF \qr.
+	ALIGN_JUSTIFY              // Paragraph is justified; RTF \qj.
+)
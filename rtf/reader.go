@@ -0,0 +1,505 @@
+package rtf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// Reads all the RTF content from r and parses it.
+func Read(r io.Reader) (*Document, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(src)
+}
+
+// Parses the common subset of RTF into a Document: fonts, colors, character
+// formatting, paragraph alignment, indentation and spacing, and tables.
+// Unknown control words and destinations are ignored.
+//
+// Runs carry their effective formatting, so a font or size set at document
+// level will appear in every run.
+func Parse(src []byte) (*Document, error) {
+	if !bytes.HasPrefix(src, []byte("{\\rtf")) {
+		return nil, errors.New("not an RTF document")
+	}
+
+	p := _Parser{
+		src:   src,
+		doc:   &Document{},
+		fonts: make(map[int]string),
+	}
+	p.state.uc = 1
+	p.state.fontIdx = -1
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	return p.doc, nil
+}
+
+// Destination of the current group.
+type _DEST uint8
+
+const (
+	_DEST_TEXT     _DEST = iota // regular document text
+	_DEST_FONTTBL               // inside \fonttbl
+	_DEST_FONT                  // inside a font entry of \fonttbl
+	_DEST_COLORTBL              // inside \colortbl
+	_DEST_SKIP                  // ignored destination
+)
+
+// Formatting state, saved and restored with groups.
+type _State struct {
+	dest     _DEST
+	uc       int // number of fallback chars after \u
+	fontIdx  int
+	halfPts  int
+	colorIdx int
+	bgIdx    int
+	bold     bool
+	italic   bool
+	ul       bool
+	strike   bool
+}
+
+type _Parser struct {
+	src   []byte
+	pos   int
+	doc   *Document
+	state _State
+	stack []_State
+
+	fonts     map[int]string
+	fontName  strings.Builder
+	fontEntry int
+	deff      int
+	colors    []*Color // nil entries are the automatic color
+	curColor  Color
+	hasColor  bool
+
+	para    Paragraph       // paragraph being built
+	runText strings.Builder // text of the last run of para, set when it ends
+	inTable bool            // \intbl is set
+	cellx   []int           // right edges of the current row cells
+	cell    Cell            // cell being built
+	row     Row             // row being built
+	table   *Table          // table being built
+	skipUc  int             // fallback chars still to be skipped
+	pending []uint16        // high surrogate waiting for its pair
+}
+
+func (p *_Parser) parse() error {
+	for p.pos < len(p.src) {
+		ch := p.src[p.pos]
+		switch ch {
+		case '{':
+			p.pos++
+			p.stack = append(p.stack, p.state)
+		case '}':
+			p.pos++
+			if len(p.stack) == 0 {
+				return fmt.Errorf("unbalanced closing brace at offset %d", p.pos-1)
+			}
+			p.endGroup()
+		case '\\':
+			if err := p.parseControl(); err != nil {
+				return err
+			}
+		case '\r', '\n':
+			p.pos++
+		default:
+			p.pos++
+			p.addByte(ch)
+		}
+	}
+
+	if len(p.stack) > 0 {
+		return errors.New("unexpected end of RTF document, unbalanced braces")
+	}
+	p.endParagraph(false) // text after the last \par
+	p.endTable()
+	return nil
+}
+
+func (p *_Parser) endGroup() {
+	if p.state.dest == _DEST_FONT {
+		p.storeFont()
+	}
+	p.state = p.stack[len(p.stack)-1]
+	p.stack = p.stack[:len(p.stack)-1]
+	p.skipUc = 0
+}
+
+func (p *_Parser) parseControl() error {
+	p.pos++ // skip backslash
+	if p.pos >= len(p.src) {
+		return errors.New("unexpected end of RTF document after backslash")
+	}
+
+	ch := p.src[p.pos]
+	if !_IsAlpha(ch) { // control symbol
+		p.pos++
+		switch ch {
+		case '\\', '{', '}':
+			p.addByte(ch)
+		case '\'':
+			if p.pos+2 > len(p.src) {
+				return errors.New("unexpected end of RTF document in \\' escape")
+			}
+			b, err := strconv.ParseUint(string(p.src[p.pos:p.pos+2]), 16, 8)
+			if err != nil {
+				return fmt.Errorf("bad \\' escape at offset %d", p.pos)
+			}
+			p.pos += 2
+			p.addByte(byte(b))
+		case '~':
+			p.addRune(' ')
+		case '_':
+			p.addRune('‑')
+		case '*':
+			p.state.dest = _DEST_SKIP
+		case '\r', '\n':
+			p.control("par", 0, false) // same as \par
+		}
+		return nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.src) && _IsAlpha(p.src[p.pos]) {
+		p.pos++
+	}
+	word := string(p.src[start:p.pos])
+
+	param, hasParam := 0, false
+	if p.pos < len(p.src) && (p.src[p.pos] == '-' || _IsDigit(p.src[p.pos])) {
+		neg := p.src[p.pos] == '-'
+		if neg {
+			p.pos++
+		}
+		for p.pos < len(p.src) && _IsDigit(p.src[p.pos]) {
+			param = param*10 + int(p.src[p.pos]-'0')
+			hasParam = true
+			p.pos++
+		}
+		if neg {
+			param = -param
+		}
+	}
+	if p.pos < len(p.src) && p.src[p.pos] == ' ' {
+		p.pos++ // the space is part of the control word
+	}
+
+	p.control(word, param, hasParam)
+	return nil
+}
+
+func (p *_Parser) control(word string, param int, hasParam bool) {
+	if p.state.dest == _DEST_SKIP {
+		return
+	}
+	on := !hasParam || param != 0 // toggle properties
+
+	switch word {
+	// Destinations.
+	case "fonttbl":
+		p.state.dest = _DEST_FONTTBL
+	case "colortbl":
+		p.state.dest = _DEST_COLORTBL
+	case "stylesheet", "info", "pict", "object", "header", "footer",
+		"headerl", "headerr", "footerl", "footerr", "footnote",
+		"listtable", "listoverridetable", "revtbl", "rsidtbl",
+		"generator", "xmlnstbl", "themedata", "colorschememapping",
+		"latentstyles", "datastore", "fldinst", "nonshppict":
+		p.state.dest = _DEST_SKIP
+
+	// Font and color tables.
+	case "deff":
+		p.deff = param
+	case "f":
+		if p.state.dest == _DEST_FONTTBL || p.state.dest == _DEST_FONT {
+			p.state.dest = _DEST_FONT
+			p.fontEntry = param
+			p.fontName.Reset()
+		} else {
+			p.state.fontIdx = param
+		}
+	case "red":
+		p.curColor.R, p.hasColor = uint8(param), true
+	case "green":
+		p.curColor.G, p.hasColor = uint8(param), true
+	case "blue":
+		p.curColor.B, p.hasColor = uint8(param), true
+
+	// Character formatting.
+	case "plain":
+		p.state.fontIdx, p.state.halfPts = -1, 0
+		p.state.colorIdx, p.state.bgIdx = 0, 0
+		p.state.bold, p.state.italic, p.state.ul, p.state.strike = false, false, false, false
+	case "fs":
+		p.state.halfPts = param
+	case "cf":
+		p.state.colorIdx = param
+	case "highlight", "cb", "chcbpat":
+		p.state.bgIdx = param
+	case "b":
+		p.state.bold = on
+	case "i":
+		p.state.italic = on
+	case "ul":
+		p.state.ul = on
+	case "ulnone":
+		p.state.ul = false
+	case "strike":
+		p.state.strike = on
+	case "uc":
+		p.state.uc = param
+
+	// Paragraph formatting.
+	case "pard":
+		p.para = Paragraph{Runs: p.para.Runs}
+		p.inTable = false
+	case "ql":
+		p.para.Align = ALIGN_LEFT
+	case "qc":
+		p.para.Align = ALIGN_CENTER
+	case "qr":
+		p.para.Align = ALIGN_RIGHT
+	case "qj":
+		p.para.Align = ALIGN_JUSTIFY
+	case "li":
+		p.para.IndentLeft = param
+	case "ri":
+		p.para.IndentRight = param
+	case "fi":
+		p.para.IndentFirst = param
+	case "sb":
+		p.para.SpaceBefore = param
+	case "sa":
+		p.para.SpaceAfter = param
+	case "par":
+		p.endParagraph(true)
+
+	// Tables.
+	case "intbl":
+		p.inTable = true
+	case "trowd":
+		p.cellx = p.cellx[:0]
+	case "cellx":
+		p.cellx = append(p.cellx, param)
+	case "cell":
+		p.endCell()
+	case "row":
+		p.endRow()
+
+	// Special characters.
+	case "line":
+		p.addRune('\n')
+	case "tab":
+		p.addRune('\t')
+	case "emdash":
+		p.addRune('—')
+	case "endash":
+		p.addRune('–')
+	case "bullet":
+		p.addRune('•')
+	case "lquote":
+		p.addRune('‘')
+	case "rquote":
+		p.addRune('’')
+	case "ldblquote":
+		p.addRune('“')
+	case "rdblquote":
+		p.addRune('”')
+	case "u":
+		p.addUtf16(uint16(int16(param)))
+		p.skipUc = p.state.uc
+	}
+}
+
+// Adds a raw byte, which is interpreted according to the destination.
+func (p *_Parser) addByte(b byte) {
+	if p.skipUc > 0 { // fallback char after \u
+		p.skipUc--
+		return
+	}
+
+	switch p.state.dest {
+	case _DEST_FONT:
+		if b == ';' {
+			p.storeFont()
+			p.state.dest = _DEST_FONTTBL
+		} else {
+			p.fontName.WriteRune(_Cp1252ToRune(b))
+		}
+	case _DEST_COLORTBL:
+		if b == ';' {
+			if p.hasColor {
+				color := p.curColor
+				p.colors = append(p.colors, &color)
+			} else {
+				p.colors = append(p.colors, nil) // automatic color, usually index 0
+			}
+			p.curColor, p.hasColor = Color{}, false
+		}
+	case _DEST_TEXT:
+		p.addRune(_Cp1252ToRune(b))
+	}
+}
+
+func (p *_Parser) addUtf16(c uint16) {
+	if utf16.IsSurrogate(rune(c)) {
+		if len(p.pending) == 0 {
+			p.pending = append(p.pending, c)
+			return
+		}
+		r := utf16.DecodeRune(rune(p.pending[0]), rune(c))
+		p.pending = p.pending[:0]
+		p.addRune(r)
+		return
+	}
+	p.addRune(rune(c))
+}
+
+// Adds a char to the current run, creating a new run if the formatting changed.
+func (p *_Parser) addRune(r rune) {
+	if p.state.dest == _DEST_FONT {
+		p.fontName.WriteRune(r)
+		return
+	} else if p.state.dest != _DEST_TEXT {
+		return
+	}
+
+	run := p.currentRun()
+	n := len(p.para.Runs)
+	if n == 0 || !p.para.Runs[n-1].SameFormat(run) {
+		p.endRun()
+		p.para.Runs = append(p.para.Runs, run)
+	}
+	p.runText.WriteRune(r)
+}
+
+// Sets the text of the last run of the current paragraph, if any.
+func (p *_Parser) endRun() {
+	if n := len(p.para.Runs); n > 0 && p.runText.Len() > 0 {
+		p.para.Runs[n-1].Text = p.runText.String()
+	}
+	p.runText.Reset()
+}
+
+// Returns an empty run with the current formatting.
+func (p *_Parser) currentRun() Run {
+	run := Run{
+		Bold:      p.state.bold,
+		Italic:    p.state.italic,
+		Underline: p.state.ul,
+		Strike:    p.state.strike,
+		Size:      float64(p.state.halfPts) / 2,
+	}
+
+	fontIdx := p.state.fontIdx
+	if fontIdx == -1 {
+		fontIdx = p.deff
+	}
+	run.Font = p.fonts[fontIdx]
+
+	if p.state.colorIdx >= 0 && p.state.colorIdx < len(p.colors) {
+		run.Color = p.colors[p.state.colorIdx]
+	}
+	if p.state.bgIdx > 0 && p.state.bgIdx < len(p.colors) { // zero means no highlight
+		run.BgColor = p.colors[p.state.bgIdx]
+	}
+	return run
+}
+
+func (p *_Parser) storeFont() {
+	name := strings.TrimSpace(p.fontName.String())
+	if name != "" {
+		p.fonts[p.fontEntry] = name
+		if p.fontEntry == p.deff && p.doc.DefaultFont == "" {
+			p.doc.DefaultFont = name
+		}
+	}
+	p.fontName.Reset()
+}
+
+// Finishes the current paragraph; if explicit is false, an empty paragraph is
+// discarded.
+func (p *_Parser) endParagraph(explicit bool) {
+	p.endRun()
+	if !explicit && len(p.para.Runs) == 0 {
+		return
+	}
+
+	para := p.para
+	p.para.Runs = nil // paragraph properties are kept until \pard
+
+	if p.inTable {
+		p.cell.Paragraphs = append(p.cell.Paragraphs, para)
+	} else {
+		p.endTable()
+		p.doc.Blocks = append(p.doc.Blocks, &para)
+	}
+}
+
+func (p *_Parser) endCell() {
+	p.endRun()
+	if len(p.para.Runs) > 0 || len(p.cell.Paragraphs) == 0 {
+		para := p.para
+		p.para.Runs = nil
+		p.cell.Paragraphs = append(p.cell.Paragraphs, para)
+	}
+	p.row.Cells = append(p.row.Cells, p.cell)
+	p.cell = Cell{}
+}
+
+func (p *_Parser) endRow() {
+	left := 0
+	for i := range p.row.Cells {
+		if i < len(p.cellx) {
+			p.row.Cells[i].Width = p.cellx[i] - left
+			left = p.cellx[i]
+		}
+	}
+
+	if p.table == nil {
+		p.table = &Table{}
+	}
+	p.table.Rows = append(p.table.Rows, p.row)
+	p.row = Row{}
+}
+
+func (p *_Parser) endTable() {
+	if p.table != nil {
+		p.doc.Blocks = append(p.doc.Blocks, p.table)
+		p.table = nil
+	}
+}
+
+func _IsAlpha(ch byte) bool {
+	return (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z')
+}
+
+func _IsDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
+}
+
+// Windows-1252 chars from 0x80 to 0x9f; the others match Latin-1.
+var _cp1252 = [32]rune{
+	'€', '�', '‚', 'ƒ', '„', '…', '†', '‡',
+	'ˆ', '‰', 'Š', '‹', 'Œ', '�', 'Ž', '�',
+	'�', '‘', '’', '“', '”', '•', '–', '—',
+	'˜', '™', 'š', '›', 'œ', '�', 'ž', 'Ÿ',
+}
+
+func _Cp1252ToRune(b byte) rune {
+	if b >= 0x80 && b <= 0x9f {
+		return _cp1252[b-0x80]
+	}
+	return rune(b)
+}
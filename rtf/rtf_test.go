@@ -0,0 +1,238 @@
+package rtf
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// Fills the run formatting which Parse() reports as effective, so a written
+// document can be compared with the parsed one.
+func withDefaults(doc *Document) *Document {
+	font, size := doc.DefaultFont, doc.DefaultSize
+	if font == "" {
+		font = "Segoe UI"
+	}
+	if size == 0 {
+		size = 9
+	}
+	fillPara := func(para *Paragraph) {
+		for i := range para.Runs {
+			if para.Runs[i].Font == "" {
+				para.Runs[i].Font = font
+			}
+			if para.Runs[i].Size == 0 {
+				para.Runs[i].Size = size
+			}
+		}
+	}
+
+	out := &Document{DefaultFont: font}
+	for _, block := range doc.Blocks {
+		switch b := block.(type) {
+		case *Paragraph:
+			para := *b
+			para.Runs = append([]Run(nil), b.Runs...)
+			fillPara(&para)
+			out.Blocks = append(out.Blocks, &para)
+		case *Table:
+			tbl := &Table{}
+			for _, row := range b.Rows {
+				var newRow Row
+				for _, cell := range row.Cells {
+					newCell := Cell{Width: cell.Width}
+					if newCell.Width == 0 {
+						newCell.Width = 2000
+					}
+					for _, para := range cell.Paragraphs {
+						para.Runs = append([]Run(nil), para.Runs...)
+						fillPara(&para)
+						newCell.Paragraphs = append(newCell.Paragraphs, para)
+					}
+					newRow.Cells = append(newRow.Cells, newCell)
+				}
+				tbl.Rows = append(tbl.Rows, newRow)
+			}
+			out.Blocks = append(out.Blocks, tbl)
+		}
+	}
+	return out
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  Document
+	}{
+		{
+			name: "plain text",
+			doc: Document{Blocks: []Block{
+				&Paragraph{Runs: []Run{{Text: "Hello, world"}}},
+			}},
+		},
+		{
+			name: "escaped chars",
+			doc: Document{Blocks: []Block{
+				&Paragraph{Runs: []Run{{Text: `back\slash {braces} and \{mixed\}`}}},
+			}},
+		},
+		{
+			name: "line breaks and tabs",
+			doc: Document{Blocks: []Block{
+				&Paragraph{Runs: []Run{{Text: "one\ntwo\tthree"}}},
+			}},
+		},
+		{
+			name: "non-ASCII and surrogate pairs",
+			doc: Document{Blocks: []Block{
+				&Paragraph{Runs: []Run{{Text: "café € 日本 😀 𝄞"}}},
+			}},
+		},
+		{
+			name: "control chars",
+			doc: Document{Blocks: []Block{
+				&Paragraph{Runs: []Run{{Text: "a\x01b\x1fc"}}},
+			}},
+		},
+		{
+			name: "formatting",
+			doc: Document{
+				DefaultFont: "Arial",
+				DefaultSize: 11,
+				Blocks: []Block{
+					&Paragraph{
+						Align:       ALIGN_CENTER,
+						IndentLeft:  720,
+						IndentRight: 360,
+						IndentFirst: -180,
+						SpaceBefore: 120,
+						SpaceAfter:  240,
+						Runs: []Run{
+							{Text: "bold ", Bold: true},
+							{Text: "italic ", Italic: true, Font: "Courier New"},
+							{Text: "red ", Color: &Color{R: 200}, Size: 14.5},
+							{Text: "marked", BgColor: &Color{R: 255, G: 255}, Underline: true, Strike: true},
+						},
+					},
+					&Paragraph{
+						Align: ALIGN_JUSTIFY,
+						Runs:  []Run{{Text: "second", Color: &Color{G: 128, B: 64}}},
+					},
+				},
+			},
+		},
+		{
+			name: "table",
+			doc: Document{Blocks: []Block{
+				&Paragraph{Runs: []Run{{Text: "before"}}},
+				&Table{Rows: []Row{
+					{Cells: []Cell{
+						{Width: 1500, Paragraphs: []Paragraph{{Runs: []Run{{Text: "a1"}}}}},
+						{Paragraphs: []Paragraph{
+							{Runs: []Run{{Text: "b1", Bold: true}}},
+							{Align: ALIGN_RIGHT, Runs: []Run{{Text: "b1 second"}}},
+						}},
+					}},
+					{Cells: []Cell{
+						{Width: 1500, Paragraphs: []Paragraph{{Runs: []Run{{Text: "{a2}"}}}}},
+						{Paragraphs: []Paragraph{{Runs: []Run{{Text: "b2 €"}}}}},
+					}},
+				}},
+				&Paragraph{Runs: []Run{{Text: "after"}}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.doc.Bytes()
+			parsed, err := Parse(data)
+			if err != nil {
+				t.Fatalf("Parse: %v\n%s", err, data)
+			}
+			if want := withDefaults(&tt.doc); !reflect.DeepEqual(parsed, want) {
+				t.Errorf("round trip mismatch\nRTF:\n%s\ngot:  %s\nwant: %s",
+					data, dump(parsed), dump(want))
+			}
+		})
+	}
+}
+
+func TestParseText(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"escaped braces", `{\rtf1 a\{b\}c\\d}`, `a{b}c\d`},
+		{"cp1252 bytes", `{\rtf1 \'80 \'e9t\'e9 \'93q\'94}`, "€ été “q”"},
+		{"unicode with fallback", `{\rtf1 \u233?t\u233?}`, "été"},
+		{"negative surrogate pair", `{\rtf1 \u-10179?\u-8704?!}`, "😀!"},
+		{"uc0 has no fallback", `{\rtf1\uc0 \u8364 5}`, "€5"},
+		{"uc2 skips two chars", `{\rtf1\uc2 \u8364XY end}`, "€ end"},
+		{"fallback as hex escape", `{\rtf1\uc1 \u233\'e9!}`, "é!"},
+		{"uc restored by group", `{\rtf1 {\uc2 \u8364XY}\u8364Zw}`, "€€w"},
+		{"special words", `{\rtf1 a\tab b\line c\emdash\bullet}`, "a\tb\nc—•"},
+		{"skipped destination", `{\rtf1 {\*\generator Foo;}text}`, "text"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := Parse([]byte(tt.src))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if len(doc.Blocks) != 1 {
+				t.Fatalf("got %d blocks, want 1", len(doc.Blocks))
+			}
+			if got := doc.Blocks[0].(*Paragraph).Text(); got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, src := range []string{
+		`not rtf`,
+		`{\rtf1 unbalanced`,
+		`{\rtf1 extra}}`,
+		`{\rtf1 \'zz}`,
+		`{\rtf1 \`,
+	} {
+		if _, err := Parse([]byte(src)); err == nil {
+			t.Errorf("Parse(%q): expected error", src)
+		}
+	}
+}
+
+func dump(doc *Document) string {
+	s := fmt.Sprintf("DefaultFont=%q", doc.DefaultFont)
+	for _, block := range doc.Blocks {
+		switch b := block.(type) {
+		case *Paragraph:
+			s += "\n  " + dumpPara(b)
+		case *Table:
+			for _, row := range b.Rows {
+				s += "\n  row:"
+				for _, cell := range row.Cells {
+					s += fmt.Sprintf("\n    cell %d:", cell.Width)
+					for i := range cell.Paragraphs {
+						s += "\n      " + dumpPara(&cell.Paragraphs[i])
+					}
+				}
+			}
+		}
+	}
+	return s
+}
+
+func dumpPara(para *Paragraph) string {
+	s := fmt.Sprintf("align=%d indent=%d/%d/%d space=%d/%d",
+		para.Align, para.IndentLeft, para.IndentRight, para.IndentFirst,
+		para.SpaceBefore, para.SpaceAfter)
+	for _, run := range para.Runs {
+		s += fmt.Sprintf(" %+v", run)
+	}
+	return s
+}
@@ -0,0 +1,257 @@
+package rtf
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"unicode/utf16"
+)
+
+// Serializes the document as RTF, returning the bytes.
+func (doc *Document) Bytes() []byte {
+	var buf bytes.Buffer
+	doc.WriteTo(&buf)
+	return buf.Bytes()
+}
+
+// Serializes the document as RTF, writing it to w.
+//
+// Implements io.WriterTo.
+func (doc *Document) WriteTo(w io.Writer) (int64, error) {
+	wr := _Writer{doc: doc}
+	wr.collectTables()
+	wr.writeHeader()
+	for _, block := range doc.Blocks {
+		switch b := block.(type) {
+		case *Paragraph:
+			wr.writeParagraph(b, false)
+			wr.buf.WriteString("\\par\n")
+		case *Table:
+			wr.writeTable(b)
+		}
+	}
+	wr.buf.WriteString("}\n")
+	return wr.buf.WriteTo(w)
+}
+
+type _Writer struct {
+	doc    *Document
+	buf    bytes.Buffer
+	fonts  []string // font table
+	colors []Color  // color table, index 0 is the automatic color
+}
+
+func (wr *_Writer) defaultFont() string {
+	if wr.doc.DefaultFont == "" {
+		return "Segoe UI"
+	}
+	return wr.doc.DefaultFont
+}
+
+func (wr *_Writer) defaultSize() float64 {
+	if wr.doc.DefaultSize == 0 {
+		return 9
+	}
+	return wr.doc.DefaultSize
+}
+
+// Builds the font and color tables from all the runs.
+func (wr *_Writer) collectTables() {
+	wr.fonts = []string{wr.defaultFont()}
+	wr.colors = []Color{{}} // placeholder for the automatic color
+
+	visitRuns := func(runs []Run) {
+		for _, run := range runs {
+			if run.Font != "" {
+				wr.fontIndex(run.Font)
+			}
+			if run.Color != nil {
+				wr.colorIndex(run.Color)
+			}
+			if run.BgColor != nil {
+				wr.colorIndex(run.BgColor)
+			}
+		}
+	}
+
+	for _, block := range wr.doc.Blocks {
+		switch b := block.(type) {
+		case *Paragraph:
+			visitRuns(b.Runs)
+		case *Table:
+			for _, row := range b.Rows {
+				for _, cell := range row.Cells {
+					for _, para := range cell.Paragraphs {
+						visitRuns(para.Runs)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Returns the index of the font in the font table, adding it if needed.
+func (wr *_Writer) fontIndex(name string) int {
+	for i, font := range wr.fonts {
+		if font == name {
+			return i
+		}
+	}
+	wr.fonts = append(wr.fonts, name)
+	return len(wr.fonts) - 1
+}
+
+// Returns the index of the color in the color table, adding it if needed.
+func (wr *_Writer) colorIndex(color *Color) int {
+	for i, c := range wr.colors[1:] {
+		if c == *color {
+			return i + 1
+		}
+	}
+	wr.colors = append(wr.colors, *color)
+	return len(wr.colors) - 1
+}
+
+func (wr *_Writer) writeHeader() {
+	wr.buf.WriteString("{\\rtf1\\ansi\\ansicpg1252\\deff0\\uc1\n{\\fonttbl")
+	for i, font := range wr.fonts {
+		fmt.Fprintf(&wr.buf, "{\\f%d\\fnil ", i)
+		wr.writeText(font)
+		wr.buf.WriteString(";}")
+	}
+	wr.buf.WriteString("}\n{\\colortbl ;")
+	for _, c := range wr.colors[1:] {
+		fmt.Fprintf(&wr.buf, "\\red%d\\green%d\\blue%d;", c.R, c.G, c.B)
+	}
+	fmt.Fprintf(&wr.buf, "}\n\\viewkind4\\f0\\fs%d\n", _HalfPoints(wr.defaultSize()))
+}
+
+// Writes the paragraph properties and runs, without the terminating \par.
+func (wr *_Writer) writeParagraph(para *Paragraph, inTable bool) {
+	wr.buf.WriteString("\\pard")
+	if inTable {
+		wr.buf.WriteString("\\intbl")
+	}
+	switch para.Align {
+	case ALIGN_CENTER:
+		wr.buf.WriteString("\\qc")
+	case ALIGN_RIGHT:
+		wr.buf.WriteString("\\qr")
+	case ALIGN_JUSTIFY:
+		wr.buf.WriteString("\\qj")
+	}
+	wr.writeIntProp("\\li", para.IndentLeft)
+	wr.writeIntProp("\\ri", para.IndentRight)
+	wr.writeIntProp("\\fi", para.IndentFirst)
+	wr.writeIntProp("\\sb", para.SpaceBefore)
+	wr.writeIntProp("\\sa", para.SpaceAfter)
+	wr.buf.WriteByte(' ')
+
+	for i := range para.Runs {
+		wr.writeRun(&para.Runs[i])
+	}
+}
+
+func (wr *_Writer) writeIntProp(word string, val int) {
+	if val != 0 {
+		fmt.Fprintf(&wr.buf, "%s%d", word, val)
+	}
+}
+
+func (wr *_Writer) writeRun(run *Run) {
+	wr.buf.WriteByte('{')
+	start := wr.buf.Len()
+	if run.Font != "" {
+		fmt.Fprintf(&wr.buf, "\\f%d", wr.fontIndex(run.Font))
+	}
+	if run.Size != 0 {
+		fmt.Fprintf(&wr.buf, "\\fs%d", _HalfPoints(run.Size))
+	}
+	if run.Color != nil {
+		fmt.Fprintf(&wr.buf, "\\cf%d", wr.colorIndex(run.Color))
+	}
+	if run.BgColor != nil {
+		fmt.Fprintf(&wr.buf, "\\highlight%d", wr.colorIndex(run.BgColor))
+	}
+	if run.Bold {
+		wr.buf.WriteString("\\b")
+	}
+	if run.Italic {
+		wr.buf.WriteString("\\i")
+	}
+	if run.Underline {
+		wr.buf.WriteString("\\ul")
+	}
+	if run.Strike {
+		wr.buf.WriteString("\\strike")
+	}
+	if wr.buf.Len() > start {
+		wr.buf.WriteByte(' ') // delimits the last control word
+	}
+	wr.writeText(run.Text)
+	wr.buf.WriteByte('}')
+}
+
+func (wr *_Writer) writeTable(tbl *Table) {
+	for _, row := range tbl.Rows {
+		wr.buf.WriteString("\\trowd\\trgaph108")
+		right := 0
+		for _, cell := range row.Cells {
+			width := cell.Width
+			if width == 0 {
+				width = 2000
+			}
+			right += width
+			fmt.Fprintf(&wr.buf, "\\cellx%d", right)
+		}
+		wr.buf.WriteByte('\n')
+
+		for _, cell := range row.Cells {
+			if len(cell.Paragraphs) == 0 {
+				wr.buf.WriteString("\\pard\\intbl ")
+			}
+			for i := range cell.Paragraphs {
+				if i > 0 {
+					wr.buf.WriteString("\\par\n")
+				}
+				wr.writeParagraph(&cell.Paragraphs[i], true)
+			}
+			wr.buf.WriteString("\\cell\n")
+		}
+		wr.buf.WriteString("\\row\n")
+	}
+	wr.buf.WriteString("\\pard\n")
+}
+
+// Writes the text escaping special chars; non-ASCII chars are written as \u
+// with a "?" fallback.
+func (wr *_Writer) writeText(text string) {
+	for _, ch := range text {
+		switch {
+		case ch == '\\' || ch == '{' || ch == '}':
+			wr.buf.WriteByte('\\')
+			wr.buf.WriteRune(ch)
+		case ch == '\n':
+			wr.buf.WriteString("\\line ")
+		case ch == '\t':
+			wr.buf.WriteString("\\tab ")
+		case ch == '\r':
+			// ignored, \n alone makes the line break
+		case ch < 0x20:
+			fmt.Fprintf(&wr.buf, "\\'%02x", ch)
+		case ch < 0x80:
+			wr.buf.WriteRune(ch)
+		case ch <= 0xffff:
+			fmt.Fprintf(&wr.buf, "\\u%d?", int16(ch))
+		default:
+			hi, lo := utf16.EncodeRune(ch)
+			fmt.Fprintf(&wr.buf, "\\u%d?\\u%d?", int16(hi), int16(lo))
+		}
+	}
+}
+
+// Converts points to RTF half points.
+func _HalfPoints(pt float64) int {
+	return int(math.Round(pt * 2))
+}
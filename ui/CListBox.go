@@ -0,0 +1,341 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/ui/wm"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Native [list box] control.
+//
+// Note that WM_CHARTOITEM and WM_VKEYTOITEM are sent to the parent window, so
+// they must be handled with the parent's On().WmCharToItem() and
+// On().WmVKeyToItem() methods.
+//
+// [list box]: https://learn.microsoft.com/en-us/windows/win32/controls/list-boxes
+type ListBox interface {
+	AnyNativeControl
+	AnyFocusControl
+	implListBox() // prevent public implementation
+
+	// Exposes all the [ListBox notifications] the can be handled.
+	//
+	// Panics if called after the control was created.
+	//
+	// [ListBox notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-list-box-control-reference-notifications
+	On() *_ListBoxEvents
+
+	ItemHeight() int            // Retrieves the height of the items, in pixels.
+	Items() *_ListBoxItems      // Item methods.
+	SetItemHeight(height int)   // Sets the height of the items, in pixels; useful with LBS_OWNERDRAWFIXED.
+	SetRedraw(allowRedraw bool) // Sends WM_SETREDRAW to enable or disable UI updates.
+}
+
+//------------------------------------------------------------------------------
+
+type _ListBox struct {
+	_NativeControlBase
+	events _ListBoxEvents
+	items  _ListBoxItems
+}
+
+// Creates a new ListBox. Call ui.ListBoxOpts() to define the options to be
+// passed to the underlying CreateWindowEx().
+//
+// # Example
+//
+//	var owner ui.AnyParent // initialized somewhere
+//
+//	myList := ui.NewListBox(
+//		owner,
+//		ui.ListBoxOpts().
+//			Position(win.POINT{X: 10, Y: 20}).
+//			CtrlStyles(co.LBS_NOTIFY | co.LBS_EXTENDEDSEL).
+//			Texts("First", "Second", "Third"),
+//	)
+//
+// For a virtual list, which can hold a very large number of items, use the
+// LBS_NODATA style along with LBS_OWNERDRAWFIXED, and paint the items with
+// On().WmDrawItem():
+//
+//	myVirtList := ui.NewListBox(
+//		owner,
+//		ui.ListBoxOpts().
+//			CtrlStyles(co.LBS_NOTIFY | co.LBS_NODATA | co.LBS_OWNERDRAWFIXED).
+//			ItemCount(100_000),
+//	)
+func NewListBox(parent AnyParent, opts *_ListBoxO) ListBox {
+	if opts == nil {
+		opts = ListBoxOpts()
+	}
+	opts.lateDefaults()
+
+	me := &_ListBox{}
+	me._NativeControlBase.new(parent, opts.ctrlId)
+	me.events.new(&me._NativeControlBase)
+	me.items.new(me)
+
+	parent.internalOn().addMsgNoRet(_CreateOrInitDialog(parent), func(_ wm.Any) {
+		_ConvertDtuOrMultiplyDpi(parent, &opts.position, &opts.size)
+
+		me._NativeControlBase.createWindow(opts.wndExStyles,
+			win.ClassNameStr("LISTBOX"), win.StrOptNone(),
+			opts.wndStyles|co.WS(opts.ctrlStyles),
+			opts.position, opts.size, win.HMENU(opts.ctrlId))
+
+		parent.addResizingChild(me, opts.horz, opts.vert)
+		me.Hwnd().SendMessage(co.WM_SETFONT, win.WPARAM(_globalUiFont), 1)
+
+		if opts.itemHeight != 0 {
+			me.SetItemHeight(opts.itemHeight)
+		}
+		if opts.texts != nil {
+			me.Items().Add(opts.texts...)
+		}
+		if opts.itemCount != 0 {
+			me.Items().SetCount(opts.itemCount)
+		}
+	})
+
+	return me
+}
+
+// Creates a new ListBox from a dialog resource.
+func NewListBoxDlg(
+	parent AnyParent, ctrlId int,
+	horz HORZ, vert VERT) ListBox {
+
+	me := &_ListBox{}
+	me._NativeControlBase.new(parent, ctrlId)
+	me.events.new(&me._NativeControlBase)
+	me.items.new(me)
+
+	parent.internalOn().addMsgNoRet(co.WM_INITDIALOG, func(_ wm.Any) {
+		me._NativeControlBase.assignDlgItem()
+		parent.addResizingChild(me, horz, vert)
+	})
+
+	return me
+}
+
+// Implements ListBox.
+func (*_ListBox) implListBox() {}
+
+// Implements AnyFocusControl.
+func (me *_ListBox) Focus() {
+	me._NativeControlBase.focus()
+}
+
+func (me *_ListBox) On() *_ListBoxEvents {
+	if me.Hwnd() != 0 {
+		panic("Cannot add event handling after the ListBox is created.")
+	}
+	return &me.events
+}
+
+func (me *_ListBox) ItemHeight() int {
+	return int(me.Hwnd().SendMessage(co.LB_GETITEMHEIGHT, 0, 0))
+}
+
+func (me *_ListBox) Items() *_ListBoxItems {
+	return &me.items
+}
+
+func (me *_ListBox) SetItemHeight(height int) {
+	if int(me.Hwnd().SendMessage(co.LB_SETITEMHEIGHT, 0, win.LPARAM(height))) == -1 {
+		panic("LB_SETITEMHEIGHT failed.")
+	}
+}
+
+func (me *_ListBox) SetRedraw(allowRedraw bool) {
+	me.Hwnd().SendMessage(co.WM_SETREDRAW,
+		win.WPARAM(util.BoolToUintptr(allowRedraw)), 0)
+}
+
+//------------------------------------------------------------------------------
+
+type _ListBoxO struct {
+	ctrlId int
+
+	position    win.POINT
+	size        win.SIZE
+	horz        HORZ
+	vert        VERT
+	ctrlStyles  co.LBS
+	wndStyles   co.WS
+	wndExStyles co.WS_EX
+
+	texts      []string
+	itemCount  int
+	itemHeight int
+}
+
+// Control ID.
+//
+// Defaults to an auto-generated ID.
+func (o *_ListBoxO) CtrlId(i int) *_ListBoxO { o.ctrlId = i; return o }
+
+// Position within parent's client area.
+//
+// If parent is a dialog box, coordinates are in Dialog Template Units;
+// otherwise, they are in pixels and they will be adjusted to the current system
+// DPI.
+//
+// Defaults to 0x0.
+func (o *_ListBoxO) Position(p win.POINT) *_ListBoxO { _OwPt(&o.position, p); return o }
+
+// Control size.
+//
+// If parent is a dialog box, coordinates are in Dialog Template Units;
+// otherwise, they are in pixels and they will be adjusted to the current system
+// DPI.
+//
+// Defaults to 120x120.
+func (o *_ListBoxO) Size(s win.SIZE) *_ListBoxO { _OwSz(&o.size, s); return o }
+
+// Horizontal behavior when the parent is resized.
+//
+// Defaults to HORZ_NONE.
+func (o *_ListBoxO) Horz(s HORZ) *_ListBoxO { o.horz = s; return o }
+
+// Vertical behavior when the parent is resized.
+//
+// Defaults to VERT_NONE.
+func (o *_ListBoxO) Vert(s VERT) *_ListBoxO { o.vert = s; return o }
+
+// ListBox control styles, passed to CreateWindowEx().
+//
+// Defaults to LBS_NOTIFY | LBS_NOINTEGRALHEIGHT.
+func (o *_ListBoxO) CtrlStyles(s co.LBS) *_ListBoxO { o.ctrlStyles = s; return o }
+
+// Window styles, passed to CreateWindowEx().
+//
+// Defaults to co.WS_CHILD | co.WS_GROUP | co.WS_TABSTOP | co.WS_VISIBLE | co.WS_VSCROLL.
+func (o *_ListBoxO) WndStyles(s co.WS) *_ListBoxO { o.wndStyles = s; return o }
+
+// Extended window styles, passed to CreateWindowEx().
+//
+// Defaults to WS_EX_CLIENTEDGE.
+func (o *_ListBoxO) WndExStyles(s co.WS_EX) *_ListBoxO { o.wndExStyles = s; return o }
+
+// Texts to be added to the ListBox. Not allowed with LBS_NODATA.
+//
+// Defaults to none.
+func (o *_ListBoxO) Texts(t ...string) *_ListBoxO { o.texts = t; return o }
+
+// Number of items of a virtual ListBox, which has the LBS_NODATA style.
+//
+// Defaults to none.
+func (o *_ListBoxO) ItemCount(c int) *_ListBoxO { o.itemCount = c; return o }
+
+// Height of the items, in pixels; useful with LBS_OWNERDRAWFIXED.
+//
+// Defaults to the system height.
+func (o *_ListBoxO) ItemHeight(h int) *_ListBoxO { o.itemHeight = h; return o }
+
+func (o *_ListBoxO) lateDefaults() {
+	if o.ctrlId == 0 {
+		o.ctrlId = _NextCtrlId()
+	}
+}
+
+// Options for NewListBox().
+func ListBoxOpts() *_ListBoxO {
+	return &_ListBoxO{
+		size:       win.SIZE{Cx: 120, Cy: 120},
+		horz:       HORZ_NONE,
+		vert:       VERT_NONE,
+		ctrlStyles: co.LBS_NOTIFY | co.LBS_NOINTEGRALHEIGHT,
+		wndStyles: co.WS_CHILD | co.WS_GROUP | co.WS_TABSTOP | co.WS_VISIBLE |
+			co.WS_VSCROLL,
+		wndExStyles: co.WS_EX_CLIENTEDGE,
+	}
+}
+
+//------------------------------------------------------------------------------
+
+// ListBox control notifications.
+type _ListBoxEvents struct {
+	ctrlId         int
+	events         *_EventsWmNfy
+	internalEvents *_EventsInternal
+}
+
+func (me *_ListBoxEvents) new(ctrl *_NativeControlBase) {
+	me.ctrlId = ctrl.CtrlId()
+	me.events = ctrl.Parent().On()
+	me.internalEvents = ctrl.Parent().internalOn()
+}
+
+// [LBN_DBLCLK] message handler.
+//
+// [LBN_DBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-dblclk
+func (me *_ListBoxEvents) LbnDblClk(userFunc func()) {
+	me.events.addCmdZero(me.ctrlId, co.LBN_DBLCLK, func(_ wm.Command) {
+		userFunc()
+	})
+}
+
+// [LBN_ERRSPACE] message handler.
+//
+// [LBN_ERRSPACE]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-errspace
+func (me *_ListBoxEvents) LbnErrSpace(userFunc func()) {
+	me.events.addCmdZero(me.ctrlId, co.LBN_ERRSPACE, func(_ wm.Command) {
+		userFunc()
+	})
+}
+
+// [LBN_KILLFOCUS] message handler.
+//
+// [LBN_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-killfocus
+func (me *_ListBoxEvents) LbnKillFocus(userFunc func()) {
+	me.events.addCmdZero(me.ctrlId, co.LBN_KILLFOCUS, func(_ wm.Command) {
+		userFunc()
+	})
+}
+
+// [LBN_SELCANCEL] message handler.
+//
+// [LBN_SELCANCEL]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-selcancel
+func (me *_ListBoxEvents) LbnSelCancel(userFunc func()) {
+	me.events.addCmdZero(me.ctrlId, co.LBN_SELCANCEL, func(_ wm.Command) {
+		userFunc()
+	})
+}
+
+// [LBN_SELCHANGE] message handler.
+//
+// [LBN_SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-selchange
+func (me *_ListBoxEvents) LbnSelChange(userFunc func()) {
+	me.events.addCmdZero(me.ctrlId, co.LBN_SELCHANGE, func(_ wm.Command) {
+		userFunc()
+	})
+}
+
+// [LBN_SETFOCUS] message handler.
+//
+// [LBN_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/lbn-setfocus
+func (me *_ListBoxEvents) LbnSetFocus(userFunc func()) {
+	me.events.addCmdZero(me.ctrlId, co.LBN_SETFOCUS, func(_ wm.Command) {
+		userFunc()
+	})
+}
+
+// [WM_DRAWITEM] message handler, sent to the parent window when an owner-drawn
+// ListBox (LBS_OWNERDRAWFIXED or LBS_OWNERDRAWVARIABLE) must be painted.
+//
+// Unlike the parent's On().WmDrawItem(), this handler is called only for this
+// ListBox.
+//
+// [WM_DRAWITEM]: https://learn.microsoft.com/en-us/windows/win32/controls/wm-drawitem
+func (me *_ListBoxEvents) WmDrawItem(userFunc func(p *win.DRAWITEMSTRUCT)) {
+	me.internalEvents.addMsgNoRet(co.WM_DRAWITEM, func(p wm.Any) {
+		parm := wm.DrawItem{Msg: p}
+		if parm.ControlId() == me.ctrlId {
+			userFunc(parm.DrawItemStruct())
+		}
+	})
+}
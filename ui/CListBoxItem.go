@@ -0,0 +1,99 @@
+//go:build windows
+
+package ui
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// A single item of a ListBox.
+type ListBoxItem struct {
+	lb    ListBox
+	index uint32
+}
+
+// Deletes the item.
+func (me ListBoxItem) Delete() {
+	ret := int(me.lb.Hwnd().SendMessage(co.LB_DELETESTRING, win.WPARAM(me.index), 0))
+	if ret == -1 {
+		panic(fmt.Sprintf("LB_DELETESTRING %d failed.", me.index))
+	}
+}
+
+// Makes sure the item is visible, scrolling the ListBox if needed.
+func (me ListBoxItem) EnsureVisible() {
+	me.lb.Hwnd().SendMessage(co.LB_SETCARETINDEX, win.WPARAM(me.index), 0) // partially visible is fine
+}
+
+// Returns the zero-based index of the item.
+func (me ListBoxItem) Index() int {
+	return int(me.index)
+}
+
+// Tells whether the item is currently selected.
+func (me ListBoxItem) IsSelected() bool {
+	return int(me.lb.Hwnd().SendMessage(co.LB_GETSEL, win.WPARAM(me.index), 0)) > 0
+}
+
+// Retrieves the custom data associated with the item.
+func (me ListBoxItem) LParam() win.LPARAM {
+	ret := int(me.lb.Hwnd().SendMessage(co.LB_GETITEMDATA, win.WPARAM(me.index), 0))
+	if ret == -1 {
+		panic(fmt.Sprintf("LB_GETITEMDATA %d failed.", me.index))
+	}
+	return win.LPARAM(ret)
+}
+
+// Retrieves the coordinates of the rectangle surrounding the item, relative to
+// the ListBox.
+func (me ListBoxItem) Rect() win.RECT {
+	var rc win.RECT
+	ret := int(me.lb.Hwnd().SendMessage(co.LB_GETITEMRECT,
+		win.WPARAM(me.index), win.LPARAM(unsafe.Pointer(&rc))))
+	if ret == -1 {
+		panic(fmt.Sprintf("LB_GETITEMRECT %d failed.", me.index))
+	}
+	return rc
+}
+
+// Selects or deselects the item.
+//
+// In a single-selection ListBox, deselecting any item will clear the selection.
+func (me ListBoxItem) Select(doSelect bool) {
+	if me.lb.Items().isMultiSel() {
+		me.lb.Hwnd().SendMessage(co.LB_SETSEL,
+			win.WPARAM(util.BoolToUintptr(doSelect)), win.LPARAM(me.index))
+	} else {
+		idx := util.Iif(doSelect, win.WPARAM(me.index), win.WPARAM(^uintptr(0))).(win.WPARAM) // -1 clears the selection
+		me.lb.Hwnd().SendMessage(co.LB_SETCURSEL, idx, 0)
+	}
+}
+
+// Sets the custom data associated with the item.
+func (me ListBoxItem) SetLParam(lp win.LPARAM) {
+	ret := int(me.lb.Hwnd().SendMessage(co.LB_SETITEMDATA, win.WPARAM(me.index), lp))
+	if ret == -1 {
+		panic(fmt.Sprintf("LB_SETITEMDATA %d failed.", me.index))
+	}
+}
+
+// Retrieves the text of the item.
+//
+// Not allowed with LBS_NODATA.
+func (me ListBoxItem) Text() string {
+	nChars := me.lb.Hwnd().SendMessage(
+		co.LB_GETTEXTLEN, win.WPARAM(me.index), 0)
+	if int(nChars) == -1 {
+		panic(fmt.Sprintf("LB_GETTEXTLEN failed at item %d.", me.index))
+	}
+
+	textBuf := make([]uint16, nChars+1)
+	me.lb.Hwnd().SendMessage(co.LB_GETTEXT,
+		win.WPARAM(me.index), win.LPARAM(unsafe.Pointer(&textBuf[0])))
+	return win.Str.FromNativeSlice(textBuf)
+}
@@ -0,0 +1,217 @@
+//go:build windows
+
+package ui
+
+import (
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+type _ListBoxItems struct {
+	lb ListBox
+}
+
+func (me *_ListBoxItems) new(ctrl ListBox) {
+	me.lb = ctrl
+}
+
+// Adds one or more items.
+//
+// Panics if the ListBox has the LBS_NODATA style; use SetCount() instead.
+func (me *_ListBoxItems) Add(texts ...string) {
+	if len(texts) > 1 { // preallocate memory for bulk insertions
+		nBytes := 0
+		for _, text := range texts {
+			nBytes += (len(text) + 1) * 2
+		}
+		me.lb.Hwnd().SendMessage(co.LB_INITSTORAGE,
+			win.WPARAM(len(texts)), win.LPARAM(nBytes))
+	}
+
+	for _, text := range texts {
+		pText := win.Str.ToNativePtr(text)
+		ret := int(me.lb.Hwnd().SendMessage(co.LB_ADDSTRING,
+			0, win.LPARAM(unsafe.Pointer(pText))))
+		runtime.KeepAlive(pText)
+
+		if ret < 0 { // LB_ERR or LB_ERRSPACE
+			panic(fmt.Sprintf("LB_ADDSTRING failed \"%s\".", text))
+		}
+	}
+}
+
+// Retrieves the number of items.
+func (me *_ListBoxItems) Count() int {
+	return int(me.lb.Hwnd().SendMessage(co.LB_GETCOUNT, 0, 0))
+}
+
+// Deletes all items.
+func (me *_ListBoxItems) DeleteAll() {
+	me.lb.Hwnd().SendMessage(co.LB_RESETCONTENT, 0, 0)
+}
+
+// Searches for an item with the given text, case-insensitive.
+func (me *_ListBoxItems) Find(text string) (ListBoxItem, bool) {
+	pText := win.Str.ToNativePtr(text)
+	idx := int(me.lb.Hwnd().SendMessage(co.LB_FINDSTRINGEXACT,
+		win.WPARAM(^uintptr(0)), win.LPARAM(unsafe.Pointer(pText)))) // search from the beginning
+	runtime.KeepAlive(pText)
+
+	if idx == -1 {
+		return me.Get(-1), false
+	}
+	return me.Get(idx), true
+}
+
+// Returns the item at the given index.
+//
+// Note that this method is dumb: no validation is made, the given index is
+// simply kept. If the index is invalid (or becomes invalid), subsequent
+// operations on the ListBoxItem will fail.
+func (me *_ListBoxItems) Get(index int) ListBoxItem {
+	return ListBoxItem{lb: me.lb, index: uint32(index)}
+}
+
+// Inserts an item at the given index, returning it.
+//
+// Unlike Add(), the item is never sorted, even with the LBS_SORT style.
+func (me *_ListBoxItems) Insert(index int, text string) ListBoxItem {
+	pText := win.Str.ToNativePtr(text)
+	newIdx := int(me.lb.Hwnd().SendMessage(co.LB_INSERTSTRING,
+		win.WPARAM(index), win.LPARAM(unsafe.Pointer(pText))))
+	runtime.KeepAlive(pText)
+
+	if newIdx < 0 { // LB_ERR or LB_ERRSPACE
+		panic(fmt.Sprintf("LB_INSERTSTRING failed \"%s\".", text))
+	}
+	return me.Get(newIdx)
+}
+
+// Selects or deselects all items at once.
+//
+// Panics if the ListBox doesn't have the LBS_EXTENDEDSEL or LBS_MULTIPLESEL
+// styles.
+func (me *_ListBoxItems) SelectAll(doSelect bool) {
+	if !me.isMultiSel() {
+		panic("ListBox is not multi-selection, cannot select all items.")
+	}
+	me.lb.Hwnd().SendMessage(co.LB_SETSEL,
+		win.WPARAM(util.BoolToUintptr(doSelect)), win.LPARAM(^uintptr(0))) // -1 means all items
+}
+
+// Selects or deselects a range of items, inclusive.
+//
+// Panics if the ListBox doesn't have the LBS_EXTENDEDSEL or LBS_MULTIPLESEL
+// styles.
+func (me *_ListBoxItems) SelectRange(first, last int, doSelect bool) {
+	if !me.isMultiSel() {
+		panic("ListBox is not multi-selection, cannot select a range of items.")
+	}
+	if first > last {
+		first, last = last, first
+	}
+	if first == last { // LB_SELITEMRANGEEX can't deselect a single item
+		me.Get(first).Select(doSelect)
+		return
+	}
+	if !doSelect { // first greater than last means deselection
+		first, last = last, first
+	}
+	me.lb.Hwnd().SendMessage(co.LB_SELITEMRANGEEX,
+		win.WPARAM(first), win.LPARAM(last))
+}
+
+// Retrieves the selected item, if any.
+//
+// In a multi-selection ListBox, returns the item which has the focus rectangle,
+// which is not necessarily selected; use SelectedIndexes() instead.
+func (me *_ListBoxItems) Selected() (ListBoxItem, bool) {
+	var idx int
+	if me.isMultiSel() {
+		idx = int(me.lb.Hwnd().SendMessage(co.LB_GETCARETINDEX, 0, 0))
+	} else {
+		idx = int(me.lb.Hwnd().SendMessage(co.LB_GETCURSEL, 0, 0))
+	}
+
+	if idx == -1 {
+		return me.Get(-1), false
+	}
+	return me.Get(idx), true
+}
+
+// Retrieves the number of selected items.
+func (me *_ListBoxItems) SelectedCount() int {
+	if me.isMultiSel() {
+		return int(me.lb.Hwnd().SendMessage(co.LB_GETSELCOUNT, 0, 0))
+	}
+
+	if _, hasSel := me.Selected(); hasSel {
+		return 1
+	}
+	return 0
+}
+
+// Retrieves the indexes of the selected items, for both single and
+// multi-selection ListBoxes.
+func (me *_ListBoxItems) SelectedIndexes() []int {
+	if !me.isMultiSel() {
+		if selItem, hasSel := me.Selected(); hasSel {
+			return []int{selItem.Index()}
+		}
+		return []int{}
+	}
+
+	count := me.SelectedCount()
+	if count <= 0 {
+		return []int{}
+	}
+
+	buf := make([]int32, count)
+	ret := int(me.lb.Hwnd().SendMessage(co.LB_GETSELITEMS,
+		win.WPARAM(count), win.LPARAM(unsafe.Pointer(&buf[0]))))
+	if ret == -1 {
+		panic("LB_GETSELITEMS failed.")
+	}
+
+	indexes := make([]int, 0, ret)
+	for _, idx := range buf[:ret] {
+		indexes = append(indexes, int(idx))
+	}
+	return indexes
+}
+
+// Retrieves the texts of the selected items.
+//
+// Not allowed with LBS_NODATA.
+func (me *_ListBoxItems) SelectedTexts() []string {
+	indexes := me.SelectedIndexes()
+	texts := make([]string, 0, len(indexes))
+	for _, idx := range indexes {
+		texts = append(texts, me.Get(idx).Text())
+	}
+	return texts
+}
+
+// Sets the number of items of a virtual ListBox, which has the LBS_NODATA
+// style.
+func (me *_ListBoxItems) SetCount(count int) {
+	ret := int(me.lb.Hwnd().SendMessage(co.LB_SETCOUNT, win.WPARAM(count), 0))
+	if ret < 0 { // LB_ERR or LB_ERRSPACE
+		panic(fmt.Sprintf("LB_SETCOUNT failed for %d items.", count))
+	}
+}
+
+// Retrieves the index of the first visible item.
+func (me *_ListBoxItems) TopIndex() int {
+	return int(me.lb.Hwnd().SendMessage(co.LB_GETTOPINDEX, 0, 0))
+}
+
+func (me *_ListBoxItems) isMultiSel() bool {
+	style := co.LBS(me.lb.Hwnd().GetWindowLongPtr(co.GWLP_STYLE))
+	return (style & (co.LBS_EXTENDEDSEL | co.LBS_MULTIPLESEL)) != 0
+}
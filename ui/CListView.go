@@ -11,6 +11,8 @@ import (
 	"github.com/rodrigocfd/windigo/ui/wm"
 	"github.com/rodrigocfd/windigo/win"
 	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/shell"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
 )
 
 // Native [list view] control.
@@ -28,6 +30,18 @@ type ListView interface {
 	// [ListView notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-list-view-control-reference-notifications
 	On() *_ListViewEvents
 
+	// Makes the items an OLE drag source: when the user starts dragging, the
+	// data of the selected items is retrieved with dataFunc, then a drag and
+	// drop operation is performed. If the data was dropped, doneFunc is called,
+	// if not nil, so items can be removed after a move.
+	//
+	// Panics if called after the control was created.
+	//
+	// ⚠️ OLE must be initialized in the UI thread, see com.OleInitialize().
+	ItemDragSource(okEffects shellco.DROPEFFECT,
+		dataFunc func(items []ListViewItem) []shell.DataItem,
+		doneFunc func(items []ListViewItem, effect shellco.DROPEFFECT))
+
 	ContextMenu() win.HMENU                                           // Returns the associated context menu, if any.
 	Columns() *_ListViewColumns                                       // Column methods.
 	EditControl() win.HWND                                            // Retrieves a handle to the edit control being used.
@@ -169,6 +183,32 @@ func (me *_ListView) ImageList(which co.LVSIL) win.HIMAGELIST {
 	)
 }
 
+func (me *_ListView) ItemDragSource(
+	okEffects shellco.DROPEFFECT,
+	dataFunc func(items []ListViewItem) []shell.DataItem,
+	doneFunc func(items []ListViewItem, effect shellco.DROPEFFECT)) {
+
+	if me.Hwnd() != 0 {
+		panic("Cannot set drag source after the ListView is created.")
+	}
+
+	beginDrag := func(_ unsafe.Pointer) {
+		items := me.Items().SelectedItems()
+		if len(items) == 0 {
+			return
+		}
+		data := dataFunc(items)
+		if len(data) == 0 {
+			return // nothing to drag
+		}
+		if effect, dropped := DoDragDrop(okEffects, data...); dropped && doneFunc != nil {
+			doneFunc(items, effect)
+		}
+	}
+	me.Parent().internalOn().addNfyNoRet(me.CtrlId(), co.LVN_BEGINDRAG, beginDrag)
+	me.Parent().internalOn().addNfyNoRet(me.CtrlId(), co.LVN_BEGINRDRAG, beginDrag)
+}
+
 func (me *_ListView) Items() *_ListViewItems {
 	return &me.items
 }
@@ -0,0 +1,501 @@
+//go:build windows
+
+package ui
+
+import (
+	"io"
+	"runtime"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/ui/wm"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Native [rich edit] control, version 4.1, from msftedit.dll.
+//
+// [rich edit]: https://learn.microsoft.com/en-us/windows/win32/controls/about-rich-edit-controls
+type RichEdit interface {
+	AnyNativeControl
+	AnyFocusControl
+	AnyTextControl
+	implRichEdit() // prevent public implementation
+
+	// Exposes all the [RichEdit notifications] the can be handled.
+	//
+	// Panics if called after the control was created.
+	//
+	// [RichEdit notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-rich-edit-control-reference-notifications
+	On() *_RichEditEvents
+
+	CharFormat() win.CHARFORMAT2                     // Retrieves the character formatting of the current selection.
+	ParaFormat() win.PARAFORMAT2                     // Retrieves the paragraph formatting of the current selection.
+	ReplaceSelection(text string)                    // Replaces the current text selection with the given text.
+	SelectedRange() (int, int)                       // Retrieves the index of first and past-the-last selected chars.
+	SelectRange(idxFirst, idxPastLast int)           // Sets the currently selected chars. Pass 0, -1 to select all.
+	SetAutoUrlDetect(flags co.AURL)                  // Enables or disables the automatic detection of links.
+	SetBkColor(color win.COLORREF)                   // Sets the background color.
+	SetCharFormat(cf *win.CHARFORMAT2, scope co.SCF) // Sets the character formatting; to format the selection, pass SCF_SELECTION.
+	SetParaFormat(pf *win.PARAFORMAT2)               // Sets the paragraph formatting of the current selection.
+	StreamIn(src io.Reader, format co.SF) error      // Replaces the contents, or the selection with SFF_SELECTION, with the content read from the source.
+	StreamOut(dest io.Writer, format co.SF) error    // Writes the contents, or the selection with SFF_SELECTION, to the destination.
+	TextRange(idxFirst, idxPastLast int) string      // Retrieves the text within the given range.
+}
+
+//------------------------------------------------------------------------------
+
+type _RichEdit struct {
+	_NativeControlBase
+	events _RichEditEvents
+}
+
+// Creates a new RichEdit. Call ui.RichEditOpts() to define the options to be
+// passed to the underlying CreateWindowEx().
+//
+// # Example
+//
+//	var owner ui.AnyParent // initialized somewhere
+//
+//	myRich := ui.NewRichEdit(
+//		owner,
+//		ui.RichEditOpts().
+//			Position(win.POINT{X: 10, Y: 20}).
+//			Size(win.SIZE{Cx: 300, Cy: 200}),
+//		),
+//	)
+func NewRichEdit(parent AnyParent, opts *_RichEditO) RichEdit {
+	if opts == nil {
+		opts = RichEditOpts()
+	}
+	opts.lateDefaults()
+	_LoadMsftedit()
+
+	me := &_RichEdit{}
+	me._NativeControlBase.new(parent, opts.ctrlId)
+	me.events.new(&me._NativeControlBase)
+
+	parent.internalOn().addMsgNoRet(_CreateOrInitDialog(parent), func(_ wm.Any) {
+		_ConvertDtuOrMultiplyDpi(parent, &opts.position, &opts.size)
+
+		me._NativeControlBase.createWindow(opts.wndExStyles,
+			win.ClassNameStr("RICHEDIT50W"), win.StrOptSome(opts.text),
+			opts.wndStyles|co.WS(opts.ctrlStyles),
+			opts.position, opts.size, win.HMENU(opts.ctrlId))
+
+		parent.addResizingChild(me, opts.horz, opts.vert)
+		me.Hwnd().SendMessage(co.WM_SETFONT, win.WPARAM(_globalUiFont), 1)
+		if opts.autoUrl != co.AURL_NONE {
+			me.SetAutoUrlDetect(opts.autoUrl)
+		}
+		me.events.applyEventMask(me.Hwnd())
+	})
+
+	return me
+}
+
+// Creates a new RichEdit from a dialog resource. The control class must be
+// RICHEDIT50W.
+func NewRichEditDlg(parent AnyParent, ctrlId int, horz HORZ, vert VERT) RichEdit {
+	_LoadMsftedit() // must be loaded before the dialog is created
+
+	me := &_RichEdit{}
+	me._NativeControlBase.new(parent, ctrlId)
+	me.events.new(&me._NativeControlBase)
+
+	parent.internalOn().addMsgNoRet(co.WM_INITDIALOG, func(_ wm.Any) {
+		me._NativeControlBase.assignDlgItem()
+		parent.addResizingChild(me, horz, vert)
+		me.events.applyEventMask(me.Hwnd())
+	})
+
+	return me
+}
+
+var _globalMsftedit win.HINSTANCE // Loaded once, never freed.
+
+// Loads msftedit.dll, which registers the RICHEDIT50W window class.
+func _LoadMsftedit() {
+	if _globalMsftedit == 0 {
+		_globalMsftedit = win.LoadLibrary("msftedit.dll")
+	}
+}
+
+// Implements RichEdit.
+func (*_RichEdit) implRichEdit() {}
+
+// Implements AnyFocusControl.
+func (me *_RichEdit) Focus() {
+	me._NativeControlBase.focus()
+}
+
+// Implements AnyTextControl.
+func (me *_RichEdit) SetText(text string) {
+	me.Hwnd().SetWindowText(text)
+}
+
+// Implements AnyTextControl.
+func (me *_RichEdit) Text() string {
+	return me.Hwnd().GetWindowText()
+}
+
+func (me *_RichEdit) On() *_RichEditEvents {
+	if me.Hwnd() != 0 {
+		panic("Cannot add event handling after the RichEdit is created.")
+	}
+	return &me.events
+}
+
+func (me *_RichEdit) CharFormat() win.CHARFORMAT2 {
+	var cf win.CHARFORMAT2
+	cf.SetCbSize()
+	me.Hwnd().SendMessage(co.EM_GETCHARFORMAT,
+		win.WPARAM(co.SCF_SELECTION), win.LPARAM(unsafe.Pointer(&cf)))
+	return cf
+}
+
+func (me *_RichEdit) ParaFormat() win.PARAFORMAT2 {
+	var pf win.PARAFORMAT2
+	pf.SetCbSize()
+	me.Hwnd().SendMessage(co.EM_GETPARAFORMAT,
+		0, win.LPARAM(unsafe.Pointer(&pf)))
+	return pf
+}
+
+func (me *_RichEdit) ReplaceSelection(replacementText string) {
+	pText := win.Str.ToNativePtr(replacementText)
+	me.Hwnd().SendMessage(co.EM_REPLACESEL,
+		1, win.LPARAM(unsafe.Pointer(pText)))
+	runtime.KeepAlive(pText)
+}
+
+func (me *_RichEdit) SelectedRange() (idxFirst, idxPastLast int) {
+	var cr win.CHARRANGE
+	me.Hwnd().SendMessage(co.EM_EXGETSEL, 0, win.LPARAM(unsafe.Pointer(&cr)))
+	return int(cr.CpMin), int(cr.CpMax)
+}
+
+func (me *_RichEdit) SelectRange(idxFirst, idxPastLast int) {
+	cr := win.CHARRANGE{CpMin: int32(idxFirst), CpMax: int32(idxPastLast)}
+	me.Hwnd().SendMessage(co.EM_EXSETSEL, 0, win.LPARAM(unsafe.Pointer(&cr)))
+}
+
+func (me *_RichEdit) SetAutoUrlDetect(flags co.AURL) {
+	ret := me.Hwnd().SendMessage(co.EM_AUTOURLDETECT, win.WPARAM(flags), 0)
+	if ret != 0 {
+		panic(co.HRESULT(ret))
+	}
+}
+
+func (me *_RichEdit) SetBkColor(color win.COLORREF) {
+	me.Hwnd().SendMessage(co.EM_SETBKGNDCOLOR, 0, win.LPARAM(color))
+}
+
+func (me *_RichEdit) SetCharFormat(cf *win.CHARFORMAT2, scope co.SCF) {
+	ret := me.Hwnd().SendMessage(co.EM_SETCHARFORMAT,
+		win.WPARAM(scope), win.LPARAM(unsafe.Pointer(cf)))
+	if ret == 0 {
+		panic("EM_SETCHARFORMAT failed.")
+	}
+}
+
+func (me *_RichEdit) SetParaFormat(pf *win.PARAFORMAT2) {
+	ret := me.Hwnd().SendMessage(co.EM_SETPARAFORMAT,
+		0, win.LPARAM(unsafe.Pointer(pf)))
+	if ret == 0 {
+		panic("EM_SETPARAFORMAT failed.")
+	}
+}
+
+func (me *_RichEdit) StreamIn(src io.Reader, format co.SF) error {
+	return me.stream(co.EM_STREAMIN, &_EditStreamPack{r: src}, format)
+}
+
+func (me *_RichEdit) StreamOut(dest io.Writer, format co.SF) error {
+	return me.stream(co.EM_STREAMOUT, &_EditStreamPack{w: dest}, format)
+}
+
+func (me *_RichEdit) stream(msg co.WM, pPack *_EditStreamPack, format co.SF) error {
+	_globalEditStreamMutex.Lock()
+	if _globalEditStreamPacks == nil { // the set was not initialized yet?
+		_globalEditStreamPacks = make(map[*_EditStreamPack]struct{}, 1)
+	}
+	_globalEditStreamPacks[pPack] = struct{}{} // store pointer in the set
+	_globalEditStreamMutex.Unlock()
+
+	var es win.EDITSTREAM
+	es.SetDwCookie(uintptr(unsafe.Pointer(pPack)))
+	es.SetPfnCallback(_globalEditStreamCallback)
+	me.Hwnd().SendMessage(msg,
+		win.WPARAM(format), win.LPARAM(unsafe.Pointer(&es)))
+
+	_globalEditStreamMutex.Lock()
+	delete(_globalEditStreamPacks, pPack) // remove from the set
+	_globalEditStreamMutex.Unlock()
+
+	if pPack.err != nil {
+		return pPack.err // error from the io.Reader or io.Writer
+	} else if es.DwError() != 0 {
+		return errco.ERROR(es.DwError())
+	}
+	return nil
+}
+
+func (me *_RichEdit) TextRange(idxFirst, idxPastLast int) string {
+	if idxPastLast == -1 {
+		idxPastLast = int(me.Hwnd().SendMessage(co.WM_GETTEXTLENGTH, 0, 0))
+	}
+	if idxPastLast <= idxFirst {
+		return ""
+	}
+
+	buf := make([]uint16, idxPastLast-idxFirst+1) // room for terminating null
+	tr := win.TEXTRANGE{
+		Chrg: win.CHARRANGE{CpMin: int32(idxFirst), CpMax: int32(idxPastLast)},
+	}
+	tr.SetLpstrText(buf)
+	me.Hwnd().SendMessage(co.EM_GETTEXTRANGE, 0, win.LPARAM(unsafe.Pointer(&tr)))
+	return win.Str.FromNativeSlice(buf)
+}
+
+type _EditStreamPack struct {
+	r   io.Reader
+	w   io.Writer
+	err error
+}
+
+var (
+	_globalEditStreamPacks    map[*_EditStreamPack]struct{} // keeps pointers from being collected by GC
+	_globalEditStreamMutex    = sync.Mutex{}
+	_globalEditStreamCallback = syscall.NewCallback(
+		func(pPack *_EditStreamPack, pbBuff *byte, cb int32, pcb *int32) uintptr {
+			buf := unsafe.Slice(pbBuff, cb)
+
+			var n int
+			if pPack.r != nil { // EM_STREAMIN
+				for n == 0 && pPack.err == nil {
+					n, pPack.err = pPack.r.Read(buf)
+				}
+				if pPack.err == io.EOF {
+					pPack.err = nil // a zero count will end the stream
+				}
+			} else { // EM_STREAMOUT
+				n, pPack.err = pPack.w.Write(buf)
+			}
+
+			*pcb = int32(n)
+			return util.BoolToUintptr(pPack.err != nil) // nonzero aborts the operation
+		})
+)
+
+//------------------------------------------------------------------------------
+
+type _RichEditO struct {
+	ctrlId int
+
+	text        string
+	position    win.POINT
+	size        win.SIZE
+	horz        HORZ
+	vert        VERT
+	ctrlStyles  co.ES
+	wndStyles   co.WS
+	wndExStyles co.WS_EX
+
+	autoUrl co.AURL
+}
+
+// Control ID.
+//
+// Defaults to an auto-generated ID.
+func (o *_RichEditO) CtrlId(i int) *_RichEditO { o.ctrlId = i; return o }
+
+// Text to appear in the control, passed to CreateWindowEx().
+//
+// Defaults to empty string.
+func (o *_RichEditO) Text(t string) *_RichEditO { o.text = t; return o }
+
+// Position within parent's client area.
+//
+// If parent is a dialog box, coordinates are in Dialog Template Units;
+// otherwise, they are in pixels and they will be adjusted to the current system
+// DPI.
+//
+// Defaults to 0x0.
+func (o *_RichEditO) Position(p win.POINT) *_RichEditO { _OwPt(&o.position, p); return o }
+
+// Control size in pixels.
+//
+// If parent is a dialog box, coordinates are in Dialog Template Units;
+// otherwise, they are in pixels and they will be adjusted to the current system
+// DPI.
+//
+// Defaults to 200x120.
+func (o *_RichEditO) Size(s win.SIZE) *_RichEditO { _OwSz(&o.size, s); return o }
+
+// Horizontal behavior when the parent is resized.
+//
+// Defaults to HORZ_NONE.
+func (o *_RichEditO) Horz(s HORZ) *_RichEditO { o.horz = s; return o }
+
+// Vertical behavior when the parent is resized.
+//
+// Defaults to VERT_NONE.
+func (o *_RichEditO) Vert(s VERT) *_RichEditO { o.vert = s; return o }
+
+// RichEdit control styles, passed to CreateWindowEx().
+//
+// Defaults to ES_MULTILINE | ES_AUTOVSCROLL | ES_WANTRETURN | ES_NOHIDESEL.
+func (o *_RichEditO) CtrlStyles(s co.ES) *_RichEditO { o.ctrlStyles = s; return o }
+
+// Window styles, passed to CreateWindowEx().
+//
+// Defaults to co.WS_CHILD | co.WS_GROUP | co.WS_TABSTOP | co.WS_VISIBLE | co.WS_VSCROLL.
+func (o *_RichEditO) WndStyles(s co.WS) *_RichEditO { o.wndStyles = s; return o }
+
+// Extended window styles, passed to CreateWindowEx().
+//
+// Defaults to WS_EX_CLIENTEDGE.
+func (o *_RichEditO) WndExStyles(s co.WS_EX) *_RichEditO { o.wndExStyles = s; return o }
+
+// Automatic detection of links, which are then reported with EN_LINK.
+//
+// Defaults to AURL_NONE.
+func (o *_RichEditO) AutoUrlDetect(f co.AURL) *_RichEditO { o.autoUrl = f; return o }
+
+func (o *_RichEditO) lateDefaults() {
+	if o.ctrlId == 0 {
+		o.ctrlId = _NextCtrlId()
+	}
+}
+
+// Options for NewRichEdit().
+func RichEditOpts() *_RichEditO {
+	return &_RichEditO{
+		size: win.SIZE{Cx: 200, Cy: 120},
+		horz: HORZ_NONE,
+		vert: VERT_NONE,
+		ctrlStyles: co.ES_MULTILINE | co.ES_AUTOVSCROLL |
+			co.ES_WANTRETURN | co.ES_NOHIDESEL,
+		wndStyles: co.WS_CHILD | co.WS_GROUP | co.WS_TABSTOP |
+			co.WS_VISIBLE | co.WS_VSCROLL,
+		wndExStyles: co.WS_EX_CLIENTEDGE,
+	}
+}
+
+//------------------------------------------------------------------------------
+
+// RichEdit control notifications.
+//
+// Unlike the Edit, most notifications must be enabled in the event mask; this
+// is automatically done for each handler added.
+type _RichEditEvents struct {
+	ctrlId    int
+	events    *_EventsWmNfy
+	eventMask co.ENM
+}
+
+func (me *_RichEditEvents) new(ctrl *_NativeControlBase) {
+	me.ctrlId = ctrl.CtrlId()
+	me.events = ctrl.Parent().On()
+}
+
+func (me *_RichEditEvents) applyEventMask(hCtrl win.HWND) {
+	if me.eventMask != co.ENM_NONE {
+		hCtrl.SendMessage(co.EM_SETEVENTMASK, 0, win.LPARAM(me.eventMask))
+	}
+}
+
+// [EN_CHANGE] message handler.
+//
+// [EN_CHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-change
+func (me *_RichEditEvents) EnChange(userFunc func()) {
+	me.eventMask |= co.ENM_CHANGE
+	me.events.addCmdZero(me.ctrlId, co.EN_CHANGE, func(_ wm.Command) {
+		userFunc()
+	})
+}
+
+// [EN_HSCROLL] message handler.
+//
+// [EN_HSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/en-hscroll
+func (me *_RichEditEvents) EnHScroll(userFunc func()) {
+	me.eventMask |= co.ENM_SCROLL
+	me.events.addCmdZero(me.ctrlId, co.EN_HSCROLL, func(_ wm.Command) {
+		userFunc()
+	})
+}
+
+// [EN_KILLFOCUS] message handler.
+//
+// [EN_KILLFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/en-killfocus
+func (me *_RichEditEvents) EnKillFocus(userFunc func()) {
+	me.events.addCmdZero(me.ctrlId, co.EN_KILLFOCUS, func(_ wm.Command) {
+		userFunc()
+	})
+}
+
+// [EN_LINK] message handler.
+//
+// Text is reported as link if it has the CFE_LINK effect, or if automatic link
+// detection is enabled. Return true to prevent the control from processing the
+// mouse or cursor message.
+//
+// [EN_LINK]: https://learn.microsoft.com/en-us/windows/win32/controls/en-link
+func (me *_RichEditEvents) EnLink(userFunc func(p *win.ENLINK) bool) {
+	me.eventMask |= co.ENM_LINK
+	me.events.addNfyRet(me.ctrlId, co.EN_LINK, func(p unsafe.Pointer) uintptr {
+		return util.BoolToUintptr(userFunc((*win.ENLINK)(p)))
+	})
+}
+
+// [EN_MAXTEXT] message handler.
+//
+// [EN_MAXTEXT]: https://learn.microsoft.com/en-us/windows/win32/controls/en-maxtext
+func (me *_RichEditEvents) EnMaxText(userFunc func()) {
+	me.events.addCmdZero(me.ctrlId, co.EN_MAXTEXT, func(_ wm.Command) {
+		userFunc()
+	})
+}
+
+// [EN_SELCHANGE] message handler.
+//
+// [EN_SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-selchange
+func (me *_RichEditEvents) EnSelChange(userFunc func(p *win.SELCHANGE)) {
+	me.eventMask |= co.ENM_SELCHANGE
+	me.events.addNfyZero(me.ctrlId, co.EN_SELCHANGE, func(p unsafe.Pointer) {
+		userFunc((*win.SELCHANGE)(p))
+	})
+}
+
+// [EN_SETFOCUS] message handler.
+//
+// [EN_SETFOCUS]: https://learn.microsoft.com/en-us/windows/win32/controls/en-setfocus
+func (me *_RichEditEvents) EnSetFocus(userFunc func()) {
+	me.events.addCmdZero(me.ctrlId, co.EN_SETFOCUS, func(_ wm.Command) {
+		userFunc()
+	})
+}
+
+// [EN_UPDATE] message handler.
+//
+// [EN_UPDATE]: https://learn.microsoft.com/en-us/windows/win32/controls/en-update
+func (me *_RichEditEvents) EnUpdate(userFunc func()) {
+	me.eventMask |= co.ENM_UPDATE
+	me.events.addCmdZero(me.ctrlId, co.EN_UPDATE, func(_ wm.Command) {
+		userFunc()
+	})
+}
+
+// [EN_VSCROLL] message handler.
+//
+// [EN_VSCROLL]: https://learn.microsoft.com/en-us/windows/win32/controls/en-vscroll
+func (me *_RichEditEvents) EnVScroll(userFunc func()) {
+	me.eventMask |= co.ENM_SCROLL
+	me.events.addCmdZero(me.ctrlId, co.EN_VSCROLL, func(_ wm.Command) {
+		userFunc()
+	})
+}
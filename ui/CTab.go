@@ -0,0 +1,415 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/ui/wm"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Native [tab] control.
+//
+// Each tab hosts a WindowControl, which is shown when the tab is selected, and
+// hidden otherwise.
+//
+// [tab]: https://learn.microsoft.com/en-us/windows/win32/controls/tab-controls
+type Tab interface {
+	AnyNativeControl
+	AnyFocusControl
+	implTab() // prevent public implementation
+
+	// Exposes all the [Tab notifications] the can be handled.
+	//
+	// Panics if called after the control was created.
+	//
+	// [Tab notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-tab-control-reference-notifications
+	On() *_TabEvents
+
+	DisplayRect() win.RECT                        // Retrieves the display area of the tab, relative to the parent's client area.
+	ExtendedStyle() co.TCS_EX                     // Retrieves the extended style flags.
+	Items() *_TabItems                            // Item methods.
+	SetExtendedStyle(doSet bool, style co.TCS_EX) // Sets or unsets extended style flags.
+
+	showPage(index int) // shows the page at the given index, hiding all others
+}
+
+// A page to be hosted by a Tab: the text of the tab, and the WindowControl
+// which is displayed when the tab is selected.
+//
+// The WindowControl must have the same parent of the Tab.
+type TabPage struct {
+	Title string
+	Page  WindowControl
+}
+
+//------------------------------------------------------------------------------
+
+type _Tab struct {
+	_NativeControlBase
+	events _TabEvents
+	items  _TabItems
+	pages  []TabPage
+}
+
+// Creates a new Tab. Call ui.TabOpts() to define the options to be passed to
+// the underlying CreateWindowEx().
+//
+// The WindowControl of each page must be constructed before the Tab, because
+// the pages are positioned when the Tab is created; otherwise, it panics.
+//
+// # Example
+//
+//	var owner ui.AnyParent // initialized somewhere
+//
+//	page1 := ui.NewWindowControl(owner, nil)
+//	page2 := ui.NewWindowControl(owner, nil)
+//
+//	myTab := ui.NewTab(
+//		owner,
+//		ui.TabOpts().
+//			Position(win.POINT{X: 10, Y: 10}).
+//			Size(win.SIZE{Cx: 320, Cy: 240}).
+//			Pages(
+//				ui.TabPage{Title: "General", Page: page1},
+//				ui.TabPage{Title: "Advanced", Page: page2},
+//			),
+//	)
+func NewTab(parent AnyParent, opts *_TabO) Tab {
+	if opts == nil {
+		opts = TabOpts()
+	}
+	opts.lateDefaults()
+
+	me := &_Tab{}
+	me._NativeControlBase.new(parent, opts.ctrlId)
+	me.events.new(&me._NativeControlBase)
+	me.items.new(me)
+	me.pages = opts.pages
+
+	parent.internalOn().addMsgNoRet(_CreateOrInitDialog(parent), func(_ wm.Any) {
+		_ConvertDtuOrMultiplyDpi(parent, &opts.position, &opts.size)
+
+		me._NativeControlBase.createWindow(opts.wndExStyles,
+			win.ClassNameStr("SysTabControl32"), win.StrOptNone(),
+			opts.wndStyles|co.WS(opts.ctrlStyles),
+			opts.position, opts.size, win.HMENU(opts.ctrlId))
+
+		parent.addResizingChild(me, opts.horz, opts.vert)
+		me.Hwnd().SendMessage(co.WM_SETFONT, win.WPARAM(_globalUiFont), 1)
+
+		if opts.ctrlExStyles != co.TCS_EX_NONE {
+			me.SetExtendedStyle(true, opts.ctrlExStyles)
+		}
+		me.placePages(opts.horz, opts.vert)
+	})
+
+	me.handledEvents()
+	return me
+}
+
+// Creates a new Tab from a dialog resource.
+//
+// The WindowControl of each page must have the same parent of the Tab, and it
+// must be constructed before the Tab; otherwise, it panics.
+func NewTabDlg(
+	parent AnyParent, ctrlId int,
+	horz HORZ, vert VERT, pages ...TabPage) Tab {
+
+	me := &_Tab{}
+	me._NativeControlBase.new(parent, ctrlId)
+	me.events.new(&me._NativeControlBase)
+	me.items.new(me)
+	me.pages = pages
+
+	parent.internalOn().addMsgNoRet(co.WM_INITDIALOG, func(_ wm.Any) {
+		me._NativeControlBase.assignDlgItem()
+		parent.addResizingChild(me, horz, vert)
+		me.placePages(horz, vert)
+	})
+
+	me.handledEvents()
+	return me
+}
+
+// Implements Tab.
+func (*_Tab) implTab() {}
+
+// Implements AnyFocusControl.
+func (me *_Tab) Focus() {
+	me._NativeControlBase.focus()
+}
+
+func (me *_Tab) On() *_TabEvents {
+	if me.Hwnd() != 0 {
+		panic("Cannot add event handling after the Tab is created.")
+	}
+	return &me.events
+}
+
+func (me *_Tab) DisplayRect() win.RECT {
+	rc := me.Hwnd().GetWindowRect()          // relative to screen
+	me.Parent().Hwnd().ScreenToClientRc(&rc) // now relative to parent
+	me.Hwnd().SendMessage(co.TCM_ADJUSTRECT, 0, win.LPARAM(unsafe.Pointer(&rc)))
+	return rc
+}
+
+func (me *_Tab) ExtendedStyle() co.TCS_EX {
+	return co.TCS_EX(me.Hwnd().SendMessage(co.TCM_GETEXTENDEDSTYLE, 0, 0))
+}
+
+func (me *_Tab) Items() *_TabItems {
+	return &me.items
+}
+
+func (me *_Tab) SetExtendedStyle(doSet bool, style co.TCS_EX) {
+	affected := util.Iif(doSet, style, 0).(co.TCS_EX)
+	me.Hwnd().SendMessage(co.TCM_SETEXTENDEDSTYLE,
+		win.WPARAM(style), win.LPARAM(affected))
+}
+
+func (me *_Tab) showPage(index int) {
+	for i, page := range me.pages {
+		if page.Page.Hwnd() != 0 {
+			page.Page.Hwnd().ShowWindow(util.Iif(i == index, co.SW_SHOW, co.SW_HIDE).(co.SW))
+		}
+	}
+}
+
+// Inserts the tab items, and moves the pages to the display area of the tab.
+// The pages are resized along with the tab itself.
+func (me *_Tab) placePages(horz HORZ, vert VERT) {
+	rcDisplay := me.DisplayRect()
+
+	for _, page := range me.pages {
+		me.Items().Add(page.Title)
+
+		if page.Page.Hwnd() == 0 {
+			panic("Tab page was not created; it must be constructed before the Tab.")
+		}
+		page.Page.Hwnd().SetWindowPos(win.HWND(co.HWND_IA_TOP), // above the tab, which is created after
+			rcDisplay.Left, rcDisplay.Top,
+			rcDisplay.Right-rcDisplay.Left, rcDisplay.Bottom-rcDisplay.Top,
+			co.SWP_NOACTIVATE)
+		me.Parent().addResizingChild(page.Page, horz, vert)
+	}
+
+	if len(me.pages) > 0 {
+		me.Items().Get(0).Select()
+	}
+}
+
+func (me *_Tab) handledEvents() {
+	me.Parent().internalOn().addNfyNoRet(me.CtrlId(), co.TCN_SELCHANGE, func(_ unsafe.Pointer) {
+		if selItem, hasSel := me.Items().Selected(); hasSel {
+			me.showPage(selItem.Index())
+		}
+	})
+}
+
+//------------------------------------------------------------------------------
+
+type _TabO struct {
+	ctrlId int
+
+	position     win.POINT
+	size         win.SIZE
+	horz         HORZ
+	vert         VERT
+	ctrlStyles   co.TCS
+	ctrlExStyles co.TCS_EX
+	wndStyles    co.WS
+	wndExStyles  co.WS_EX
+
+	pages []TabPage
+}
+
+// Control ID.
+//
+// Defaults to an auto-generated ID.
+func (o *_TabO) CtrlId(i int) *_TabO { o.ctrlId = i; return o }
+
+// Position within parent's client area.
+//
+// If parent is a dialog box, coordinates are in Dialog Template Units;
+// otherwise, they are in pixels and they will be adjusted to the current system
+// DPI.
+//
+// Defaults to 0x0.
+func (o *_TabO) Position(p win.POINT) *_TabO { _OwPt(&o.position, p); return o }
+
+// Control size.
+//
+// If parent is a dialog box, coordinates are in Dialog Template Units;
+// otherwise, they are in pixels and they will be adjusted to the current system
+// DPI.
+//
+// Defaults to 300x200.
+func (o *_TabO) Size(s win.SIZE) *_TabO { _OwSz(&o.size, s); return o }
+
+// Horizontal behavior when the parent is resized. The pages follow the same
+// behavior.
+//
+// Defaults to HORZ_NONE.
+func (o *_TabO) Horz(s HORZ) *_TabO { o.horz = s; return o }
+
+// Vertical behavior when the parent is resized. The pages follow the same
+// behavior.
+//
+// Defaults to VERT_NONE.
+func (o *_TabO) Vert(s VERT) *_TabO { o.vert = s; return o }
+
+// Tab control styles, passed to CreateWindowEx().
+//
+// Defaults to TCS_TABS.
+func (o *_TabO) CtrlStyles(s co.TCS) *_TabO { o.ctrlStyles = s; return o }
+
+// Tab extended control styles.
+//
+// Defaults to TCS_EX_NONE.
+func (o *_TabO) CtrlExStyles(s co.TCS_EX) *_TabO { o.ctrlExStyles = s; return o }
+
+// Window styles, passed to CreateWindowEx().
+//
+// Defaults to co.WS_CHILD | co.WS_GROUP | co.WS_TABSTOP | co.WS_VISIBLE | co.WS_CLIPSIBLINGS.
+func (o *_TabO) WndStyles(s co.WS) *_TabO { o.wndStyles = s; return o }
+
+// Extended window styles, passed to CreateWindowEx().
+//
+// Defaults to WS_EX_NONE.
+func (o *_TabO) WndExStyles(s co.WS_EX) *_TabO { o.wndExStyles = s; return o }
+
+// Pages to be hosted by the Tab. The WindowControl of each page must have the
+// same parent of the Tab, and it must be constructed before the Tab.
+//
+// Defaults to none.
+func (o *_TabO) Pages(p ...TabPage) *_TabO { o.pages = p; return o }
+
+func (o *_TabO) lateDefaults() {
+	if o.ctrlId == 0 {
+		o.ctrlId = _NextCtrlId()
+	}
+}
+
+// Options for NewTab().
+func TabOpts() *_TabO {
+	return &_TabO{
+		size:       win.SIZE{Cx: 300, Cy: 200},
+		horz:       HORZ_NONE,
+		vert:       VERT_NONE,
+		ctrlStyles: co.TCS_TABS,
+		wndStyles: co.WS_CHILD | co.WS_GROUP | co.WS_TABSTOP | co.WS_VISIBLE |
+			co.WS_CLIPSIBLINGS,
+	}
+}
+
+//------------------------------------------------------------------------------
+
+// Tab control notifications.
+type _TabEvents struct {
+	ctrlId int
+	events *_EventsWmNfy
+}
+
+func (me *_TabEvents) new(ctrl *_NativeControlBase) {
+	me.ctrlId = ctrl.CtrlId()
+	me.events = ctrl.Parent().On()
+}
+
+// [NM_CLICK] message handler.
+//
+// [NM_CLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-click-tab
+func (me *_TabEvents) NmClick(userFunc func()) {
+	me.events.addNfyZero(me.ctrlId, co.NM_CLICK, func(_ unsafe.Pointer) {
+		userFunc()
+	})
+}
+
+// [NM_DBLCLK] message handler.
+//
+// [NM_DBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-dblclk-tab
+func (me *_TabEvents) NmDblClk(userFunc func()) {
+	me.events.addNfyZero(me.ctrlId, co.NM_DBLCLK, func(_ unsafe.Pointer) {
+		userFunc()
+	})
+}
+
+// [NM_RCLICK] message handler.
+//
+// [NM_RCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rclick-tab
+func (me *_TabEvents) NmRClick(userFunc func() bool) {
+	me.events.addNfyRet(me.ctrlId, co.NM_RCLICK, func(_ unsafe.Pointer) uintptr {
+		return util.BoolToUintptr(userFunc())
+	})
+}
+
+// [NM_RDBLCLK] message handler.
+//
+// [NM_RDBLCLK]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-rdblclk-tab
+func (me *_TabEvents) NmRDblClk(userFunc func() bool) {
+	me.events.addNfyRet(me.ctrlId, co.NM_RDBLCLK, func(_ unsafe.Pointer) uintptr {
+		return util.BoolToUintptr(userFunc())
+	})
+}
+
+// [NM_RELEASEDCAPTURE] message handler.
+//
+// [NM_RELEASEDCAPTURE]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-releasedcapture-tab-
+func (me *_TabEvents) NmReleasedCapture(userFunc func()) {
+	me.events.addNfyZero(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) {
+		userFunc()
+	})
+}
+
+// [TCN_FOCUSCHANGE] message handler.
+//
+// [TCN_FOCUSCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/tcn-focuschange
+func (me *_TabEvents) TcnFocusChange(userFunc func()) {
+	me.events.addNfyZero(me.ctrlId, co.TCN_FOCUSCHANGE, func(_ unsafe.Pointer) {
+		userFunc()
+	})
+}
+
+// [TCN_GETOBJECT] message handler.
+//
+// [TCN_GETOBJECT]: https://learn.microsoft.com/en-us/windows/win32/controls/tcn-getobject
+func (me *_TabEvents) TcnGetObject(userFunc func(p *win.NMOBJECTNOTIFY)) {
+	me.events.addNfyZero(me.ctrlId, co.TCN_GETOBJECT, func(p unsafe.Pointer) {
+		userFunc((*win.NMOBJECTNOTIFY)(p))
+	})
+}
+
+// [TCN_KEYDOWN] message handler.
+//
+// [TCN_KEYDOWN]: https://learn.microsoft.com/en-us/windows/win32/controls/tcn-keydown
+func (me *_TabEvents) TcnKeyDown(userFunc func(p *win.NMTCKEYDOWN)) {
+	me.events.addNfyZero(me.ctrlId, co.TCN_KEYDOWN, func(p unsafe.Pointer) {
+		userFunc((*win.NMTCKEYDOWN)(p))
+	})
+}
+
+// [TCN_SELCHANGE] message handler.
+//
+// The page of the newly selected tab is automatically shown before this
+// handler is called.
+//
+// [TCN_SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/controls/tcn-selchange
+func (me *_TabEvents) TcnSelChange(userFunc func()) {
+	me.events.addNfyZero(me.ctrlId, co.TCN_SELCHANGE, func(_ unsafe.Pointer) {
+		userFunc()
+	})
+}
+
+// [TCN_SELCHANGING] message handler.
+//
+// Return true to prevent the selection from changing.
+//
+// [TCN_SELCHANGING]: https://learn.microsoft.com/en-us/windows/win32/controls/tcn-selchanging
+func (me *_TabEvents) TcnSelChanging(userFunc func() bool) {
+	me.events.addNfyRet(me.ctrlId, co.TCN_SELCHANGING, func(_ unsafe.Pointer) uintptr {
+		return util.BoolToUintptr(userFunc())
+	})
+}
@@ -0,0 +1,86 @@
+//go:build windows
+
+package ui
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// A single item of a Tab.
+type TabItem struct {
+	tab   Tab
+	index uint32
+}
+
+// Returns the zero-based index of the item.
+func (me TabItem) Index() int {
+	return int(me.index)
+}
+
+// Retrieves the custom data associated with the item.
+func (me TabItem) LParam() win.LPARAM {
+	tci := win.TCITEM{
+		Mask: co.TCIF_PARAM,
+	}
+
+	ret := me.tab.Hwnd().SendMessage(co.TCM_GETITEM,
+		win.WPARAM(me.index), win.LPARAM(unsafe.Pointer(&tci)))
+	if ret == 0 {
+		panic(fmt.Sprintf("TCM_GETITEM %d failed.", me.index))
+	}
+	return tci.LParam
+}
+
+// Selects the item, showing its page.
+func (me TabItem) Select() {
+	me.tab.Hwnd().SendMessage(co.TCM_SETCURSEL, win.WPARAM(me.index), 0)
+	me.tab.showPage(int(me.index)) // TCM_SETCURSEL won't send TCN_SELCHANGE
+}
+
+// Sets the custom data associated with the item.
+func (me TabItem) SetLParam(lp win.LPARAM) {
+	tci := win.TCITEM{
+		Mask:   co.TCIF_PARAM,
+		LParam: lp,
+	}
+
+	ret := me.tab.Hwnd().SendMessage(co.TCM_SETITEM,
+		win.WPARAM(me.index), win.LPARAM(unsafe.Pointer(&tci)))
+	if ret == 0 {
+		panic(fmt.Sprintf("TCM_SETITEM %d failed.", me.index))
+	}
+}
+
+// Sets the text of the item.
+func (me TabItem) SetText(text string) {
+	tci := win.TCITEM{
+		Mask: co.TCIF_TEXT,
+	}
+	tci.SetPszText(win.Str.ToNativeSlice(text))
+
+	ret := me.tab.Hwnd().SendMessage(co.TCM_SETITEM,
+		win.WPARAM(me.index), win.LPARAM(unsafe.Pointer(&tci)))
+	if ret == 0 {
+		panic(fmt.Sprintf("TCM_SETITEM %d failed \"%s\".", me.index, text))
+	}
+}
+
+// Retrieves the text of the item.
+func (me TabItem) Text() string {
+	var buf [256]uint16 // arbitrary
+	tci := win.TCITEM{
+		Mask: co.TCIF_TEXT,
+	}
+	tci.SetPszText(buf[:])
+
+	ret := me.tab.Hwnd().SendMessage(co.TCM_GETITEM,
+		win.WPARAM(me.index), win.LPARAM(unsafe.Pointer(&tci)))
+	if ret == 0 {
+		panic(fmt.Sprintf("TCM_GETITEM %d failed.", me.index))
+	}
+	return win.Str.FromNativeSlice(tci.PszText())
+}
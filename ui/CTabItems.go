@@ -0,0 +1,62 @@
+//go:build windows
+
+package ui
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+type _TabItems struct {
+	tab Tab
+}
+
+func (me *_TabItems) new(ctrl Tab) {
+	me.tab = ctrl
+}
+
+// Adds a new tab item, returning it.
+//
+// Note that this method only adds the tab itself; pages are hosted only when
+// given at the Tab construction.
+func (me *_TabItems) Add(text string) TabItem {
+	tci := win.TCITEM{
+		Mask: co.TCIF_TEXT,
+	}
+	tci.SetPszText(win.Str.ToNativeSlice(text))
+
+	newIdx := int(
+		me.tab.Hwnd().SendMessage(co.TCM_INSERTITEM,
+			win.WPARAM(me.Count()), win.LPARAM(unsafe.Pointer(&tci))),
+	)
+	if newIdx == -1 {
+		panic(fmt.Sprintf("TCM_INSERTITEM failed \"%s\".", text))
+	}
+	return me.Get(newIdx)
+}
+
+// Retrieves the number of items.
+func (me *_TabItems) Count() int {
+	return int(me.tab.Hwnd().SendMessage(co.TCM_GETITEMCOUNT, 0, 0))
+}
+
+// Returns the item at the given index.
+//
+// Note that this method is dumb: no validation is made, the given index is
+// simply kept. If the index is invalid (or becomes invalid), subsequent
+// operations on the TabItem will fail.
+func (me *_TabItems) Get(index int) TabItem {
+	return TabItem{tab: me.tab, index: uint32(index)}
+}
+
+// Retrieves the selected item, if any.
+func (me *_TabItems) Selected() (TabItem, bool) {
+	idx := int(me.tab.Hwnd().SendMessage(co.TCM_GETCURSEL, 0, 0))
+	if idx == -1 {
+		return me.Get(-1), false
+	}
+	return me.Get(idx), true
+}
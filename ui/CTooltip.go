@@ -0,0 +1,326 @@
+//go:build windows
+
+package ui
+
+import (
+	"runtime"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/ui/wm"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Native [tooltip] control.
+//
+// Unlike other controls, a tooltip is a popup window owned by its parent, and
+// it displays text for the tools added to it: controls or rectangles of the
+// parent's client area.
+//
+// [tooltip]: https://learn.microsoft.com/en-us/windows/win32/controls/tooltip-controls
+type Tooltip interface {
+	AnyNativeControl
+	implTooltip() // prevent public implementation
+
+	// Exposes all the [Tooltip notifications] the can be handled.
+	//
+	// Panics if called after the control was created.
+	//
+	// [Tooltip notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-tooltip-control-reference-notifications
+	On() *_TooltipEvents
+
+	Activate(active bool)                             // Activates or deactivates the tooltip.
+	AddTool(ctrl AnyControl, text string) TooltipTool // Adds a tool for the given control. If text is empty, it will be requested with TTN_GETDISPINFO.
+	AddToolRect(rc win.RECT, text string) TooltipTool // Adds a tool for a rectangle of the parent's client area, in pixels. If text is empty, it will be requested with TTN_GETDISPINFO.
+	AddTrackingTool(text string) TooltipTool          // Adds a tracking tool, which is manually shown with TooltipTool.TrackActivate(). If text is empty, it will be requested with TTN_GETDISPINFO.
+	DelayTime(which co.TTDT) int                      // Retrieves the given delay time, in milliseconds.
+	MaxTipWidth() int                                 // Retrieves the maximum tooltip width, or -1 if none.
+	Pop()                                             // Hides the tooltip, if visible.
+	SetDelayTime(which co.TTDT, ms int)               // Sets the given delay time, in milliseconds. Pass -1 to restore the default.
+	SetMaxTipWidth(width int)                         // Sets the maximum tooltip width, in pixels, which allows multiline text. Pass -1 to allow any width.
+	SetTitle(title string, icon co.TTI)               // Sets the title and icon displayed above the text.
+	ToolCount() int                                   // Retrieves the number of tools.
+}
+
+//------------------------------------------------------------------------------
+
+type _Tooltip struct {
+	_NativeControlBase
+	events _TooltipEvents
+}
+
+// Creates a new Tooltip. Call ui.TooltipOpts() to define the options to be
+// passed to the underlying CreateWindowEx().
+//
+// Tools can be added only after the parent is created.
+//
+// # Example
+//
+//	var owner ui.AnyParent // initialized somewhere
+//	var myButton ui.Button
+//
+//	myTip := ui.NewTooltip(
+//		owner,
+//		ui.TooltipOpts().
+//			CtrlStyles(co.TTS_ALWAYSTIP|co.TTS_NOPREFIX|co.TTS_BALLOON),
+//	)
+//
+//	owner.On().WmCreate(func(_ wm.Create) int {
+//		myTip.AddTool(myButton, "Click me")
+//		return 0
+//	})
+func NewTooltip(parent AnyParent, opts *_TooltipO) Tooltip {
+	if opts == nil {
+		opts = TooltipOpts()
+	}
+
+	me := &_Tooltip{}
+	me._NativeControlBase.new(parent, _NextCtrlId())
+	me.events.new(&me._NativeControlBase)
+
+	parent.internalOn().addMsgNoRet(_CreateOrInitDialog(parent), func(_ wm.Any) {
+		me._NativeControlBase.createWindow(opts.wndExStyles,
+			win.ClassNameStr("tooltips_class32"), win.StrOptNone(),
+			opts.wndStyles|co.WS(opts.ctrlStyles),
+			win.POINT{}, win.SIZE{}, // the tooltip sizes itself
+			win.HMENU(0)) // popup window, no control ID
+
+		me.Hwnd().SendMessage(co.WM_SETFONT, win.WPARAM(_globalUiFont), 1)
+		if opts.maxTipWidth != -1 {
+			me.SetMaxTipWidth(opts.maxTipWidth)
+		}
+		if opts.title != "" {
+			me.SetTitle(opts.title, opts.titleIcon)
+		}
+	})
+
+	return me
+}
+
+// Implements Tooltip.
+func (*_Tooltip) implTooltip() {}
+
+func (me *_Tooltip) On() *_TooltipEvents {
+	if me.Hwnd() != 0 {
+		panic("Cannot add event handling after the Tooltip is created.")
+	}
+	return &me.events
+}
+
+func (me *_Tooltip) Activate(active bool) {
+	me.Hwnd().SendMessage(co.TTM_ACTIVATE, win.WPARAM(util.BoolToUintptr(active)), 0)
+}
+
+func (me *_Tooltip) AddTool(ctrl AnyControl, text string) TooltipTool {
+	return me.addTool(co.TTF_IDISHWND|co.TTF_SUBCLASS,
+		uintptr(ctrl.Hwnd()), win.RECT{}, text)
+}
+
+func (me *_Tooltip) AddToolRect(rc win.RECT, text string) TooltipTool {
+	return me.addTool(co.TTF_SUBCLASS, uintptr(_NextCtrlId()), rc, text)
+}
+
+func (me *_Tooltip) AddTrackingTool(text string) TooltipTool {
+	return me.addTool(co.TTF_TRACK|co.TTF_ABSOLUTE, uintptr(_NextCtrlId()),
+		win.RECT{}, text)
+}
+
+func (me *_Tooltip) addTool(
+	flags co.TTF, uId uintptr, rc win.RECT, text string) TooltipTool {
+
+	ti := win.TTTOOLINFO{
+		UFlags: flags,
+		Hwnd:   me.Parent().Hwnd(),
+		UId:    uId,
+		Rect:   rc,
+	}
+	ti.SetCbSize()
+
+	if text == "" {
+		ti.SetLpszTextCallback()
+	} else {
+		ti.SetLpszText(win.Str.ToNativeSlice(text))
+	}
+
+	ret := me.Hwnd().SendMessage(co.TTM_ADDTOOL, 0, win.LPARAM(unsafe.Pointer(&ti)))
+	if ret == 0 {
+		panic("TTM_ADDTOOL failed.")
+	}
+
+	me.events.hookTool(int(uId))
+	return TooltipTool{me, uId}
+}
+
+func (me *_Tooltip) DelayTime(which co.TTDT) int {
+	return int(me.Hwnd().SendMessage(co.TTM_GETDELAYTIME, win.WPARAM(which), 0))
+}
+
+func (me *_Tooltip) MaxTipWidth() int {
+	return int(int32(me.Hwnd().SendMessage(co.TTM_GETMAXTIPWIDTH, 0, 0)))
+}
+
+func (me *_Tooltip) Pop() {
+	me.Hwnd().SendMessage(co.TTM_POP, 0, 0)
+}
+
+func (me *_Tooltip) SetDelayTime(which co.TTDT, ms int) {
+	me.Hwnd().SendMessage(co.TTM_SETDELAYTIME,
+		win.WPARAM(which), win.LPARAM(int32(ms)))
+}
+
+func (me *_Tooltip) SetMaxTipWidth(width int) {
+	me.Hwnd().SendMessage(co.TTM_SETMAXTIPWIDTH, 0, win.LPARAM(int32(width)))
+}
+
+func (me *_Tooltip) SetTitle(title string, icon co.TTI) {
+	pTitle := win.Str.ToNativePtr(title)
+	ret := me.Hwnd().SendMessage(co.TTM_SETTITLE,
+		win.WPARAM(icon), win.LPARAM(unsafe.Pointer(pTitle)))
+	runtime.KeepAlive(pTitle)
+	if ret == 0 {
+		panic("TTM_SETTITLE failed.")
+	}
+}
+
+func (me *_Tooltip) ToolCount() int {
+	return int(me.Hwnd().SendMessage(co.TTM_GETTOOLCOUNT, 0, 0))
+}
+
+//------------------------------------------------------------------------------
+
+type _TooltipO struct {
+	ctrlStyles  co.TTS
+	wndStyles   co.WS
+	wndExStyles co.WS_EX
+
+	maxTipWidth int
+	title       string
+	titleIcon   co.TTI
+}
+
+// Tooltip control styles, passed to CreateWindowEx().
+//
+// Defaults to TTS_ALWAYSTIP | TTS_NOPREFIX.
+func (o *_TooltipO) CtrlStyles(s co.TTS) *_TooltipO { o.ctrlStyles = s; return o }
+
+// Window styles, passed to CreateWindowEx().
+//
+// Defaults to co.WS_POPUP.
+func (o *_TooltipO) WndStyles(s co.WS) *_TooltipO { o.wndStyles = s; return o }
+
+// Extended window styles, passed to CreateWindowEx().
+//
+// Defaults to WS_EX_TOPMOST.
+func (o *_TooltipO) WndExStyles(s co.WS_EX) *_TooltipO { o.wndExStyles = s; return o }
+
+// Maximum tooltip width, in pixels. When set, the text will be broken into
+// multiple lines, also honoring explicit line breaks.
+//
+// Defaults to -1, meaning single-line tooltips of any width.
+func (o *_TooltipO) MaxTipWidth(w int) *_TooltipO { o.maxTipWidth = w; return o }
+
+// Title and icon displayed above the text.
+//
+// Defaults to none.
+func (o *_TooltipO) Title(t string, icon co.TTI) *_TooltipO {
+	o.title, o.titleIcon = t, icon
+	return o
+}
+
+// Options for NewTooltip().
+func TooltipOpts() *_TooltipO {
+	return &_TooltipO{
+		ctrlStyles:  co.TTS_ALWAYSTIP | co.TTS_NOPREFIX,
+		wndStyles:   co.WS_POPUP,
+		wndExStyles: co.WS_EX_TOPMOST,
+		maxTipWidth: -1,
+	}
+}
+
+//------------------------------------------------------------------------------
+
+// Tooltip control notifications.
+//
+// Tooltip notifications are sent to the parent identified by the tool ID, not
+// the control ID, therefore the handlers are hooked as each tool is added.
+type _TooltipEvents struct {
+	events *_EventsWmNfy
+
+	getDispInfo func(p *win.NMTTDISPINFO)
+	linkClick   func()
+	pop         func()
+	show        func() bool
+}
+
+func (me *_TooltipEvents) new(ctrl *_NativeControlBase) {
+	me.events = ctrl.Parent().On()
+}
+
+func (me *_TooltipEvents) hookTool(toolId int) {
+	if me.getDispInfo != nil {
+		userFunc := me.getDispInfo
+		me.events.addNfyZero(toolId, co.TTN_GETDISPINFO, func(p unsafe.Pointer) {
+			userFunc((*win.NMTTDISPINFO)(p))
+		})
+	}
+	if me.linkClick != nil {
+		userFunc := me.linkClick
+		me.events.addNfyZero(toolId, co.TTN_LINKCLICK, func(_ unsafe.Pointer) {
+			userFunc()
+		})
+	}
+	if me.pop != nil {
+		userFunc := me.pop
+		me.events.addNfyZero(toolId, co.TTN_POP, func(_ unsafe.Pointer) {
+			userFunc()
+		})
+	}
+	if me.show != nil {
+		userFunc := me.show
+		me.events.addNfyRet(toolId, co.TTN_SHOW, func(_ unsafe.Pointer) uintptr {
+			return util.BoolToUintptr(userFunc())
+		})
+	}
+}
+
+func (me *_TooltipEvents) unhookTool(toolId int) {
+	for _, code := range []co.NM{co.TTN_GETDISPINFO, co.TTN_LINKCLICK, co.TTN_POP, co.TTN_SHOW} {
+		me.events.removeNfy(toolId, code)
+	}
+}
+
+// [TTN_GETDISPINFO] message handler.
+//
+// Called for tools added with empty text, so the text can be computed lazily.
+// Use NMTTDISPINFO.SetSzText() for texts up to 79 characters; for longer
+// texts, point LpszText to a buffer which outlives the handler. The tool ID is
+// in Hdr.IdFrom.
+//
+// [TTN_GETDISPINFO]: https://learn.microsoft.com/en-us/windows/win32/controls/ttn-getdispinfo
+func (me *_TooltipEvents) TtnGetDispInfo(userFunc func(p *win.NMTTDISPINFO)) {
+	me.getDispInfo = userFunc
+}
+
+// [TTN_LINKCLICK] message handler.
+//
+// [TTN_LINKCLICK]: https://learn.microsoft.com/en-us/windows/win32/controls/ttn-linkclick
+func (me *_TooltipEvents) TtnLinkClick(userFunc func()) {
+	me.linkClick = userFunc
+}
+
+// [TTN_POP] message handler.
+//
+// [TTN_POP]: https://learn.microsoft.com/en-us/windows/win32/controls/ttn-pop
+func (me *_TooltipEvents) TtnPop(userFunc func()) {
+	me.pop = userFunc
+}
+
+// [TTN_SHOW] message handler.
+//
+// Return true if the tooltip window was repositioned with SetWindowPos().
+//
+// [TTN_SHOW]: https://learn.microsoft.com/en-us/windows/win32/controls/ttn-show
+func (me *_TooltipEvents) TtnShow(userFunc func() bool) {
+	me.show = userFunc
+}
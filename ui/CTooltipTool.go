@@ -0,0 +1,83 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// A single tool of a Tooltip.
+type TooltipTool struct {
+	tooltip *_Tooltip
+	uId     uintptr
+}
+
+func (me TooltipTool) toolInfo() win.TTTOOLINFO {
+	ti := win.TTTOOLINFO{
+		Hwnd: me.tooltip.Parent().Hwnd(),
+		UId:  me.uId,
+	}
+	ti.SetCbSize()
+	return ti
+}
+
+// Removes the tool from the Tooltip, along with its notification handlers.
+func (me TooltipTool) Delete() {
+	ti := me.toolInfo()
+	me.tooltip.Hwnd().SendMessage(co.TTM_DELTOOL, 0, win.LPARAM(unsafe.Pointer(&ti)))
+	me.tooltip.events.unhookTool(int(me.uId))
+}
+
+// Returns the tool ID, which is the control handle for tools added with
+// Tooltip.AddTool().
+func (me TooltipTool) Id() uintptr {
+	return me.uId
+}
+
+// Sets the bounding rectangle of a tool added with Tooltip.AddToolRect(), in
+// pixels, relative to the parent's client area.
+func (me TooltipTool) SetRect(rc win.RECT) {
+	ti := me.toolInfo()
+	ti.Rect = rc
+	me.tooltip.Hwnd().SendMessage(co.TTM_NEWTOOLRECT, 0, win.LPARAM(unsafe.Pointer(&ti)))
+}
+
+// Sets the text of the tool. If the text is empty, it will be requested with
+// TTN_GETDISPINFO.
+func (me TooltipTool) SetText(text string) {
+	ti := me.toolInfo()
+	if text == "" {
+		ti.SetLpszTextCallback()
+	} else {
+		ti.SetLpszText(win.Str.ToNativeSlice(text))
+	}
+	me.tooltip.Hwnd().SendMessage(co.TTM_UPDATETIPTEXT, 0, win.LPARAM(unsafe.Pointer(&ti)))
+}
+
+// Retrieves the text of the tool.
+func (me TooltipTool) Text() string {
+	var buf [1024]uint16 // arbitrary
+	ti := me.toolInfo()
+	ti.SetLpszText(buf[:])
+	me.tooltip.Hwnd().SendMessage(co.TTM_GETTEXT,
+		win.WPARAM(len(buf)), win.LPARAM(unsafe.Pointer(&ti)))
+	return win.Str.FromNativeSlice(buf[:])
+}
+
+// Shows or hides a tool added with Tooltip.AddTrackingTool().
+func (me TooltipTool) TrackActivate(show bool) {
+	ti := me.toolInfo()
+	me.tooltip.Hwnd().SendMessage(co.TTM_TRACKACTIVATE,
+		win.WPARAM(util.BoolToUintptr(show)), win.LPARAM(unsafe.Pointer(&ti)))
+}
+
+// Moves a tool added with Tooltip.AddTrackingTool() to the given position, in
+// screen coordinates.
+func (me TooltipTool) TrackPosition(pt win.POINT) {
+	me.tooltip.Hwnd().SendMessage(co.TTM_TRACKPOSITION,
+		0, win.MAKELPARAM(uint16(pt.X), uint16(pt.Y)))
+}
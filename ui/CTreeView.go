@@ -9,6 +9,8 @@ import (
 	"github.com/rodrigocfd/windigo/ui/wm"
 	"github.com/rodrigocfd/windigo/win"
 	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/shell"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
 )
 
 // Native [tree view] control.
@@ -26,6 +28,18 @@ type TreeView interface {
 	// [TreeView notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-tree-view-control-reference-notifications
 	On() *_TreeViewEvents
 
+	// Makes the items an OLE drag source: when the user starts dragging an
+	// item, its data is retrieved with dataFunc, then a drag and drop operation
+	// is performed. If the data was dropped, doneFunc is called, if not nil, so
+	// the item can be removed after a move.
+	//
+	// Panics if called after the control was created.
+	//
+	// ⚠️ OLE must be initialized in the UI thread, see com.OleInitialize().
+	ItemDragSource(okEffects shellco.DROPEFFECT,
+		dataFunc func(item TreeViewItem) []shell.DataItem,
+		doneFunc func(item TreeViewItem, effect shellco.DROPEFFECT))
+
 	Items() *_TreeViewItems // Item methods.
 }
 
@@ -115,6 +129,30 @@ func (me *_TreeView) On() *_TreeViewEvents {
 	return &me.events
 }
 
+func (me *_TreeView) ItemDragSource(
+	okEffects shellco.DROPEFFECT,
+	dataFunc func(item TreeViewItem) []shell.DataItem,
+	doneFunc func(item TreeViewItem, effect shellco.DROPEFFECT)) {
+
+	if me.Hwnd() != 0 {
+		panic("Cannot set drag source after the TreeView is created.")
+	}
+
+	beginDrag := func(p unsafe.Pointer) {
+		nmtv := (*win.NMTREEVIEW)(p)
+		item := me.Items().Get(nmtv.ItemNew.HItem)
+		data := dataFunc(item)
+		if len(data) == 0 {
+			return // nothing to drag
+		}
+		if effect, dropped := DoDragDrop(okEffects, data...); dropped && doneFunc != nil {
+			doneFunc(item, effect)
+		}
+	}
+	me.Parent().internalOn().addNfyNoRet(me.CtrlId(), co.TVN_BEGINDRAG, beginDrag)
+	me.Parent().internalOn().addNfyNoRet(me.CtrlId(), co.TVN_BEGINRDRAG, beginDrag)
+}
+
 func (me *_TreeView) Items() *_TreeViewItems {
 	return &me.items
 }
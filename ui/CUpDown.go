@@ -0,0 +1,344 @@
+//go:build windows
+
+package ui
+
+import (
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/ui/wm"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Native [up-down] control, also known as spin control.
+//
+// [up-down]: https://learn.microsoft.com/en-us/windows/win32/controls/up-down-controls
+type UpDown interface {
+	AnyNativeControl
+	implUpDown() // prevent public implementation
+
+	// Exposes all the [UpDown notifications] the can be handled.
+	//
+	// Panics if called after the control was created.
+	//
+	// [UpDown notifications]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-up-down-control-reference-notifications
+	On() *_UpDownEvents
+
+	Accel() []win.UDACCEL           // Retrieves the acceleration table.
+	Base() int                      // Retrieves the radix base, either 10 or 16.
+	Buddy() win.HWND                // Retrieves the current buddy window, if any.
+	Pos() int                       // Retrieves the current position.
+	Range() (min, max int)          // Retrieves the minimum and maximum positions.
+	SetAccel(accels ...win.UDACCEL) // Sets the acceleration table; the first entry defines the step.
+	SetBase(base int)               // Sets the radix base, either 10 (decimal) or 16 (hexadecimal).
+	SetBuddy(buddy AnyControl)      // Sets the buddy control, usually an Edit.
+	SetPos(pos int)                 // Sets the current position.
+	SetRange(min, max int)          // Sets the minimum and maximum positions. Default is 0-100.
+}
+
+//------------------------------------------------------------------------------
+
+type _UpDown struct {
+	_NativeControlBase
+	events _UpDownEvents
+}
+
+// Creates a new UpDown. Call ui.UpDownOpts() to define the options to be passed
+// to the underlying CreateWindowEx().
+//
+// If a buddy control is given, it must be constructed before the UpDown.
+//
+// # Example
+//
+//	var owner ui.AnyParent // initialized somewhere
+//
+//	myEdit := ui.NewEdit(
+//		owner,
+//		ui.EditOpts().
+//			Position(win.POINT{X: 10, Y: 20}).
+//			CtrlStyles(co.ES_NUMBER),
+//	)
+//	mySpin := ui.NewUpDown(
+//		owner,
+//		ui.UpDownOpts().
+//			Buddy(myEdit).
+//			Range(1, 10),
+//	)
+func NewUpDown(parent AnyParent, opts *_UpDownO) UpDown {
+	if opts == nil {
+		opts = UpDownOpts()
+	}
+	opts.lateDefaults()
+
+	me := &_UpDown{}
+	me._NativeControlBase.new(parent, opts.ctrlId)
+	me.events.new(&me._NativeControlBase)
+
+	parent.internalOn().addMsgNoRet(_CreateOrInitDialog(parent), func(_ wm.Any) {
+		_ConvertDtuOrMultiplyDpi(parent, &opts.position, &opts.size)
+
+		me._NativeControlBase.createWindow(opts.wndExStyles,
+			win.ClassNameStr("msctls_updown32"), win.StrOptNone(),
+			opts.wndStyles|co.WS(opts.ctrlStyles),
+			opts.position, opts.size, win.HMENU(opts.ctrlId))
+
+		if opts.buddy != nil {
+			me.SetBuddy(opts.buddy) // will reposition the control if aligned
+		}
+		parent.addResizingChild(me, opts.horz, opts.vert)
+
+		me.SetRange(opts.rangeMin, opts.rangeMax)
+		if opts.base != 10 {
+			me.SetBase(opts.base)
+		}
+		if len(opts.accels) > 0 {
+			me.SetAccel(opts.accels...)
+		}
+		me.SetPos(opts.pos)
+	})
+
+	return me
+}
+
+// Creates a new UpDown from a dialog resource.
+func NewUpDownDlg(
+	parent AnyParent, ctrlId int,
+	horz HORZ, vert VERT) UpDown {
+
+	me := &_UpDown{}
+	me._NativeControlBase.new(parent, ctrlId)
+	me.events.new(&me._NativeControlBase)
+
+	parent.internalOn().addMsgNoRet(co.WM_INITDIALOG, func(_ wm.Any) {
+		me._NativeControlBase.assignDlgItem()
+		parent.addResizingChild(me, horz, vert)
+	})
+
+	return me
+}
+
+// Implements UpDown.
+func (*_UpDown) implUpDown() {}
+
+func (me *_UpDown) On() *_UpDownEvents {
+	if me.Hwnd() != 0 {
+		panic("Cannot add event handling after the UpDown is created.")
+	}
+	return &me.events
+}
+
+func (me *_UpDown) Accel() []win.UDACCEL {
+	count := int(me.Hwnd().SendMessage(co.UDM_GETACCEL, 0, 0))
+	if count == 0 {
+		return []win.UDACCEL{}
+	}
+
+	accels := make([]win.UDACCEL, count)
+	me.Hwnd().SendMessage(co.UDM_GETACCEL,
+		win.WPARAM(count), win.LPARAM(unsafe.Pointer(&accels[0])))
+	return accels
+}
+
+func (me *_UpDown) Base() int {
+	return int(me.Hwnd().SendMessage(co.UDM_GETBASE, 0, 0))
+}
+
+func (me *_UpDown) Buddy() win.HWND {
+	return win.HWND(me.Hwnd().SendMessage(co.UDM_GETBUDDY, 0, 0))
+}
+
+func (me *_UpDown) Pos() int {
+	var bErr int32 // BOOL
+	pos := me.Hwnd().SendMessage(co.UDM_GETPOS32,
+		0, win.LPARAM(unsafe.Pointer(&bErr)))
+	return int(int32(pos))
+}
+
+func (me *_UpDown) Range() (min, max int) {
+	var min32, max32 int32
+	me.Hwnd().SendMessage(co.UDM_GETRANGE32,
+		win.WPARAM(unsafe.Pointer(&min32)), win.LPARAM(unsafe.Pointer(&max32)))
+	return int(min32), int(max32)
+}
+
+func (me *_UpDown) SetAccel(accels ...win.UDACCEL) {
+	if len(accels) == 0 {
+		panic("UpDown acceleration table cannot be empty.")
+	}
+	ret := me.Hwnd().SendMessage(co.UDM_SETACCEL,
+		win.WPARAM(len(accels)), win.LPARAM(unsafe.Pointer(&accels[0])))
+	if ret == 0 {
+		panic("UDM_SETACCEL failed.")
+	}
+}
+
+func (me *_UpDown) SetBase(base int) {
+	if base != 10 && base != 16 {
+		panic("UpDown base must be either 10 or 16.")
+	}
+	me.Hwnd().SendMessage(co.UDM_SETBASE, win.WPARAM(base), 0)
+}
+
+func (me *_UpDown) SetBuddy(buddy AnyControl) {
+	me.Hwnd().SendMessage(co.UDM_SETBUDDY, win.WPARAM(buddy.Hwnd()), 0)
+}
+
+func (me *_UpDown) SetPos(pos int) {
+	me.Hwnd().SendMessage(co.UDM_SETPOS32, 0, win.LPARAM(int32(pos)))
+}
+
+func (me *_UpDown) SetRange(min, max int) {
+	me.Hwnd().SendMessage(co.UDM_SETRANGE32,
+		win.WPARAM(int32(min)), win.LPARAM(int32(max)))
+}
+
+//------------------------------------------------------------------------------
+
+type _UpDownO struct {
+	ctrlId int
+
+	position    win.POINT
+	size        win.SIZE
+	horz        HORZ
+	vert        VERT
+	ctrlStyles  co.UDS
+	wndStyles   co.WS
+	wndExStyles co.WS_EX
+
+	buddy    AnyControl
+	rangeMin int
+	rangeMax int
+	pos      int
+	base     int
+	accels   []win.UDACCEL
+}
+
+// Control ID.
+//
+// Defaults to an auto-generated ID.
+func (o *_UpDownO) CtrlId(i int) *_UpDownO { o.ctrlId = i; return o }
+
+// Position within parent's client area.
+//
+// If parent is a dialog box, coordinates are in Dialog Template Units;
+// otherwise, they are in pixels and they will be adjusted to the current system
+// DPI.
+//
+// Ignored if a buddy is set with UDS_ALIGNLEFT or UDS_ALIGNRIGHT styles.
+//
+// Defaults to 0x0.
+func (o *_UpDownO) Position(p win.POINT) *_UpDownO { _OwPt(&o.position, p); return o }
+
+// Control size.
+//
+// If parent is a dialog box, coordinates are in Dialog Template Units;
+// otherwise, they are in pixels and they will be adjusted to the current system
+// DPI.
+//
+// Defaults to 18x23.
+func (o *_UpDownO) Size(s win.SIZE) *_UpDownO { _OwSz(&o.size, s); return o }
+
+// Horizontal behavior when the parent is resized.
+//
+// Defaults to HORZ_NONE.
+func (o *_UpDownO) Horz(s HORZ) *_UpDownO { o.horz = s; return o }
+
+// Vertical behavior when the parent is resized.
+//
+// Defaults to VERT_NONE.
+func (o *_UpDownO) Vert(s VERT) *_UpDownO { o.vert = s; return o }
+
+// UpDown control styles, passed to CreateWindowEx().
+//
+// Defaults to UDS_SETBUDDYINT | UDS_ALIGNRIGHT | UDS_ARROWKEYS | UDS_HOTTRACK.
+func (o *_UpDownO) CtrlStyles(s co.UDS) *_UpDownO { o.ctrlStyles = s; return o }
+
+// Window styles, passed to CreateWindowEx().
+//
+// Defaults to co.WS_CHILD | co.WS_VISIBLE.
+func (o *_UpDownO) WndStyles(s co.WS) *_UpDownO { o.wndStyles = s; return o }
+
+// Extended window styles, passed to CreateWindowEx().
+//
+// Defaults to WS_EX_NONE.
+func (o *_UpDownO) WndExStyles(s co.WS_EX) *_UpDownO { o.wndExStyles = s; return o }
+
+// Buddy control, usually an Edit, which must be constructed before the UpDown.
+//
+// Defaults to none.
+func (o *_UpDownO) Buddy(b AnyControl) *_UpDownO { o.buddy = b; return o }
+
+// Minimum and maximum positions.
+//
+// Defaults to 0-100.
+func (o *_UpDownO) Range(min, max int) *_UpDownO { o.rangeMin, o.rangeMax = min, max; return o }
+
+// Initial position.
+//
+// Defaults to 0.
+func (o *_UpDownO) Pos(p int) *_UpDownO { o.pos = p; return o }
+
+// Radix base, either 10 (decimal) or 16 (hexadecimal).
+//
+// Defaults to 10.
+func (o *_UpDownO) Base(b int) *_UpDownO { o.base = b; return o }
+
+// Acceleration table. The first entry, which usually has NSec zero, defines the
+// step of each click.
+//
+// Defaults to the system acceleration table, with step 1.
+func (o *_UpDownO) Accel(a ...win.UDACCEL) *_UpDownO { o.accels = a; return o }
+
+func (o *_UpDownO) lateDefaults() {
+	if o.ctrlId == 0 {
+		o.ctrlId = _NextCtrlId()
+	}
+}
+
+// Options for NewUpDown().
+func UpDownOpts() *_UpDownO {
+	return &_UpDownO{
+		size: win.SIZE{Cx: 18, Cy: 23},
+		horz: HORZ_NONE,
+		vert: VERT_NONE,
+		ctrlStyles: co.UDS_SETBUDDYINT | co.UDS_ALIGNRIGHT |
+			co.UDS_ARROWKEYS | co.UDS_HOTTRACK,
+		wndStyles: co.WS_CHILD | co.WS_VISIBLE,
+		rangeMax:  100,
+		base:      10,
+	}
+}
+
+//------------------------------------------------------------------------------
+
+// UpDown control notifications.
+type _UpDownEvents struct {
+	ctrlId int
+	events *_EventsWmNfy
+}
+
+func (me *_UpDownEvents) new(ctrl *_NativeControlBase) {
+	me.ctrlId = ctrl.CtrlId()
+	me.events = ctrl.Parent().On()
+}
+
+// [UDN_DELTAPOS] message handler.
+//
+// Return true to prevent the change in the control's position.
+//
+// [UDN_DELTAPOS]: https://learn.microsoft.com/en-us/windows/win32/controls/udn-deltapos
+func (me *_UpDownEvents) UdnDeltaPos(userFunc func(p *win.NMUPDOWN) bool) {
+	me.events.addNfyRet(me.ctrlId, co.UDN_DELTAPOS, func(p unsafe.Pointer) uintptr {
+		return util.BoolToUintptr(userFunc((*win.NMUPDOWN)(p)))
+	})
+}
+
+// [NM_RELEASEDCAPTURE] message handler.
+//
+// [NM_RELEASEDCAPTURE]: https://learn.microsoft.com/en-us/windows/win32/controls/nm-releasedcapture-up-down-
+func (me *_UpDownEvents) NmReleasedCapture(userFunc func()) {
+	me.events.addNfyZero(me.ctrlId, co.NM_RELEASEDCAPTURE, func(_ unsafe.Pointer) {
+		userFunc()
+	})
+}
@@ -0,0 +1,234 @@
+//go:build windows
+
+package ui
+
+import (
+	"strings"
+
+	"github.com/rodrigocfd/windigo/res"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Options for NewWindowMainDlgTemplate() and NewWindowModalDlgTemplate(),
+// which describe a DLGTEMPLATEEX dialog template built in memory, so the
+// dialog doesn't need to be compiled as a resource.
+//
+// Positions and sizes are in Dialog Template Units, which scale with the
+// dialog font.
+//
+// # Example
+//
+//	myModal := ui.NewWindowModalDlgTemplate(
+//		ui.DlgTemplateOpts().
+//			Title("Hello").
+//			Size(win.SIZE{Cx: 200, Cy: 80}).
+//			Items(
+//				ui.DlgItemOpts().
+//					ClassName("Edit").
+//					CtrlId(1001).
+//					Position(win.POINT{X: 10, Y: 10}).
+//					Size(win.SIZE{Cx: 180, Cy: 14}).
+//					WndStyles(co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP | co.WS_BORDER),
+//				ui.DlgItemOpts().
+//					ClassName("Button").
+//					CtrlId(int(co.ID_OK)).
+//					Text("&OK").
+//					Position(win.POINT{X: 140, Y: 58}).
+//					Size(win.SIZE{Cx: 50, Cy: 14}).
+//					WndStyles(co.WS_CHILD | co.WS_VISIBLE | co.WS_TABSTOP).
+//					CtrlStyles(co.WS(co.BS_DEFPUSHBUTTON)),
+//			),
+//	)
+func DlgTemplateOpts() *_DlgTemplateO {
+	return &_DlgTemplateO{
+		dlgStyles:   co.DS_SHELLFONT | co.DS_MODALFRAME | co.DS_CENTER,
+		wndStyles:   co.WS_POPUP | co.WS_CAPTION | co.WS_SYSMENU,
+		size:        win.SIZE{Cx: 200, Cy: 100},
+		fontName:    "MS Shell Dlg",
+		fontSize:    8,
+		fontWeight:  co.FW_NORMAL,
+		fontCharSet: co.CHARSET_DEFAULT,
+	}
+}
+
+type _DlgTemplateO struct {
+	dlgStyles   co.DS
+	wndStyles   co.WS
+	wndExStyles co.WS_EX
+	title       string
+	position    win.POINT
+	size        win.SIZE
+	fontName    string
+	fontSize    int
+	fontWeight  co.FW
+	fontItalic  bool
+	fontCharSet co.CHARSET
+	items       []*_DlgItemO
+}
+
+// Dialog styles.
+// Defaults to DS_SHELLFONT | DS_MODALFRAME | DS_CENTER. DS_SETFONT is always
+// added, since the template always carries a font.
+func (o *_DlgTemplateO) DlgStyles(s co.DS) *_DlgTemplateO { o.dlgStyles = s; return o }
+
+// Window styles.
+// Defaults to WS_POPUP | WS_CAPTION | WS_SYSMENU.
+func (o *_DlgTemplateO) WndStyles(s co.WS) *_DlgTemplateO { o.wndStyles = s; return o }
+
+// Extended window styles.
+// Defaults to none.
+func (o *_DlgTemplateO) WndExStyles(s co.WS_EX) *_DlgTemplateO { o.wndExStyles = s; return o }
+
+// The title of the dialog.
+// Defaults to empty string.
+func (o *_DlgTemplateO) Title(t string) *_DlgTemplateO { o.title = t; return o }
+
+// Position in Dialog Template Units. Ignored if DS_CENTER is set.
+// Defaults to 0x0.
+func (o *_DlgTemplateO) Position(p win.POINT) *_DlgTemplateO { _OwPt(&o.position, p); return o }
+
+// Size in Dialog Template Units.
+// Defaults to 200x100.
+func (o *_DlgTemplateO) Size(s win.SIZE) *_DlgTemplateO { _OwSz(&o.size, s); return o }
+
+// Font name and size in points, which define the Dialog Template Units.
+// Defaults to "MS Shell Dlg", 8.
+func (o *_DlgTemplateO) Font(name string, pointSize int) *_DlgTemplateO {
+	o.fontName = name
+	o.fontSize = pointSize
+	return o
+}
+
+// Font weight, italic and character set.
+// Defaults to FW_NORMAL, not italic, and CHARSET_DEFAULT.
+func (o *_DlgTemplateO) FontAttrs(weight co.FW, italic bool, charSet co.CHARSET) *_DlgTemplateO {
+	o.fontWeight = weight
+	o.fontItalic = italic
+	o.fontCharSet = charSet
+	return o
+}
+
+// Appends child controls, in tab order.
+// Defaults to none.
+func (o *_DlgTemplateO) Items(items ...*_DlgItemO) *_DlgTemplateO {
+	o.items = append(o.items, items...)
+	return o
+}
+
+// Serializes the template as DLGTEMPLATEEX, which can be passed to functions
+// like CreateDialogIndirectParam, or stored as an RT_DIALOG resource.
+func (o *_DlgTemplateO) Bytes() []byte {
+	dlg := res.Dialog{
+		ExStyle: uint32(o.wndExStyles),
+		Style:   uint32(o.wndStyles) | uint32(o.dlgStyles|co.DS_SETFONT),
+		X:       int16(o.position.X),
+		Y:       int16(o.position.Y),
+		Cx:      int16(o.size.Cx),
+		Cy:      int16(o.size.Cy),
+		Title:   o.title,
+		Font: res.DialogFont{
+			PointSize: uint16(o.fontSize),
+			Weight:    uint16(o.fontWeight),
+			Italic:    o.fontItalic,
+			CharSet:   uint8(o.fontCharSet),
+			Typeface:  o.fontName,
+		},
+		Items: make([]res.DialogItem, 0, len(o.items)),
+	}
+	for _, item := range o.items {
+		dlg.Items = append(dlg.Items, item.dialogItem())
+	}
+	return dlg.Bytes()
+}
+
+//------------------------------------------------------------------------------
+
+// Options of a child control of a dialog template, to be passed to
+// DlgTemplateOpts().Items().
+func DlgItemOpts() *_DlgItemO {
+	return &_DlgItemO{
+		wndStyles: co.WS_CHILD | co.WS_VISIBLE,
+	}
+}
+
+type _DlgItemO struct {
+	className   string
+	ctrlId      int
+	text        string
+	position    win.POINT
+	size        win.SIZE
+	ctrlStyles  co.WS
+	wndStyles   co.WS
+	wndExStyles co.WS_EX
+}
+
+// Window class name of the control, like "Button" or "SysListView32". The
+// predefined classes Button, Edit, Static, ListBox, ScrollBar and ComboBox are
+// stored as ordinals.
+// Must be informed.
+func (o *_DlgItemO) ClassName(n string) *_DlgItemO { o.className = n; return o }
+
+// Control ID, used to retrieve the control with functions like NewButtonDlg().
+// Defaults to zero.
+func (o *_DlgItemO) CtrlId(i int) *_DlgItemO { o.ctrlId = i; return o }
+
+// Text of the control.
+// Defaults to empty string.
+func (o *_DlgItemO) Text(t string) *_DlgItemO { o.text = t; return o }
+
+// Position in Dialog Template Units.
+// Defaults to 0x0.
+func (o *_DlgItemO) Position(p win.POINT) *_DlgItemO { _OwPt(&o.position, p); return o }
+
+// Size in Dialog Template Units.
+// Defaults to 0x0.
+func (o *_DlgItemO) Size(s win.SIZE) *_DlgItemO { _OwSz(&o.size, s); return o }
+
+// Control-specific styles, like BS or ES, converted to WS. They are combined
+// with the window styles.
+// Defaults to none.
+func (o *_DlgItemO) CtrlStyles(s co.WS) *_DlgItemO { o.ctrlStyles = s; return o }
+
+// Window styles.
+// Defaults to WS_CHILD | WS_VISIBLE.
+func (o *_DlgItemO) WndStyles(s co.WS) *_DlgItemO { o.wndStyles = s; return o }
+
+// Extended window styles.
+// Defaults to none.
+func (o *_DlgItemO) WndExStyles(s co.WS_EX) *_DlgItemO { o.wndExStyles = s; return o }
+
+func (o *_DlgItemO) dialogItem() res.DialogItem {
+	if o.className == "" {
+		panic("Dialog template item without class name.")
+	}
+
+	return res.DialogItem{
+		ExStyle: uint32(o.wndExStyles),
+		Style:   uint32(o.wndStyles | o.ctrlStyles),
+		X:       int16(o.position.X),
+		Y:       int16(o.position.Y),
+		Cx:      int16(o.size.Cx),
+		Cy:      int16(o.size.Cy),
+		Id:      uint32(o.ctrlId),
+		Class:   _DlgItemClass(o.className),
+		Title:   res.IdStr(o.text),
+	}
+}
+
+// Predefined window classes, stored as ordinals in dialog templates.
+var _dlgItemClasses = map[string]uint16{
+	"BUTTON":    res.CLASS_BUTTON,
+	"EDIT":      res.CLASS_EDIT,
+	"STATIC":    res.CLASS_STATIC,
+	"LISTBOX":   res.CLASS_LISTBOX,
+	"SCROLLBAR": res.CLASS_SCROLLBAR,
+	"COMBOBOX":  res.CLASS_COMBOBOX,
+}
+
+func _DlgItemClass(className string) res.Id {
+	if ordinal, ok := _dlgItemClasses[strings.ToUpper(className)]; ok {
+		return res.IdInt(ordinal)
+	}
+	return res.IdStr(className)
+}
@@ -0,0 +1,301 @@
+//go:build windows
+
+package ui
+
+import (
+	"github.com/rodrigocfd/windigo/ui/wm"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/shell"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Information about a drag and drop operation over a window, passed to the
+// DragDrop event handlers.
+type DragDropInfo struct {
+	dataObj shell.IDataObject
+
+	KeyState co.MK              // State of the keyboard modifiers and mouse buttons.
+	Pos      win.POINT          // Mouse position, in client coordinates of the window.
+	Allowed  shellco.DROPEFFECT // Effects allowed by the drag source.
+	Effect   shellco.DROPEFFECT // Effect to be performed; set to DROPEFFECT_NONE to refuse the drop.
+}
+
+// Returns the underlying data object being dragged, which is owned by the
+// system; don't release it.
+func (p *DragDropInfo) DataObject() shell.IDataObject {
+	return p.dataObj
+}
+
+// Retrieves the data of the given clipboard format, if available. For
+// registered formats, call win.RegisterClipboardFormat() to retrieve the format
+// ID.
+func (p *DragDropInfo) Data(format co.CF) ([]byte, bool) {
+	return p.dataObj.GetHGlobalData(format)
+}
+
+// Retrieves the file paths being dragged, if any.
+func (p *DragDropInfo) Files() ([]string, bool) {
+	return p.dataObj.ListFiles()
+}
+
+// Tells whether the given clipboard format is available in global memory.
+func (p *DragDropInfo) HasFormat(format co.CF) bool {
+	fe := shell.FormatEtcHGlobal(format)
+	return p.dataObj.QueryGetData(&fe)
+}
+
+// Retrieves the text being dragged, if any.
+func (p *DragDropInfo) Text() (string, bool) {
+	return p.dataObj.GetText()
+}
+
+//------------------------------------------------------------------------------
+
+// Drag and drop events of a window or a control, which is registered as an OLE
+// drop target when created. Unlike WM_DROPFILES, it gives feedback while the
+// mouse moves, and accepts any data format.
+//
+// Unless changed by the handlers, the effect is chosen by the modifier keys,
+// among the effects allowed by the drag source.
+//
+// ⚠️ OLE must be initialized in the UI thread: call com.OleInitialize() and
+// defer com.OleUninitialize() before RunAsMain().
+type _DragDrop struct {
+	wnd     AnyWindow
+	enabled bool
+	target  shell.IDropTarget
+	dataObj shell.IDataObject // object being dragged, between enter and leave/drop
+	refused bool              // DragEnter handler refused the data
+
+	dragEnter func(p *DragDropInfo)
+	dragLeave func()
+	dragOver  func(p *DragDropInfo)
+	drop      func(p *DragDropInfo)
+}
+
+// Hooks the registration of the window as a drop target in its creation, and
+// the revocation in the destruction of the given parent.
+func (me *_DragDrop) enable(wnd AnyWindow, parentEvents *_EventsInternal) {
+	if me.enabled {
+		return
+	}
+	me.wnd = wnd
+	me.enabled = true
+
+	register := func(_ wm.Any) {
+		if me.target != nil || me.wnd.Hwnd() == 0 {
+			return
+		}
+		me.target = shell.NewIDropTargetImpl(&_DragDropTarget{me})
+		shell.RegisterDragDrop(me.wnd.Hwnd(), me.target)
+	}
+	parentEvents.addMsgNoRet(co.WM_CREATE, register)
+	parentEvents.addMsgNoRet(co.WM_INITDIALOG, register)
+
+	parentEvents.addMsgNoRet(co.WM_DESTROY, func(_ wm.Any) {
+		if me.target != nil {
+			shell.RevokeDragDrop(me.wnd.Hwnd())
+			me.target.Release()
+			me.target = nil
+		}
+	})
+}
+
+func (me *_DragDrop) releaseData() {
+	if me.dataObj != nil {
+		me.dataObj.Release()
+		me.dataObj = nil
+	}
+}
+
+func (me *_DragDrop) newInfo(
+	keyState co.MK, pt win.POINT, allowed shellco.DROPEFFECT) *DragDropInfo {
+
+	me.wnd.Hwnd().ScreenToClientPt(&pt)
+	p := &DragDropInfo{
+		dataObj:  me.dataObj,
+		KeyState: keyState,
+		Pos:      pt,
+		Allowed:  allowed,
+		Effect:   shellco.DROPEFFECT_NONE,
+	}
+	if !me.refused {
+		p.Effect = _DefaultDropEffect(keyState, allowed)
+	}
+	return p
+}
+
+// [IDropTarget.DragEnter] handler. The data can be retrieved with the
+// DragDropInfo methods.
+//
+// [IDropTarget.DragEnter]: https://learn.microsoft.com/en-us/windows/win32/api/oleidl/nf-oleidl-idroptarget-dragenter
+func (me *_DragDrop) OnDragEnter(userFunc func(p *DragDropInfo)) {
+	me.dragEnter = userFunc
+}
+
+// [IDropTarget.DragLeave] handler.
+//
+// [IDropTarget.DragLeave]: https://learn.microsoft.com/en-us/windows/win32/api/oleidl/nf-oleidl-idroptarget-dragleave
+func (me *_DragDrop) OnDragLeave(userFunc func()) {
+	me.dragLeave = userFunc
+}
+
+// [IDropTarget.DragOver] handler.
+//
+// If the data was refused in OnDragEnter(), the effect starts as
+// DROPEFFECT_NONE.
+//
+// [IDropTarget.DragOver]: https://learn.microsoft.com/en-us/windows/win32/api/oleidl/nf-oleidl-idroptarget-dragover
+func (me *_DragDrop) OnDragOver(userFunc func(p *DragDropInfo)) {
+	me.dragOver = userFunc
+}
+
+// [IDropTarget.Drop] handler, where the dropped data is retrieved.
+//
+// # Example
+//
+//	var wnd ui.WindowMain // initialized somewhere
+//
+//	wnd.DragDrop().OnDrop(func(p *ui.DragDropInfo) {
+//		if files, ok := p.Files(); ok {
+//			for _, file := range files {
+//				println(file)
+//			}
+//		}
+//	})
+//
+// [IDropTarget.Drop]: https://learn.microsoft.com/en-us/windows/win32/api/oleidl/nf-oleidl-idroptarget-drop
+func (me *_DragDrop) OnDrop(userFunc func(p *DragDropInfo)) {
+	me.drop = userFunc
+}
+
+// Implements shell.IDropTargetImpl, forwarding the calls to _DragDrop.
+type _DragDropTarget struct {
+	dd *_DragDrop
+}
+
+func (me *_DragDropTarget) DragEnter(
+	dataObj shell.IDataObject, keyState co.MK,
+	pt win.POINT, effect *shellco.DROPEFFECT) {
+
+	me.dd.releaseData()
+	me.dd.dataObj = shell.NewIDataObject(dataObj.AddRef()) // keep it until leave/drop
+	me.dd.refused = false
+
+	p := me.dd.newInfo(keyState, pt, *effect)
+	if me.dd.dragEnter != nil {
+		me.dd.dragEnter(p)
+	}
+	*effect = p.Effect & p.Allowed
+	me.dd.refused = *effect == shellco.DROPEFFECT_NONE
+}
+
+func (me *_DragDropTarget) DragLeave() {
+	if me.dd.dragLeave != nil {
+		me.dd.dragLeave()
+	}
+	me.dd.releaseData()
+}
+
+func (me *_DragDropTarget) DragOver(
+	keyState co.MK, pt win.POINT, effect *shellco.DROPEFFECT) {
+
+	p := me.dd.newInfo(keyState, pt, *effect)
+	if me.dd.dragOver != nil {
+		me.dd.dragOver(p)
+	}
+	*effect = p.Effect & p.Allowed
+}
+
+func (me *_DragDropTarget) Drop(
+	_ shell.IDataObject, keyState co.MK,
+	pt win.POINT, effect *shellco.DROPEFFECT) {
+
+	defer me.dd.releaseData()
+
+	p := me.dd.newInfo(keyState, pt, *effect)
+	if me.dd.drop != nil {
+		me.dd.drop(p)
+	}
+	*effect = p.Effect & p.Allowed
+}
+
+// Chooses the effect according to the modifier keys, following the shell
+// conventions, among the allowed effects.
+func _DefaultDropEffect(
+	keyState co.MK, allowed shellco.DROPEFFECT) shellco.DROPEFFECT {
+
+	var wanted shellco.DROPEFFECT
+	switch {
+	case (keyState&co.MK_CONTROL) != 0 && (keyState&co.MK_SHIFT) != 0:
+		wanted = shellco.DROPEFFECT_LINK
+	case (keyState & co.MK_SHIFT) != 0:
+		wanted = shellco.DROPEFFECT_MOVE
+	default:
+		wanted = shellco.DROPEFFECT_COPY
+	}
+	if (allowed & wanted) != 0 {
+		return wanted
+	}
+
+	for _, effect := range []shellco.DROPEFFECT{
+		shellco.DROPEFFECT_COPY, shellco.DROPEFFECT_MOVE, shellco.DROPEFFECT_LINK,
+	} {
+		if (allowed & effect) != 0 {
+			return effect
+		}
+	}
+	return shellco.DROPEFFECT_NONE
+}
+
+//------------------------------------------------------------------------------
+
+// Performs an OLE drag and drop operation with the given data, blocking until
+// the mouse button is released. Should be called in response to a mouse button
+// down or a begin-drag notification.
+//
+// Returns the effect performed by the drop target, and false if the operation
+// was cancelled.
+//
+// ⚠️ OLE must be initialized in the UI thread: call com.OleInitialize() and
+// defer com.OleUninitialize() before RunAsMain().
+//
+// # Example
+//
+//	var myList ui.ListView // initialized somewhere
+//
+//	myList.On().LvnBeginDrag(func(_ *win.NMLISTVIEW) {
+//		ui.DoDragDrop(shellco.DROPEFFECT_COPY, shell.DataItemText("Hello"))
+//	})
+func DoDragDrop(
+	okEffects shellco.DROPEFFECT,
+	data ...shell.DataItem) (shellco.DROPEFFECT, bool) {
+
+	dataObj := shell.NewIDataObjectImpl(data...)
+	defer dataObj.Release()
+
+	dropSource := shell.NewIDropSourceImpl(&_DragDropSource{})
+	defer dropSource.Release()
+
+	return shell.DoDragDrop(dataObj, dropSource, okEffects)
+}
+
+// Implements shell.IDropSourceImpl with the default behavior.
+type _DragDropSource struct{}
+
+func (*_DragDropSource) GiveFeedback(_ shellco.DROPEFFECT) errco.ERROR {
+	return errco.DRAGDROP_S_USEDEFAULTCURSORS
+}
+
+func (*_DragDropSource) QueryContinueDrag(
+	escapePressed bool, keyState co.MK) errco.ERROR {
+
+	if escapePressed {
+		return errco.DRAGDROP_S_CANCEL
+	} else if (keyState & (co.MK_LBUTTON | co.MK_RBUTTON)) == 0 {
+		return errco.DRAGDROP_S_DROP
+	}
+	return errco.S_OK
+}
@@ -77,6 +77,16 @@ func (me *_EventsWmNfy) addNfyZero(idFrom int, code co.NM, userFunc func(p unsaf
 	}] = userFunc
 }
 
+// Removes the WM_NOTIFY event, with or without meaningful return value.
+func (me *_EventsWmNfy) removeNfy(idFrom int, code co.NM) {
+	hash := _HashNfy{
+		idFrom: idFrom,
+		code:   code,
+	}
+	delete(me.nfysRet, hash)
+	delete(me.nfysNoRet, hash)
+}
+
 func (me *_EventsWmNfy) processMessage(
 	uMsg co.WM,
 	wParam win.WPARAM,
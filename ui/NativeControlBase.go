@@ -19,6 +19,7 @@ type _NativeControlBase struct {
 	eventsSubcl  _EventsWm // subclass events
 	subclassId   uint32
 	subclassProc uintptr // necessary to circumvent InvalidInitCycle error
+	dragDrop     _DragDrop
 }
 
 func (me *_NativeControlBase) new(parent AnyParent, ctrlId int) {
@@ -44,6 +45,15 @@ func (me *_NativeControlBase) Parent() AnyParent {
 	return me.parent
 }
 
+// Implements AnyNativeControl.
+func (me *_NativeControlBase) DragDrop() *_DragDrop {
+	if me.hWnd != 0 {
+		panic("Cannot enable drag and drop after the control is created.")
+	}
+	me.dragDrop.enable(me, me.parent.internalOn())
+	return &me.dragDrop
+}
+
 // Implements AnyNativeControl.
 func (me *_NativeControlBase) OnSubclass() *_EventsWm {
 	if me.Hwnd() != 0 {
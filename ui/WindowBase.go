@@ -23,6 +23,7 @@ type _WindowBase struct {
 	internalEvents  _EventsInternal // Events added internally by the library.
 	events          _EventsWmNfy    // Ordinary window events, added by user.
 	resizerChildren _ResizerChildren
+	dragDrop        _DragDrop
 }
 
 func (me *_WindowBase) new() {
@@ -39,6 +40,15 @@ func (me *_WindowBase) Hwnd() win.HWND {
 	return me.hWnd
 }
 
+// Implements AnyParent.
+func (me *_WindowBase) DragDrop() *_DragDrop {
+	if me.hWnd != 0 {
+		panic("Cannot enable drag and drop after the window is created.")
+	}
+	me.dragDrop.enable(me, &me.internalEvents)
+	return &me.dragDrop
+}
+
 // Implements AnyParent.
 func (me *_WindowBase) On() *_EventsWmNfy {
 	if me.hWnd != 0 {
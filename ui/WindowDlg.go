@@ -15,6 +15,7 @@ import (
 type _WindowDlg struct {
 	_WindowBase
 	dialogId int
+	template []byte // DLGTEMPLATEEX built in memory, used instead of dialogId
 }
 
 func (me *_WindowDlg) new(dialogId int) {
@@ -22,7 +23,13 @@ func (me *_WindowDlg) new(dialogId int) {
 	me.dialogId = dialogId
 }
 
-// Calls CreateDialogParam().
+func (me *_WindowDlg) newFromTemplate(template *_DlgTemplateO) {
+	me._WindowBase.new()
+	me.template = template.Bytes()
+}
+
+// Calls CreateDialogParam(), or CreateDialogIndirectParam() if built from a
+// template.
 func (me *_WindowDlg) createDialog(hParent win.HWND, hInst win.HINSTANCE) {
 	if me.Hwnd() != 0 {
 		panic(fmt.Sprintf("Dialog already created: %d.", me.dialogId))
@@ -31,11 +38,16 @@ func (me *_WindowDlg) createDialog(hParent win.HWND, hInst win.HINSTANCE) {
 	_globalWindowDlgPtrs[me] = struct{}{} // store pointer in the set
 
 	// The hwnd member is saved in WM_INITDIALOG processing in dlgProc.
-	hInst.CreateDialogParam(win.ResIdInt(me.dialogId), hParent,
-		_globalDlgProc, win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	if me.template != nil {
+		hInst.CreateDialogIndirectParam(me.templatePtr(), hParent,
+			_globalDlgProc, win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	} else {
+		hInst.CreateDialogParam(win.ResIdInt(me.dialogId), hParent,
+			_globalDlgProc, win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	}
 }
 
-// Calls DialogBoxParam().
+// Calls DialogBoxParam(), or DialogBoxIndirectParam() if built from a template.
 func (me *_WindowDlg) dialogBox(hParent win.HWND, hInst win.HINSTANCE) {
 	if me.Hwnd() != 0 {
 		panic(fmt.Sprintf("Dialog already created: %d.", me.dialogId))
@@ -44,8 +56,19 @@ func (me *_WindowDlg) dialogBox(hParent win.HWND, hInst win.HINSTANCE) {
 	_globalWindowDlgPtrs[me] = struct{}{} // store pointer in the set
 
 	// The hwnd member is saved in WM_INITDIALOG processing in dlgProc.
-	hInst.DialogBoxParam(win.ResIdInt(me.dialogId), hParent,
-		_globalDlgProc, win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	if me.template != nil {
+		hInst.DialogBoxIndirectParam(me.templatePtr(), hParent,
+			_globalDlgProc, win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	} else {
+		hInst.DialogBoxParam(win.ResIdInt(me.dialogId), hParent,
+			_globalDlgProc, win.LPARAM(unsafe.Pointer(me))) // pass pointer to object itself
+	}
+}
+
+// The template buffer starts with DLGTEMPLATEEX, which is passed through the
+// DLGTEMPLATE pointer of the indirect functions.
+func (me *_WindowDlg) templatePtr() *win.DLGTEMPLATE {
+	return (*win.DLGTEMPLATE)(unsafe.Pointer(&me.template[0]))
 }
 
 var (
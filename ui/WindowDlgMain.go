@@ -28,6 +28,29 @@ func NewWindowMainDlg(dialogId, iconId, accelTableId int) WindowMain {
 	return me
 }
 
+// Creates a new WindowMain from a dialog template built in memory. Call
+// DlgTemplateOpts() to define the template.
+//
+// Parameters iconId and accelTableId are optional.
+//
+// # Example
+//
+//	myWindow := ui.NewWindowMainDlgTemplate(
+//		ui.DlgTemplateOpts().
+//			Title("Hello world").
+//			WndStyles(co.WS_CAPTION | co.WS_SYSMENU | co.WS_MINIMIZEBOX),
+//		0, 0,
+//	)
+func NewWindowMainDlgTemplate(template *_DlgTemplateO, iconId, accelTableId int) WindowMain {
+	me := &_WindowDlgMain{}
+	me._WindowDlg.newFromTemplate(template)
+	me.iconId = iconId
+	me.accelTableId = accelTableId
+
+	me.defaultMessages()
+	return me
+}
+
 // Implements WindowMain.
 func (me *_WindowDlgMain) RunAsMain() int {
 	_FirstMainStuff()
@@ -20,6 +20,16 @@ func NewWindowModalDlg(dialogId int) WindowModal {
 	return me
 }
 
+// Creates a new WindowModal from a dialog template built in memory. Call
+// DlgTemplateOpts() to define the template.
+func NewWindowModalDlgTemplate(template *_DlgTemplateO) WindowModal {
+	me := &_WindowDlgModal{}
+	me._WindowDlg.newFromTemplate(template)
+
+	me.defaultMessages()
+	return me
+}
+
 // Implements WindowModal.
 func (me *_WindowDlgModal) ShowModal(parent AnyParent) {
 	me._WindowDlg.dialogBox(parent.Hwnd(), parent.Hwnd().Hinstance())
@@ -21,6 +21,14 @@ type AnyParent interface {
 	addResizingChild(ctrl AnyControl, horz HORZ, vert VERT)
 	isDialog() bool
 
+	// Registers the window as an OLE drop target when it's created, exposing
+	// the drag and drop events.
+	//
+	// Panics if called after the window was created.
+	//
+	// ⚠️ OLE must be initialized in the UI thread, see com.OleInitialize().
+	DragDrop() *_DragDrop
+
 	// Exposes all the window notifications the can be handled.
 	//
 	// Cannot be called after the window was created.
@@ -48,6 +56,14 @@ type AnyControl interface {
 type AnyNativeControl interface {
 	AnyControl
 
+	// Registers the control as an OLE drop target when it's created, exposing
+	// the drag and drop events.
+	//
+	// Panics if called after the control was created.
+	//
+	// ⚠️ OLE must be initialized in the UI thread, see com.OleInitialize().
+	DragDrop() *_DragDrop
+
 	// Exposes all the window messages that can be handled with subclassing.
 	//
 	// Warning: Subclassing is a potentially slow technique, try to use the
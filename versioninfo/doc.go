@@ -0,0 +1,174 @@
+package versioninfo
+
+import (
+	"strings"
+)
+
+// A [VS_VERSIONINFO] resource, which is stamped into executables and DLLs, and
+// shown in the Details tab of the file properties in Windows Explorer.
+//
+// # Example
+//
+//	vi := versioninfo.VersionInfo{
+//		Fixed: versioninfo.FixedFileInfo{
+//			FileVersion:    [4]uint16{1, 2, 0, 0},
+//			ProductVersion: [4]uint16{1, 2, 0, 0},
+//			FileOS:         versioninfo.OS_NT_WINDOWS32,
+//			FileType:       versioninfo.FT_APP,
+//		},
+//	}
+//	st := vi.AddStringTable(versioninfo.LANGID_EN_US, versioninfo.CP_UNICODE)
+//	st.Set("CompanyName", "Acme")
+//	st.Set("FileVersion", "1.2.0.0")
+//	data := vi.Bytes()
+//
+// [VS_VERSIONINFO]: https://learn.microsoft.com/en-us/windows/win32/menurc/vs-versioninfo
+type VersionInfo struct {
+	Fixed        FixedFileInfo // Binary version information.
+	StringTables []StringTable // Strings of the StringFileInfo block, one table per language and code page.
+	Translations []Translation // Languages and code pages of the VarFileInfo\Translation value.
+}
+
+// The [VS_FIXEDFILEINFO] of a VersionInfo.
+//
+// [VS_FIXEDFILEINFO]: https://learn.microsoft.com/en-us/windows/win32/api/verrsrc/ns-verrsrc-vs_fixedfileinfo
+type FixedFileInfo struct {
+	FileVersion    [4]uint16 // Major, minor, patch and build.
+	ProductVersion [4]uint16 // Major, minor, patch and build.
+	FileFlagsMask  FF        // Which bits of FileFlags are valid.
+	FileFlags      FF
+	FileOS         OS
+	FileType       FT
+	FileSubtype    uint32 // Depends on FileType, like the VFT2 constants.
+	FileDate       uint64
+}
+
+// A StringTable block of a VersionInfo, with the strings for one language and
+// code page.
+type StringTable struct {
+	LangId   uint16
+	CodePage uint16
+	Strings  []String // Strings in file order.
+}
+
+// A string of a StringTable.
+type String struct {
+	Key   string // Name of the string, like CompanyName or ProductVersion.
+	Value string
+}
+
+// A language and code page pair, which tells the StringTable to be used.
+type Translation struct {
+	LangId   uint16
+	CodePage uint16
+}
+
+const (
+	LANGID_NEUTRAL uint16 = 0x0000 // Language neutral.
+	LANGID_EN_US   uint16 = 0x0409 // English (United States).
+	CP_ANSI        uint16 = 1252   // Windows Latin-1 code page.
+	CP_UNICODE     uint16 = 1200   // Unicode code page, used by most version resources.
+)
+
+// [VS_FIXEDFILEINFO] file flags.
+//
+// [VS_FIXEDFILEINFO]: https://learn.microsoft.com/en-us/windows/win32/api/verrsrc/ns-verrsrc-vs_fixedfileinfo
+type FF uint32
+
+const (
+	FF_DEBUG        FF = 0x0000_0001
+	FF_PRERELEASE   FF = 0x0000_0002
+	FF_PATCHED      FF = 0x0000_0004
+	FF_PRIVATEBUILD FF = 0x0000_0008
+	FF_INFOINFERRED FF = 0x0000_0010
+	FF_SPECIALBUILD FF = 0x0000_0020
+	FF_MASK         FF = 0x0000_003f
+)
+
+// [VS_FIXEDFILEINFO] operating system.
+//
+// [VS_FIXEDFILEINFO]: https://learn.microsoft.com/en-us/windows/win32/api/verrsrc/ns-verrsrc-vs_fixedfileinfo
+type OS uint32
+
+const (
+	OS_UNKNOWN       OS = 0x0000_0000
+	OS_NT            OS = 0x0004_0000
+	OS_WINDOWS32     OS = 0x0000_0004
+	OS_NT_WINDOWS32  OS = 0x0004_0004
+	OS_DOS_WINDOWS32 OS = 0x0001_0004
+)
+
+// [VS_FIXEDFILEINFO] file type.
+//
+// [VS_FIXEDFILEINFO]: https://learn.microsoft.com/en-us/windows/win32/api/verrsrc/ns-verrsrc-vs_fixedfileinfo
+type FT uint32
+
+const (
+	FT_UNKNOWN    FT = 0x0000_0000
+	FT_APP        FT = 0x0000_0001
+	FT_DLL        FT = 0x0000_0002
+	FT_DRV        FT = 0x0000_0003
+	FT_FONT       FT = 0x0000_0004
+	FT_VXD        FT = 0x0000_0005
+	FT_STATIC_LIB FT = 0x0000_0007
+)
+
+// Returns the StringTable with the given language and code page, if any.
+func (vi *VersionInfo) StringTable(langId, codePage uint16) (*StringTable, bool) {
+	for i := range vi.StringTables {
+		st := &vi.StringTables[i]
+		if st.LangId == langId && st.CodePage == codePage {
+			return st, true
+		}
+	}
+	return nil, false
+}
+
+// Returns the StringTable with the given language and code page, appending a
+// new one if it doesn't exist. The pair is also appended to the Translations,
+// if not there yet.
+//
+// Note that the returned pointer is invalidated when another table is added.
+func (vi *VersionInfo) AddStringTable(langId, codePage uint16) *StringTable {
+	hasTranslation := false
+	for _, tr := range vi.Translations {
+		if tr.LangId == langId && tr.CodePage == codePage {
+			hasTranslation = true
+			break
+		}
+	}
+	if !hasTranslation {
+		vi.Translations = append(vi.Translations, Translation{langId, codePage})
+	}
+
+	if st, ok := vi.StringTable(langId, codePage); ok {
+		return st
+	}
+	vi.StringTables = append(vi.StringTables, StringTable{LangId: langId, CodePage: codePage})
+	return &vi.StringTables[len(vi.StringTables)-1]
+}
+
+// Returns the value of the string with the given key, if any. Keys are
+// case-insensitive, like in the Windows [VerQueryValue] function.
+//
+// [VerQueryValue]: https://learn.microsoft.com/en-us/windows/win32/api/winver/nf-winver-verqueryvaluew
+func (st *StringTable) Get(key string) (string, bool) {
+	for _, s := range st.Strings {
+		if strings.EqualFold(s.Key, key) {
+			return s.Value, true
+		}
+	}
+	return "", false
+}
+
+// Sets the value of the string with the given key, appending a new string if
+// it doesn't exist.
+func (st *StringTable) Set(key, value string) {
+	for i := range st.Strings {
+		if strings.EqualFold(st.Strings[i].Key, key) {
+			st.Strings[i].Value = value
+			return
+		}
+	}
+	st.Strings = append(st.Strings, String{key, value})
+}
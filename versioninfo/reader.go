@@ -0,0 +1,172 @@
+package versioninfo
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"unicode/utf16"
+)
+
+// Reads all the VS_VERSIONINFO content from r and parses it.
+func Read(r io.Reader) (*VersionInfo, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(src)
+}
+
+// Parses a VS_VERSIONINFO block, as stored in an RT_VERSION resource or
+// returned by the Windows [GetFileVersionInfo] function. Any bytes after the
+// block are ignored.
+//
+// [GetFileVersionInfo]: https://learn.microsoft.com/en-us/windows/win32/api/winver/nf-winver-getfileversioninfow
+func Parse(src []byte) (*VersionInfo, error) {
+	root, _, err := parseNode(src)
+	if err != nil {
+		return nil, err
+	}
+	if root.key != _KEY_ROOT {
+		return nil, errors.New("not a VS_VERSIONINFO block")
+	}
+
+	vi := &VersionInfo{}
+	if len(root.value) > 0 { // the fixed info is optional
+		if err := vi.Fixed.parse(root.value); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, child := range root.children {
+		switch child.key {
+		case _KEY_STRINGS:
+			for _, table := range child.children {
+				st, err := parseStringTable(&table)
+				if err != nil {
+					return nil, err
+				}
+				vi.StringTables = append(vi.StringTables, st)
+			}
+		case _KEY_VARS:
+			for _, v := range child.children {
+				if v.key != _KEY_TRANSLATION {
+					continue
+				}
+				for i := 0; i+4 <= len(v.value); i += 4 {
+					vi.Translations = append(vi.Translations, Translation{
+						LangId:   binary.LittleEndian.Uint16(v.value[i:]),
+						CodePage: binary.LittleEndian.Uint16(v.value[i+2:]),
+					})
+				}
+			}
+		}
+	}
+	return vi, nil
+}
+
+// Parses the VS_FIXEDFILEINFO struct.
+func (ffi *FixedFileInfo) parse(src []byte) error {
+	if len(src) < _FIXED_SIZE {
+		return fmt.Errorf("VS_FIXEDFILEINFO has %d bytes, expected %d", len(src), _FIXED_SIZE)
+	}
+	var dw [13]uint32
+	for i := range dw {
+		dw[i] = binary.LittleEndian.Uint32(src[i*4:])
+	}
+	if dw[0] != _SIGNATURE {
+		return fmt.Errorf("invalid VS_FIXEDFILEINFO signature %#08x", dw[0])
+	}
+
+	ffi.FileVersion = [4]uint16{uint16(dw[2] >> 16), uint16(dw[2]), uint16(dw[3] >> 16), uint16(dw[3])}
+	ffi.ProductVersion = [4]uint16{uint16(dw[4] >> 16), uint16(dw[4]), uint16(dw[5] >> 16), uint16(dw[5])}
+	ffi.FileFlagsMask = FF(dw[6])
+	ffi.FileFlags = FF(dw[7])
+	ffi.FileOS = OS(dw[8])
+	ffi.FileType = FT(dw[9])
+	ffi.FileSubtype = dw[10]
+	ffi.FileDate = uint64(dw[11])<<32 | uint64(dw[12])
+	return nil
+}
+
+func parseStringTable(table *_Node) (StringTable, error) {
+	langCp, err := strconv.ParseUint(table.key, 16, 32)
+	if err != nil || len(table.key) != 8 {
+		return StringTable{}, fmt.Errorf("invalid StringTable key %q", table.key)
+	}
+
+	st := StringTable{
+		LangId:   uint16(langCp >> 16),
+		CodePage: uint16(langCp),
+		Strings:  make([]String, 0, len(table.children)),
+	}
+	for _, s := range table.children {
+		st.Strings = append(st.Strings, String{s.key, decodeSz(s.value)})
+	}
+	return st, nil
+}
+
+// Parses a node and its children, returning the node and its length.
+func parseNode(src []byte) (_Node, int, error) {
+	if len(src) < 6 {
+		return _Node{}, 0, errors.New("truncated version block")
+	}
+	length := int(binary.LittleEndian.Uint16(src[0:]))
+	valueLen := int(binary.LittleEndian.Uint16(src[2:]))
+	ty := binary.LittleEndian.Uint16(src[4:])
+	if length < 6 || length > len(src) {
+		return _Node{}, 0, fmt.Errorf("invalid version block length %d", length)
+	}
+	src = src[:length]
+
+	pos := 6
+	var key []uint16
+	for ; pos+1 < length; pos += 2 {
+		ch := binary.LittleEndian.Uint16(src[pos:])
+		if ch == 0 {
+			break
+		}
+		key = append(key, ch)
+	}
+	pos = align32(pos + 2) // skip the null terminator
+
+	node := _Node{key: string(utf16.Decode(key)), ty: ty}
+	if ty == _TYPE_TEXT {
+		valueLen *= 2 // length in chars
+	}
+	if pos+valueLen > length {
+		valueLen = max(length-pos, 0) // some compilers count the padding
+	}
+	if pos < length {
+		node.value = src[pos : pos+valueLen]
+	}
+	pos = align32(pos + valueLen)
+
+	for pos < length {
+		child, childLen, err := parseNode(src[pos:])
+		if err != nil {
+			return _Node{}, 0, err
+		}
+		node.children = append(node.children, child)
+		pos = align32(pos + childLen)
+	}
+	return node, length, nil
+}
+
+func align32(n int) int {
+	return (n + 3) &^ 3
+}
+
+// Decodes null-terminated UTF-16 bytes, stopping at the first null.
+func decodeSz(data []byte) string {
+	chars := make([]uint16, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		ch := binary.LittleEndian.Uint16(data[i:])
+		if ch == 0 {
+			break
+		}
+		chars = append(chars, ch)
+	}
+	return string(utf16.Decode(chars))
+}
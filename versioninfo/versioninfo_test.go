@@ -0,0 +1,211 @@
+package versioninfo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// The contents of testdata/version.rc.
+func fixture() *VersionInfo {
+	return &VersionInfo{
+		Fixed: FixedFileInfo{
+			FileVersion:    [4]uint16{1, 2, 3, 4},
+			ProductVersion: [4]uint16{5, 6, 0, 7},
+			FileFlagsMask:  FF_MASK,
+			FileFlags:      FF_PRERELEASE,
+			FileOS:         OS_NT_WINDOWS32,
+			FileType:       FT_APP,
+		},
+		StringTables: []StringTable{
+			{
+				LangId:   LANGID_EN_US,
+				CodePage: CP_UNICODE,
+				Strings: []String{
+					{"CompanyName", "Acme"},
+					{"FileDescription", "Test app"},
+					{"FileVersion", "1.2.3.4"},
+					{"ProductName", "Windigo"},
+					{"Comments", ""},
+				},
+			},
+			{
+				LangId:   0x0416,
+				CodePage: CP_UNICODE,
+				Strings: []String{
+					{"CompanyName", "Acme Brasil"},
+					{"FileDescription", "Aplicação"},
+				},
+			},
+			{
+				LangId:   LANGID_NEUTRAL,
+				CodePage: CP_ANSI,
+				Strings:  []String{{"X", "odd"}},
+			},
+		},
+		Translations: []Translation{
+			{LANGID_EN_US, CP_UNICODE},
+			{0x0416, CP_UNICODE},
+			{LANGID_NEUTRAL, CP_ANSI},
+		},
+	}
+}
+
+func TestParseFixture(t *testing.T) {
+	data, err := os.ReadFile("testdata/version.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vi, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if want := fixture(); !reflect.DeepEqual(vi, want) {
+		t.Errorf("got  %+v\nwant %+v", vi, want)
+	}
+
+	if got := vi.Bytes(); !bytes.Equal(got, data) {
+		t.Errorf("serialization differs from the resource compiler output\ngot  % x\nwant % x",
+			got, data)
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		vi   *VersionInfo
+	}{
+		{"fixture", fixture()},
+		{"fixed only", &VersionInfo{
+			Fixed: FixedFileInfo{
+				FileVersion: [4]uint16{0xffff, 0, 1, 0xfffe},
+				FileFlags:   FF_DEBUG | FF_PATCHED,
+				FileOS:      OS_WINDOWS32,
+				FileType:    FT_DLL,
+				FileSubtype: 7,
+				FileDate:    0x0102_0304_0506_0708,
+			},
+		}},
+		{"no translations", &VersionInfo{
+			StringTables: []StringTable{{
+				LangId:   LANGID_EN_US,
+				CodePage: CP_ANSI,
+				Strings:  []String{{"A", "1"}},
+			}},
+		}},
+		{"translations only", &VersionInfo{
+			Translations: []Translation{{LANGID_NEUTRAL, CP_UNICODE}},
+		}},
+	}
+
+	// Keys and values of every length modulo 4, to exercise the padding.
+	padding := &VersionInfo{}
+	st := padding.AddStringTable(LANGID_EN_US, CP_UNICODE)
+	for _, s := range []string{"a", "ab", "abc", "abcd", "abcde", "😀", "日本語"} {
+		st.Set(s, s)
+		st.Set("k"+s, s+s+s)
+	}
+	tests = append(tests, struct {
+		name string
+		vi   *VersionInfo
+	}{"padding", padding})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.vi.Bytes()
+			checkAlignment(t, data)
+
+			parsed, err := Parse(data)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if !reflect.DeepEqual(parsed, tt.vi) {
+				t.Errorf("got  %+v\nwant %+v", parsed, tt.vi)
+			}
+		})
+	}
+}
+
+// Checks that each child block starts at a 32-bit boundary, and that the
+// lengths are consistent.
+func checkAlignment(t *testing.T, data []byte) {
+	t.Helper()
+	var walk func(offset int)
+	walk = func(offset int) {
+		if offset%4 != 0 {
+			t.Errorf("block at offset %d is not 32-bit aligned", offset)
+		}
+		block := data[offset:]
+		length := int(binary.LittleEndian.Uint16(block[0:]))
+		valueLen := int(binary.LittleEndian.Uint16(block[2:]))
+		if binary.LittleEndian.Uint16(block[4:]) == _TYPE_TEXT {
+			valueLen *= 2
+		}
+		if offset+length > len(data) {
+			t.Fatalf("block at offset %d overflows: %d bytes", offset, length)
+		}
+
+		pos := 6
+		for binary.LittleEndian.Uint16(block[pos:]) != 0 {
+			pos += 2
+		}
+		pos = align32(pos+2) + valueLen
+		for pos = align32(pos); pos < length; {
+			walk(offset + pos)
+			pos = align32(pos + int(binary.LittleEndian.Uint16(block[pos:])))
+		}
+	}
+	walk(0)
+}
+
+func TestStringTable(t *testing.T) {
+	vi := &VersionInfo{}
+	st := vi.AddStringTable(LANGID_EN_US, CP_UNICODE)
+	st.Set("CompanyName", "Acme")
+	st.Set("companyname", "Acme Corp") // keys are case-insensitive
+	vi.AddStringTable(0x0416, CP_UNICODE)
+	vi.AddStringTable(LANGID_EN_US, CP_UNICODE) // already there
+
+	if len(vi.StringTables) != 2 || len(vi.Translations) != 2 {
+		t.Fatalf("%d tables and %d translations, want 2 and 2",
+			len(vi.StringTables), len(vi.Translations))
+	}
+	st, ok := vi.StringTable(LANGID_EN_US, CP_UNICODE)
+	if !ok || len(st.Strings) != 1 {
+		t.Fatalf("table not found, or with wrong strings: %+v", st)
+	}
+	if val, ok := st.Get("COMPANYNAME"); !ok || val != "Acme Corp" {
+		t.Errorf("Get: %q %v", val, ok)
+	}
+	if _, ok := st.Get("Missing"); ok {
+		t.Errorf("Get of missing key returned true")
+	}
+	if _, ok := vi.StringTable(LANGID_NEUTRAL, CP_ANSI); ok {
+		t.Errorf("StringTable of missing table returned true")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	valid := fixture().Bytes()
+
+	badSignature := append([]byte(nil), valid...)
+	badSignature[40] ^= 0xff // first byte of VS_FIXEDFILEINFO
+
+	badKey := (&VersionInfo{StringTables: []StringTable{{LangId: 1}}}).Bytes()
+	copy(badKey[bytes.Index(badKey, []byte{'0', 0, '0', 0, '0', 0, '1', 0}):], []byte{'x', 0})
+
+	for name, data := range map[string][]byte{
+		"empty":         {},
+		"truncated":     valid[:len(valid)/2],
+		"wrong root":    (&_Node{key: "Other"}).bytes(),
+		"bad signature": badSignature,
+		"bad table key": badKey,
+	} {
+		if _, err := Parse(data); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package versioninfo
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"unicode/utf16"
+)
+
+const (
+	_SIGNATURE       uint32 = 0xfeef_04bd
+	_STRUC_VERSION   uint32 = 0x0001_0000
+	_FIXED_SIZE             = 52 // sizeof(VS_FIXEDFILEINFO)
+	_TYPE_BINARY     uint16 = 0
+	_TYPE_TEXT       uint16 = 1
+	_KEY_ROOT               = "VS_VERSION_INFO"
+	_KEY_STRINGS            = "StringFileInfo"
+	_KEY_VARS               = "VarFileInfo"
+	_KEY_TRANSLATION        = "Translation"
+)
+
+// Serializes the VS_VERSIONINFO block, returning the bytes, which can be stored
+// as an RT_VERSION resource.
+func (vi *VersionInfo) Bytes() []byte {
+	root := _Node{
+		key:   _KEY_ROOT,
+		ty:    _TYPE_BINARY,
+		value: vi.Fixed.bytes(),
+	}
+
+	if len(vi.StringTables) > 0 {
+		stringFileInfo := _Node{key: _KEY_STRINGS, ty: _TYPE_TEXT}
+		for _, st := range vi.StringTables {
+			table := _Node{
+				key: fmt.Sprintf("%04x%04x", st.LangId, st.CodePage),
+				ty:  _TYPE_TEXT,
+			}
+			for _, s := range st.Strings {
+				text := utf16.Encode([]rune(s.Value + "\x00"))
+				table.children = append(table.children, _Node{
+					key:      s.Key,
+					ty:       _TYPE_TEXT,
+					value:    encodeUtf16(nil, text),
+					valueLen: uint16(len(text)), // in chars, for text values
+				})
+			}
+			stringFileInfo.children = append(stringFileInfo.children, table)
+		}
+		root.children = append(root.children, stringFileInfo)
+	}
+
+	if len(vi.Translations) > 0 {
+		var pairs []byte
+		for _, tr := range vi.Translations {
+			pairs = binary.LittleEndian.AppendUint16(pairs, tr.LangId)
+			pairs = binary.LittleEndian.AppendUint16(pairs, tr.CodePage)
+		}
+		root.children = append(root.children, _Node{
+			key: _KEY_VARS,
+			ty:  _TYPE_TEXT,
+			children: []_Node{{
+				key:   _KEY_TRANSLATION,
+				ty:    _TYPE_BINARY,
+				value: pairs,
+			}},
+		})
+	}
+
+	return root.bytes()
+}
+
+// Serializes the VS_VERSIONINFO block, writing it to w.
+//
+// Implements io.WriterTo.
+func (vi *VersionInfo) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(vi.Bytes())
+	return int64(n), err
+}
+
+// Serializes the VS_FIXEDFILEINFO struct.
+func (ffi *FixedFileInfo) bytes() []byte {
+	buf := make([]byte, 0, _FIXED_SIZE)
+	le := binary.LittleEndian
+	buf = le.AppendUint32(buf, _SIGNATURE)
+	buf = le.AppendUint32(buf, _STRUC_VERSION)
+	buf = le.AppendUint32(buf, uint32(ffi.FileVersion[0])<<16|uint32(ffi.FileVersion[1]))
+	buf = le.AppendUint32(buf, uint32(ffi.FileVersion[2])<<16|uint32(ffi.FileVersion[3]))
+	buf = le.AppendUint32(buf, uint32(ffi.ProductVersion[0])<<16|uint32(ffi.ProductVersion[1]))
+	buf = le.AppendUint32(buf, uint32(ffi.ProductVersion[2])<<16|uint32(ffi.ProductVersion[3]))
+	buf = le.AppendUint32(buf, uint32(ffi.FileFlagsMask))
+	buf = le.AppendUint32(buf, uint32(ffi.FileFlags))
+	buf = le.AppendUint32(buf, uint32(ffi.FileOS))
+	buf = le.AppendUint32(buf, uint32(ffi.FileType))
+	buf = le.AppendUint32(buf, ffi.FileSubtype)
+	buf = le.AppendUint32(buf, uint32(ffi.FileDate>>32))
+	buf = le.AppendUint32(buf, uint32(ffi.FileDate))
+	return buf
+}
+
+// A block of the VS_VERSIONINFO tree: VS_VERSIONINFO itself, StringFileInfo,
+// StringTable, String, VarFileInfo or Var, which share the same layout.
+type _Node struct {
+	key      string
+	ty       uint16
+	value    []byte
+	valueLen uint16 // if zero, len(value) is written
+	children []_Node
+}
+
+// Serializes the node and its children. Each child starts at a 32-bit
+// boundary; the length doesn't count the padding after the last child.
+func (n *_Node) bytes() []byte {
+	buf := make([]byte, 6) // wLength, wValueLength and wType, filled below
+	buf = encodeUtf16(buf, utf16.Encode([]rune(n.key+"\x00")))
+	buf = pad32(buf)
+	buf = append(buf, n.value...)
+	for i := range n.children {
+		buf = pad32(buf)
+		buf = append(buf, n.children[i].bytes()...)
+	}
+
+	valueLen := n.valueLen
+	if valueLen == 0 {
+		valueLen = uint16(len(n.value))
+	}
+	binary.LittleEndian.PutUint16(buf[0:], uint16(len(buf)))
+	binary.LittleEndian.PutUint16(buf[2:], valueLen)
+	binary.LittleEndian.PutUint16(buf[4:], n.ty)
+	return buf
+}
+
+// Appends zeros until the length is a multiple of 4.
+func pad32(buf []byte) []byte {
+	for len(buf)%4 != 0 {
+		buf = append(buf, 0)
+	}
+	return buf
+}
+
+// Appends the UTF-16 chars as bytes.
+func encodeUtf16(dest []byte, chars []uint16) []byte {
+	for _, ch := range chars {
+		dest = binary.LittleEndian.AppendUint16(dest, ch)
+	}
+	return dest
+}
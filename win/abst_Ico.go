@@ -0,0 +1,88 @@
+//go:build windows
+
+package win
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/rodrigocfd/windigo/ico"
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Creates an HICON from the entry of an .ico or .cur file which best fits the
+// given size, in pixels at 96 DPI. The size is scaled to the given DPI, as
+// returned by HDC.GetDeviceCaps(co.GDC_LOGPIXELSY); zero means 96.
+//
+// ⚠️ You must defer HICON.DestroyIcon().
+//
+// # Example
+//
+//	//go:embed app.ico
+//	var appIco []byte
+//
+//	file, _ := ico.Parse(appIco)
+//	hIcon, _ := win.IcoCreateHicon(file, 32, 0)
+//	defer hIcon.DestroyIcon()
+func IcoCreateHicon(file *ico.File, size, dpi int) (HICON, error) {
+	entry, px, err := _IcoBestEntry(file, size, dpi)
+	if err != nil {
+		return HICON(0), err
+	}
+
+	hIcon, err := CreateIconFromResourceEx(entry.Data, _ICO_FMT_VERSION,
+		px, px, co.LR_DEFAULTCOLOR)
+	if err != nil {
+		return HICON(0), fmt.Errorf("CreateIconFromResourceEx: %w", err)
+	}
+	return hIcon, nil
+}
+
+// Creates an HCURSOR from the entry of a .cur or .ico file which best fits the
+// given size, in pixels at 96 DPI. The size is scaled to the given DPI, as
+// returned by HDC.GetDeviceCaps(co.GDC_LOGPIXELSY); zero means 96. For .ico
+// files, the hotspot is at the top left corner.
+//
+// ⚠️ You must defer HCURSOR.DestroyCursor().
+//
+// # Example
+//
+//	file, _ := ico.Parse(curData)
+//	hCursor, _ := win.IcoCreateHcursor(file, 32, 0)
+//	defer hCursor.DestroyCursor()
+func IcoCreateHcursor(file *ico.File, size, dpi int) (HCURSOR, error) {
+	entry, px, err := _IcoBestEntry(file, size, dpi)
+	if err != nil {
+		return HCURSOR(0), err
+	}
+
+	// Cursor resources start with the hotspot, which is not in the image data.
+	resBits := make([]byte, 0, 4+len(entry.Data))
+	resBits = binary.LittleEndian.AppendUint16(resBits, entry.HotspotX)
+	resBits = binary.LittleEndian.AppendUint16(resBits, entry.HotspotY)
+	resBits = append(resBits, entry.Data...)
+
+	hCursor, err := CreateCursorFromResourceEx(resBits, _ICO_FMT_VERSION,
+		px, px, co.LR_DEFAULTCOLOR)
+	if err != nil {
+		return HCURSOR(0), fmt.Errorf("CreateCursorFromResourceEx: %w", err)
+	}
+	return hCursor, nil
+}
+
+const _ICO_FMT_VERSION = 0x0003_0000 // expected by CreateIconFromResourceEx
+
+// Returns the best entry and the size in pixels, scaled to the DPI.
+func _IcoBestEntry(file *ico.File, size, dpi int) (*ico.Entry, int, error) {
+	if dpi == 0 {
+		dpi = 96
+	}
+	px := (size*dpi + 48) / 96 // rounded, like MulDiv
+
+	entry := file.Best(px, px)
+	if entry == nil {
+		return nil, 0, errors.New("the .ico or .cur file has no images")
+	}
+	return entry, px, nil
+}
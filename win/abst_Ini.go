@@ -4,118 +4,78 @@ package win
 
 import (
 	"runtime"
-	"strings"
 
+	"github.com/rodrigocfd/windigo/ini"
 	"github.com/rodrigocfd/windigo/win/co"
 )
 
 // High-level abstraction to a .ini file.
 //
-// Contains a slice of sections, which can be freely modified.
+// Embeds an ini.File, which keeps comments, blank lines, key order and the
+// original encoding, and whose sections can be freely modified.
 //
 // Created with IniLoad().
 type Ini struct {
-	Sections   []IniSection // All sections of this .ini file.
+	*ini.File
 	sourcePath string
 }
 
-// Loads the sections and keys of an INI file.
+// A single section of an Ini.
+//
+// Contains a slice of keys, which can be freely modified.
+type IniSection = ini.Section
+
+// A single key of an IniSection.
+type IniKey = ini.Key
+
+// Loads the sections and keys of an INI file, keeping all the keys, even if
+// repeated.
+//
+// To choose another duplicate key policy, read the file and call ini.Parse()
+// directly.
 func IniLoad(filePath string) (*Ini, error) {
-	me := &Ini{}
-	lines, err := me.loadLines(filePath)
+	data, err := _IniLoadBytes(filePath)
 	if err != nil {
 		return nil, err
 	}
 
-	me.Sections = make([]IniSection, 0, 4) // arbitrary
-	var curSection IniSection
-
-	for _, line := range lines {
-		if len(line) == 0 {
-			continue // skip blank lines
-		}
-
-		if line[0] == '[' && line[len(line)-1] == ']' { // [section] ?
-			if curSection.Name != "" {
-				me.Sections = append(me.Sections, curSection)
-			}
-			curSection = IniSection{ // create a new section with the given name
-				Name:   strings.TrimSpace(line[1 : len(line)-1]),
-				Values: make([]IniKey, 0, 4), // arbitrary
-			}
-
-		} else if curSection.Name != "" {
-			keyVal := strings.SplitN(line, "=", 2)
-			curSection.Values = append(curSection.Values, IniKey{
-				Name:  strings.TrimSpace(keyVal[0]),
-				Value: strings.TrimSpace(keyVal[1]),
-			})
-		}
-	}
-
-	if curSection.Name != "" { // for the last section
-		me.Sections = append(me.Sections, curSection)
+	file, err := ini.Parse(data, ini.DUPLICATE_KEEP)
+	if err != nil {
+		return nil, err
 	}
-
-	me.sourcePath = filePath // keep
-	return me, nil
+	return &Ini{File: file, sourcePath: filePath}, nil
 }
 
-func (me *Ini) loadLines(filePath string) ([]string, error) {
+func _IniLoadBytes(filePath string) ([]byte, error) {
 	if runtime.GOARCH == "386" { // MapViewOfFile may have issues in x86
 		fin, err := FileOpen(filePath, co.FILE_OPEN_READ_EXISTING)
 		if err != nil {
 			return nil, err
 		}
 		defer fin.Close()
-		return fin.ReadLines()
+		return fin.ReadAll()
 	} else {
 		fin, err := FileMappedOpen(filePath, co.FILE_OPEN_READ_EXISTING)
 		if err != nil {
 			return nil, err
 		}
 		defer fin.Close()
-		return fin.ReadLines(), nil
-	}
-}
-
-// Returns the IniSection with the given name, if any.
-func (me *Ini) Section(name string) (*IniSection, bool) {
-	for i := range me.Sections {
-		section := &me.Sections[i]
-		if section.Name == name {
-			return section, true
-		}
+		return fin.ReadAll(), nil
 	}
-	return nil, false
 }
 
-// Saves the contents to a .ini file.
+// Saves the contents to a .ini file, in the same encoding it was loaded.
 func (me *Ini) SaveToFile(filePath string) error {
-	var serialized strings.Builder
-
-	for s := range me.Sections {
-		section := &me.Sections[s]
-		serialized.WriteString("[" + section.Name + "]\r\n")
-		for v := range section.Values {
-			value := &section.Values[v]
-			serialized.WriteString(value.Name + "=" + value.Value + "\r\n")
-		}
-
-		isLast := s == len(me.Sections)-1
-		if !isLast {
-			serialized.WriteString("\r\n")
-		}
-	}
-
 	fout, err := FileOpen(filePath, co.FILE_OPEN_RW_OPEN_OR_CREATE)
 	if err != nil {
 		return err
 	}
 	defer fout.Close()
 
-	blob := []byte(serialized.String())
-	fout.Resize(len(blob))
+	blob := me.Bytes()
+	if err := fout.Resize(len(blob)); err != nil {
+		return err
+	}
 	_, err = fout.Write(blob)
 
 	me.sourcePath = filePath // update
@@ -142,54 +102,3 @@ func (me *Ini) SaveToFile(filePath string) error {
 func (me *Ini) SourcePath() string {
 	return me.sourcePath
 }
-
-// Returns the specific value, if existing.
-//
-// Note that a pointer to the string is returned, so that the value can be
-// directly modified.
-//
-// # Example
-//
-//	ini, _ := win.IniLoad("C:\\Temp\\foo.ini")
-//
-//	if val, ok := ini.Value("my section", "my value"); ok {
-//		fmt.Printf("Old value: %s\n", *val)
-//		*val = "new value"
-//	}
-func (me *Ini) Value(sectionName, valueName string) (*string, bool) {
-	if section, ok := me.Section(sectionName); ok {
-		if value, ok := section.Value(valueName); ok {
-			return &value.Value, true
-		}
-	}
-	return nil, false
-}
-
-//------------------------------------------------------------------------------
-
-// A single section of an Ini.
-//
-// Contains a slice of keys, which can be freely modified.
-type IniSection struct {
-	Name   string   // The name of this section.
-	Values []IniKey // All values of this section.
-}
-
-// Returns the IniKey with the given name, if any.
-func (me *IniSection) Value(name string) (*IniKey, bool) {
-	for i := range me.Values {
-		value := &me.Values[i]
-		if value.Name == name {
-			return value, true
-		}
-	}
-	return nil, false
-}
-
-//------------------------------------------------------------------------------
-
-// A single key of an IniSection.
-type IniKey struct {
-	Name  string // The name of this key.
-	Value string // The value of this key.
-}
@@ -0,0 +1,382 @@
+//go:build windows
+
+package win
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"syscall"
+
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Options for NewIoPort().
+type IoPortOpts struct {
+	Workers  int             // Number of goroutines running the completions; if zero, the number of processors is used.
+	Dispatch func(fn func()) // If set, callbacks are run through it, like ui.AnyWindow.RunUiThread, instead of in the worker goroutines.
+}
+
+// Result of an asynchronous operation started with IoPort.
+type IoResult struct {
+	NumBytes int   // Number of bytes transferred.
+	Err      error // Error of the operation, if any. Cancelled operations fail with errco.OPERATION_ABORTED.
+}
+
+// High-level asynchronous I/O engine built on an [I/O completion port].
+//
+// Handles opened with FILE_FLAG_OVERLAPPED, like HFILE and HPIPE, are
+// associated with the port, then the operations are started by the IoPort
+// methods. When each operation completes, its result is delivered either to a
+// callback or to a channel.
+//
+// The completions are run by a pool of goroutines; callbacks can be marshalled
+// to the UI thread with IoPortOpts.Dispatch.
+//
+// Created with NewIoPort().
+//
+// [I/O completion port]: https://learn.microsoft.com/en-us/windows/win32/fileio/i-o-completion-ports
+type IoPort struct {
+	hPort      HIOCP
+	dispatch   func(fn func())
+	numWorkers int
+	workers    sync.WaitGroup
+
+	mutex      sync.Mutex
+	pending    map[*OVERLAPPED]*_IoPortOp // keeps the operations alive while in use by the system
+	closing    bool
+	quitPosted bool
+}
+
+// An operation in progress, whose memory must not be released until its
+// completion packet is dequeued.
+type _IoPortOp struct {
+	ov     OVERLAPPED
+	hFile  HFILE
+	buf    []byte
+	onDone func(r IoResult)
+	ch     chan IoResult
+}
+
+const _IOPORT_QUIT_KEY = ^uintptr(0) // completion key which stops a worker
+
+// Creates a new I/O completion port, and starts its worker goroutines; opts can
+// be nil.
+//
+// ⚠️ You must defer IoPort.Close().
+//
+// # Example
+//
+// Reading a large file without blocking the UI:
+//
+//	var wnd ui.WindowMain // initialized somewhere
+//	var lblStatus ui.Static
+//
+//	port, _ := win.NewIoPort(&win.IoPortOpts{Dispatch: wnd.RunUiThread})
+//	defer port.Close()
+//
+//	hFile, _ := win.CreateFile("C:\\Temp\\big.bin", co.GENERIC_READ,
+//		co.FILE_SHARE_READ, nil, co.DISPOSITION_OPEN_EXISTING,
+//		co.FILE_ATTRIBUTE_NORMAL, co.FILE_FLAG_OVERLAPPED, co.SECURITY_NONE, 0)
+//	defer hFile.CloseHandle()
+//
+//	port.Associate(win.HANDLE(hFile))
+//
+//	buf := make([]byte, 1024*1024)
+//	port.Read(win.HANDLE(hFile), buf, 0, func(r win.IoResult) {
+//		if r.Err != nil {
+//			lblStatus.SetText(r.Err.Error())
+//		} else {
+//			lblStatus.SetText(fmt.Sprintf("%d bytes read", r.NumBytes))
+//		}
+//	})
+func NewIoPort(opts *IoPortOpts) (*IoPort, error) {
+	if opts == nil {
+		opts = &IoPortOpts{}
+	}
+
+	numWorkers := opts.Workers
+	if numWorkers <= 0 {
+		numWorkers = runtime.NumCPU()
+	}
+
+	hPort, err := CreateIoCompletionPort(HANDLE(syscall.InvalidHandle),
+		HIOCP(0), 0, uint32(numWorkers))
+	if err != nil {
+		return nil, fmt.Errorf("CreateIoCompletionPort: %w", err)
+	}
+
+	me := &IoPort{
+		hPort:      hPort,
+		dispatch:   opts.Dispatch,
+		numWorkers: numWorkers,
+		pending:    make(map[*OVERLAPPED]*_IoPortOp),
+	}
+	me.workers.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go me.worker()
+	}
+	return me, nil
+}
+
+// Associates a handle, opened with FILE_FLAG_OVERLAPPED, with the port. This
+// must be done before starting any operations on the handle.
+//
+// A handle can be associated with only one port, and remains associated until
+// it's closed.
+//
+// Calls [CreateIoCompletionPort].
+//
+// [CreateIoCompletionPort]: https://learn.microsoft.com/en-us/windows/win32/fileio/createiocompletionport
+func (me *IoPort) Associate(hFile HANDLE) error {
+	if _, err := CreateIoCompletionPort(hFile, me.hPort, 0, 0); err != nil {
+		return fmt.Errorf("CreateIoCompletionPort: %w", err)
+	}
+	return nil
+}
+
+// Cancels all pending operations, and stops the workers once the cancelled
+// operations are completed. The callbacks of the cancelled operations are still
+// called, and further operations fail with errco.INVALID_HANDLE.
+//
+// It doesn't block, so it can be called from the UI thread, or from a callback.
+func (me *IoPort) Close() {
+	me.mutex.Lock()
+	if me.closing {
+		me.mutex.Unlock()
+		return
+	}
+	me.closing = true
+	for _, op := range me.pending {
+		op.hFile.CancelIoEx(&op.ov) // the operation may be already completing
+	}
+	mustQuit := me.checkQuit()
+	me.mutex.Unlock()
+
+	if mustQuit {
+		me.postQuit()
+	}
+	go func() {
+		me.workers.Wait()
+		me.hPort.CloseHandle()
+	}()
+}
+
+// Returns the underlying handle.
+func (me *IoPort) Hiocp() HIOCP {
+	return me.hPort
+}
+
+// Starts an asynchronous [ReadFile] on the handle, at the given offset, which
+// is ignored by pipes. The buffer must not be used until the operation is
+// completed.
+//
+// When the operation completes, onDone is called. Reading past the end of a
+// file fails with errco.HANDLE_EOF; reading from a pipe whose other end was
+// closed fails with errco.BROKEN_PIPE.
+//
+// [ReadFile]: https://learn.microsoft.com/en-us/windows/win32/api/fileapi/nf-fileapi-readfile
+func (me *IoPort) Read(
+	hFile HANDLE, buf []byte, offset uint64, onDone func(r IoResult)) error {
+
+	return me.start(&_IoPortOp{hFile: HFILE(hFile), buf: buf, onDone: onDone},
+		offset, func(op *_IoPortOp) error {
+			_, err := op.hFile.ReadFile(op.buf, &op.ov)
+			return err
+		})
+}
+
+// Same as IoPort.Read(), but the result is delivered to the returned channel,
+// which receives exactly one value.
+//
+// # Example
+//
+//	var port *win.IoPort // initialized somewhere
+//	var hFile win.HFILE // associated with the port
+//
+//	buf := make([]byte, 4096)
+//	ch, _ := port.ReadChan(win.HANDLE(hFile), buf, 0)
+//	// ... do something else ...
+//	r := <-ch
+//	println(r.NumBytes)
+func (me *IoPort) ReadChan(
+	hFile HANDLE, buf []byte, offset uint64) (<-chan IoResult, error) {
+
+	ch := make(chan IoResult, 1)
+	if err := me.start(&_IoPortOp{hFile: HFILE(hFile), buf: buf, ch: ch},
+		offset, func(op *_IoPortOp) error {
+			_, err := op.hFile.ReadFile(op.buf, &op.ov)
+			return err
+		}); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// Starts an arbitrary overlapped operation on the handle, like
+// ConnectNamedPipeOverlapped, with the given OVERLAPPED. The start function
+// must return nil or errco.IO_PENDING if the operation was started.
+//
+// When the operation completes, onDone is called.
+func (me *IoPort) Start(
+	hFile HANDLE,
+	start func(overlapped *OVERLAPPED) error,
+	onDone func(r IoResult)) error {
+
+	return me.start(&_IoPortOp{hFile: HFILE(hFile), onDone: onDone},
+		0, func(op *_IoPortOp) error {
+			return start(&op.ov)
+		})
+}
+
+// Starts an asynchronous [WriteFile] on the handle, at the given offset, which
+// is ignored by pipes. The data must not be modified until the operation is
+// completed.
+//
+// When the operation completes, onDone is called.
+//
+// [WriteFile]: https://learn.microsoft.com/en-us/windows/win32/api/fileapi/nf-fileapi-writefile
+func (me *IoPort) Write(
+	hFile HANDLE, data []byte, offset uint64, onDone func(r IoResult)) error {
+
+	return me.start(&_IoPortOp{hFile: HFILE(hFile), buf: data, onDone: onDone},
+		offset, func(op *_IoPortOp) error {
+			_, err := op.hFile.WriteFile(op.buf, &op.ov)
+			return err
+		})
+}
+
+// Same as IoPort.Write(), but the result is delivered to the returned channel,
+// which receives exactly one value.
+func (me *IoPort) WriteChan(
+	hFile HANDLE, data []byte, offset uint64) (<-chan IoResult, error) {
+
+	ch := make(chan IoResult, 1)
+	if err := me.start(&_IoPortOp{hFile: HFILE(hFile), buf: data, ch: ch},
+		offset, func(op *_IoPortOp) error {
+			_, err := op.hFile.WriteFile(op.buf, &op.ov)
+			return err
+		}); err != nil {
+		return nil, err
+	}
+	return ch, nil
+}
+
+// Registers the operation as pending, and starts it.
+func (me *IoPort) start(
+	op *_IoPortOp, offset uint64, startFunc func(op *_IoPortOp) error) error {
+
+	if op.buf != nil && len(op.buf) == 0 {
+		return errco.INVALID_PARAMETER
+	}
+	op.ov.SetOffset64(offset)
+
+	me.mutex.Lock()
+	if me.closing {
+		me.mutex.Unlock()
+		return errco.INVALID_HANDLE
+	}
+	me.pending[&op.ov] = op // before starting, because it may complete right away
+	me.mutex.Unlock()
+
+	if err := startFunc(op); err != nil &&
+		err != errco.IO_PENDING && err != errco.MORE_DATA {
+		// The operation failed right away, so no completion packet will be
+		// queued; otherwise, even a synchronous success is queued. A partial
+		// message read is a warning, which is queued too.
+		if _, mustQuit := me.remove(&op.ov); mustQuit {
+			me.postQuit()
+		}
+		return err
+	}
+	return nil
+}
+
+// Removes the operation from the pending ones. Returns true if the workers
+// must be stopped.
+func (me *IoPort) remove(ov *OVERLAPPED) (*_IoPortOp, bool) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	op := me.pending[ov]
+	delete(me.pending, ov)
+	return op, me.checkQuit()
+}
+
+// Tells whether the quit packets must be posted, marking them as posted. Must
+// be called with the mutex locked.
+func (me *IoPort) checkQuit() bool {
+	if me.closing && len(me.pending) == 0 && !me.quitPosted {
+		me.quitPosted = true
+		return true
+	}
+	return false
+}
+
+func (me *IoPort) postQuit() {
+	for i := 0; i < me.numWorkers; i++ {
+		if err := me.hPort.PostQueuedCompletionStatus(
+			0, _IOPORT_QUIT_KEY, nil); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (me *IoPort) worker() {
+	defer me.workers.Done()
+
+	entries := make([]OVERLAPPED_ENTRY, 16)
+	for {
+		count, err := me.hPort.GetQueuedCompletionStatusEx(
+			entries, NumInfInfinite(), false)
+		if err != nil {
+			panic(err)
+		}
+
+		numQuits := 0
+		for i := range entries[:count] {
+			entry := &entries[i]
+			if entry.LpOverlapped == nil {
+				if entry.LpCompletionKey == _IOPORT_QUIT_KEY {
+					numQuits++
+				}
+				continue
+			}
+			me.complete(entry.LpOverlapped)
+		}
+
+		if numQuits > 0 {
+			for i := 1; i < numQuits; i++ { // quit packets meant to other workers
+				me.hPort.PostQueuedCompletionStatus(0, _IOPORT_QUIT_KEY, nil)
+			}
+			return
+		}
+	}
+}
+
+// Delivers the result of a completed operation.
+func (me *IoPort) complete(ov *OVERLAPPED) {
+	op, mustQuit := me.remove(ov)
+	if mustQuit {
+		me.postQuit()
+	}
+	if op == nil {
+		return // not started by us
+	}
+
+	numBytes, err := op.hFile.GetOverlappedResult(&op.ov, false)
+	res := IoResult{NumBytes: int(numBytes)}
+	if err != nil {
+		res.Err = err
+	}
+
+	if op.ch != nil {
+		op.ch <- res // buffered, never blocks
+	} else if op.onDone != nil {
+		if me.dispatch != nil {
+			me.dispatch(func() { op.onDone(res) })
+		} else {
+			op.onDone(res)
+		}
+	}
+}
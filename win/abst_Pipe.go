@@ -0,0 +1,539 @@
+//go:build windows
+
+package win
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Address of a named pipe, in the form \\.\pipe\name, implementing
+// [net.Addr].
+type PipeAddr string
+
+// Implements net.Addr.
+func (PipeAddr) Network() string { return "pipe" }
+
+// Implements net.Addr.
+func (a PipeAddr) String() string { return string(a) }
+
+//------------------------------------------------------------------------------
+
+// Options for PipeListen().
+type PipeListenOpts struct {
+	MessageMode        bool                 // Each Write() is sent as a message, and a Read() never returns more than one message; otherwise the pipe is a byte stream.
+	InBufferSize       uint32               // Input buffer size, in bytes; if zero, the system default is used.
+	OutBufferSize      uint32               // Output buffer size, in bytes; if zero, the system default is used.
+	AcceptRemote       bool                 // Accepts clients from other machines; by default, only local clients are accepted.
+	SecurityAttributes *SECURITY_ATTRIBUTES // Security of the pipe instances; if nil, the default security is used.
+}
+
+// Server side of a named pipe, implementing [net.Listener]. Each call to
+// Accept() waits for a client on a new pipe instance, so any number of clients
+// can be connected at the same time.
+//
+// Created with PipeListen().
+type PipeListener struct {
+	name        string
+	opts        PipeListenOpts
+	acceptMutex sync.Mutex // serializes Accept() calls
+	mutex       sync.Mutex
+	hNext       HPIPE // instance waiting for the next client
+	closed      bool
+	closeCh     chan struct{}
+}
+
+const _PIPE_UNLIMITED_INSTANCES = 255
+
+// Creates the first instance of a named pipe, and returns a listener for its
+// clients; opts can be nil.
+//
+// If another process already created a pipe with the same name, it fails with
+// errco.ACCESS_DENIED.
+//
+// ⚠️ You must defer PipeListener.Close().
+//
+// # Example
+//
+// Serving HTTP over a named pipe, which is consumed by another process:
+//
+//	ln, _ := win.PipeListen("\\\\.\\pipe\\myapp", nil)
+//	defer ln.Close()
+//
+//	http.HandleFunc("/status", func(w http.ResponseWriter, r *http.Request) {
+//		w.Write([]byte("ok"))
+//	})
+//	go http.Serve(ln, nil)
+func PipeListen(name string, opts *PipeListenOpts) (*PipeListener, error) {
+	if opts == nil {
+		opts = &PipeListenOpts{}
+	}
+
+	me := &PipeListener{
+		name:    name,
+		opts:    *opts,
+		closeCh: make(chan struct{}),
+	}
+	hPipe, err := me.createInstance(true)
+	if err != nil {
+		return nil, err
+	}
+	me.hNext = hPipe
+	return me, nil
+}
+
+func (me *PipeListener) createInstance(first bool) (HPIPE, error) {
+	openMode := co.PIPE_ACCESS_DUPLEX | co.PIPE_ACCESS(co.FILE_FLAG_OVERLAPPED)
+	if first { // fails if another process owns the name
+		openMode |= co.PIPE_ACCESS(co.FILE_FLAG_FIRST_PIPE_INSTANCE)
+	}
+
+	pipeMode := co.PIPE_WAIT | co.PIPE_TYPE_BYTE | co.PIPE_READMODE_BYTE
+	if me.opts.MessageMode {
+		pipeMode = co.PIPE_WAIT | co.PIPE_TYPE_MESSAGE | co.PIPE_READMODE_MESSAGE
+	}
+	if !me.opts.AcceptRemote {
+		pipeMode |= co.PIPE_REJECT_REMOTE_CLIENTS
+	}
+
+	hPipe, err := CreateNamedPipe(me.name, openMode, pipeMode,
+		_PIPE_UNLIMITED_INSTANCES, uint(me.opts.OutBufferSize),
+		uint(me.opts.InBufferSize), 0, me.opts.SecurityAttributes)
+	if err != nil {
+		return HPIPE(0), fmt.Errorf("CreateNamedPipe: %w", err)
+	}
+
+	if err := _PipeIoPort().Associate(HANDLE(hPipe)); err != nil {
+		hPipe.CloseHandle()
+		return HPIPE(0), err
+	}
+	return hPipe, nil
+}
+
+// Waits for the next client to connect, and returns the connection to it.
+//
+// After PipeListener.Close() is called, returns net.ErrClosed.
+//
+// Implements net.Listener.
+func (me *PipeListener) Accept() (net.Conn, error) {
+	me.acceptMutex.Lock()
+	defer me.acceptMutex.Unlock()
+
+	for {
+		me.mutex.Lock()
+		if me.closed {
+			me.mutex.Unlock()
+			return nil, me.opError(net.ErrClosed)
+		}
+		hPipe := me.hNext
+		me.mutex.Unlock()
+
+		if hPipe == 0 { // creation of the next instance failed in a previous call
+			var err error
+			if hPipe, err = me.createInstance(false); err != nil {
+				return nil, me.opError(err)
+			}
+			me.mutex.Lock()
+			me.hNext = hPipe
+			me.mutex.Unlock()
+		}
+
+		err := me.connect(hPipe)
+		if err == errco.NO_DATA { // client connected and disconnected already
+			hPipe.DisconnectNamedPipe()
+			continue
+		} else if err != nil {
+			return nil, me.opError(err)
+		}
+
+		// Creates the next instance right away, so new clients don't find the
+		// pipe busy while the connection is being handled.
+		hNext, _ := me.createInstance(false) // if failed, retried in the next call
+		me.mutex.Lock()
+		me.hNext = hNext
+		me.mutex.Unlock()
+
+		return &_PipeConn{
+			hPipe:   hPipe,
+			addr:    PipeAddr(me.name),
+			closeCh: make(chan struct{}),
+		}, nil
+	}
+}
+
+// Waits for a client to connect to the given instance.
+func (me *PipeListener) connect(hPipe HPIPE) error {
+	ch := make(chan IoResult, 1)
+	var overlapped *OVERLAPPED
+
+	err := _PipeIoPort().Start(HANDLE(hPipe), func(ov *OVERLAPPED) error {
+		overlapped = ov
+		return hPipe.ConnectNamedPipeOverlapped(ov)
+	}, func(r IoResult) {
+		ch <- r
+	})
+	if err == errco.PIPE_CONNECTED { // client connected before the call
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("ConnectNamedPipe: %w", err)
+	}
+
+	select {
+	case r := <-ch:
+		if r.Err != nil && r.Err != errco.NO_DATA {
+			return fmt.Errorf("ConnectNamedPipe: %w", r.Err)
+		}
+		return r.Err
+	case <-me.closeCh:
+		hPipe.CancelIoEx(overlapped)
+		<-ch
+		return net.ErrClosed
+	}
+}
+
+func (me *PipeListener) opError(err error) error {
+	return &net.OpError{Op: "accept", Net: "pipe", Addr: me.Addr(), Err: err}
+}
+
+// Returns the name of the pipe.
+//
+// Implements net.Listener.
+func (me *PipeListener) Addr() net.Addr {
+	return PipeAddr(me.name)
+}
+
+// Stops listening; a blocked Accept() returns net.ErrClosed. Connections
+// already accepted are not affected.
+//
+// Implements net.Listener.
+func (me *PipeListener) Close() error {
+	me.mutex.Lock()
+	if me.closed {
+		me.mutex.Unlock()
+		return nil
+	}
+	me.closed = true
+	close(me.closeCh)
+	me.mutex.Unlock()
+
+	me.acceptMutex.Lock() // waits for a blocked Accept() to return
+	defer me.acceptMutex.Unlock()
+
+	var e error
+	if me.hNext != 0 {
+		e = me.hNext.CloseHandle()
+		me.hNext = 0
+	}
+	return e
+}
+
+//------------------------------------------------------------------------------
+
+// Connects to a named pipe created with PipeListen(), or by any other server,
+// returning the connection to it.
+//
+// If all the instances of the pipe are busy, waits up to the given timeout for
+// one to be available; zero timeout waits indefinitely. If the pipe doesn't
+// exist, fails right away with errco.FILE_NOT_FOUND.
+//
+// If the pipe is in message mode, the connection reads messages too.
+//
+// ⚠️ You must defer net.Conn.Close().
+//
+// # Example
+//
+// Calling a net/rpc service exposed by another process:
+//
+//	conn, err := win.PipeDial("\\\\.\\pipe\\myapp", 5*time.Second)
+//	if err != nil {
+//		panic(err)
+//	}
+//	client := rpc.NewClient(conn)
+//	defer client.Close()
+//
+//	var reply string
+//	client.Call("Service.Status", "", &reply)
+func PipeDial(name string, timeout time.Duration) (net.Conn, error) {
+	opError := func(err error) error {
+		return &net.OpError{Op: "dial", Net: "pipe", Addr: PipeAddr(name), Err: err}
+	}
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	var hPipe HPIPE
+	for {
+		hFile, err := CreateFile(name, co.GENERIC_READ|co.GENERIC_WRITE,
+			co.FILE_SHARE_NONE, nil, co.DISPOSITION_OPEN_EXISTING,
+			co.FILE_ATTRIBUTE_NORMAL, co.FILE_FLAG_OVERLAPPED,
+			co.SECURITY_SQOS_PRESENT|co.SECURITY_IDENTIFICATION, 0) // server can't impersonate us
+		if err == nil {
+			hPipe = HPIPE(hFile)
+			break
+		} else if err != errco.PIPE_BUSY {
+			return nil, opError(err)
+		}
+
+		waitTime := NumInfInfinite()
+		if timeout > 0 {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return nil, opError(os.ErrDeadlineExceeded)
+			}
+			waitTime = NumInfNumeric(int(remaining.Milliseconds()) + 1)
+		}
+		if err := WaitNamedPipe(name, waitTime); err == errco.SEM_TIMEOUT {
+			return nil, opError(os.ErrDeadlineExceeded)
+		} else if err != nil && err != errco.PIPE_BUSY {
+			return nil, opError(err)
+		}
+	}
+
+	if info, err := hPipe.GetNamedPipeInfo(); err != nil {
+		hPipe.CloseHandle()
+		return nil, opError(fmt.Errorf("GetNamedPipeInfo: %w", err))
+	} else if (info.Flags & co.PIPE_TYPE_MESSAGE) != 0 {
+		if err := hPipe.SetNamedPipeHandleState(co.PIPE_READMODE_MESSAGE); err != nil {
+			hPipe.CloseHandle()
+			return nil, opError(fmt.Errorf("SetNamedPipeHandleState: %w", err))
+		}
+	}
+
+	if err := _PipeIoPort().Associate(HANDLE(hPipe)); err != nil {
+		hPipe.CloseHandle()
+		return nil, opError(err)
+	}
+
+	return &_PipeConn{
+		hPipe:   hPipe,
+		addr:    PipeAddr(name),
+		closeCh: make(chan struct{}),
+	}, nil
+}
+
+//------------------------------------------------------------------------------
+
+// Connection to a named pipe, on either side, implementing net.Conn.
+type _PipeConn struct {
+	hPipe    HPIPE
+	addr     PipeAddr
+	readDln  _PipeDeadline
+	writeDln _PipeDeadline
+	ops      sync.WaitGroup // operations in progress
+	mutex    sync.Mutex
+	closed   bool
+	closeCh  chan struct{}
+}
+
+func (me *_PipeConn) opError(op string, err error) error {
+	return &net.OpError{Op: op, Net: "pipe", Source: me.addr, Addr: me.addr, Err: err}
+}
+
+func (me *_PipeConn) Read(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	numRead, err := me.transfer(b, true)
+	switch err {
+	case nil, errco.MORE_DATA: // the rest of the message goes to the next Read()
+		return numRead, nil
+	case errco.BROKEN_PIPE, errco.PIPE_NOT_CONNECTED: // the other side closed
+		return numRead, io.EOF
+	default:
+		return numRead, me.opError("read", err)
+	}
+}
+
+func (me *_PipeConn) Write(b []byte) (int, error) {
+	if len(b) == 0 {
+		return 0, nil
+	}
+
+	written, err := me.transfer(b, false)
+	if err != nil {
+		return written, me.opError("write", err)
+	} else if written < len(b) {
+		return written, me.opError("write", io.ErrShortWrite)
+	}
+	return written, nil
+}
+
+// Runs an overlapped read or write, waiting for its completion, the deadline
+// or the connection to be closed.
+func (me *_PipeConn) transfer(b []byte, isRead bool) (int, error) {
+	me.mutex.Lock()
+	if me.closed {
+		me.mutex.Unlock()
+		return 0, net.ErrClosed
+	}
+	me.ops.Add(1)
+	me.mutex.Unlock()
+	defer me.ops.Done()
+
+	dln := &me.writeDln
+	if isRead {
+		dln = &me.readDln
+	}
+	if dln.expired() {
+		return 0, os.ErrDeadlineExceeded
+	}
+
+	ch := make(chan IoResult, 1)
+	var overlapped *OVERLAPPED
+
+	err := _PipeIoPort().Start(HANDLE(me.hPipe), func(ov *OVERLAPPED) error {
+		overlapped = ov
+		var err error
+		if isRead {
+			_, err = me.hPipe.ReadFile(b, ov)
+		} else {
+			_, err = me.hPipe.WriteFile(b, ov)
+		}
+		return err
+	}, func(r IoResult) {
+		ch <- r
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		deadline, changed := dln.get()
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if !deadline.IsZero() {
+			timer = time.NewTimer(time.Until(deadline))
+			timeout = timer.C
+		}
+
+		var cause error
+		select {
+		case r := <-ch:
+			if timer != nil {
+				timer.Stop()
+			}
+			return r.NumBytes, r.Err
+		case <-changed:
+			if timer != nil {
+				timer.Stop()
+			}
+			continue
+		case <-timeout:
+			cause = os.ErrDeadlineExceeded
+		case <-me.closeCh:
+			if timer != nil {
+				timer.Stop()
+			}
+			cause = net.ErrClosed
+		}
+
+		// The operation must be completed before its buffer is released.
+		me.hPipe.CancelIoEx(overlapped)
+		r := <-ch
+		if r.Err == errco.OPERATION_ABORTED {
+			r.Err = cause
+		}
+		return r.NumBytes, r.Err
+	}
+}
+
+// Cancels any pending Read() and Write(), which return net.ErrClosed, and
+// closes the pipe. Data already written remains available to the other side.
+func (me *_PipeConn) Close() error {
+	me.mutex.Lock()
+	if me.closed {
+		me.mutex.Unlock()
+		return nil
+	}
+	me.closed = true
+	close(me.closeCh)
+	me.mutex.Unlock()
+
+	me.ops.Wait()
+	return me.hPipe.CloseHandle()
+}
+
+func (me *_PipeConn) LocalAddr() net.Addr  { return me.addr }
+func (me *_PipeConn) RemoteAddr() net.Addr { return me.addr }
+
+func (me *_PipeConn) SetDeadline(t time.Time) error {
+	me.readDln.set(t)
+	me.writeDln.set(t)
+	return nil
+}
+
+func (me *_PipeConn) SetReadDeadline(t time.Time) error {
+	me.readDln.set(t)
+	return nil
+}
+
+func (me *_PipeConn) SetWriteDeadline(t time.Time) error {
+	me.writeDln.set(t)
+	return nil
+}
+
+//------------------------------------------------------------------------------
+
+// Deadline of a _PipeConn direction, which notifies the pending operation when
+// changed.
+type _PipeDeadline struct {
+	mutex   sync.Mutex
+	t       time.Time
+	changed chan struct{}
+}
+
+func (me *_PipeDeadline) get() (time.Time, <-chan struct{}) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	if me.changed == nil {
+		me.changed = make(chan struct{})
+	}
+	return me.t, me.changed
+}
+
+func (me *_PipeDeadline) set(t time.Time) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	me.t = t
+	if me.changed != nil {
+		close(me.changed)
+	}
+	me.changed = make(chan struct{})
+}
+
+func (me *_PipeDeadline) expired() bool {
+	t, _ := me.get()
+	return !t.IsZero() && !t.After(time.Now())
+}
+
+//------------------------------------------------------------------------------
+
+var (
+	_globalPipeIoPortOnce sync.Once
+	_globalPipeIoPort     *IoPort
+)
+
+// Returns the I/O completion port shared by all pipe connections, which is
+// created on the first call.
+func _PipeIoPort() *IoPort {
+	_globalPipeIoPortOnce.Do(func() {
+		port, err := NewIoPort(nil)
+		if err != nil {
+			panic(err)
+		}
+		_globalPipeIoPort = port
+	})
+	return _globalPipeIoPort
+}
@@ -0,0 +1,436 @@
+//go:build windows
+
+package win
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Options for ProcessStart().
+type ProcessOpts struct {
+	Env         map[string]string // Variables merged over the current environment; an empty value removes the variable.
+	Dir         string            // Working directory; if empty, the current one is used.
+	Flags       co.CREATE         // Creation flags, like CREATE_NO_WINDOW to hide the console of a console program.
+	PipeStdin   bool              // Redirects the standard input from Process.Stdin().
+	PipeStdout  bool              // Redirects the standard output to Process.Stdout().
+	PipeStderr  bool              // Redirects the standard error to Process.Stderr().
+	MergeStderr bool              // Redirects the standard error to Process.Stdout(), along with the standard output.
+	Job         HJOB              // Job object the process is assigned to before it starts running; if zero, no job is used.
+}
+
+// High-level abstraction to a child process created with [CreateProcess],
+// optionally with its standard input and output redirected to anonymous pipes.
+//
+// Created with ProcessStart().
+//
+// [CreateProcess]: https://learn.microsoft.com/en-us/windows/win32/api/processthreadsapi/nf-processthreadsapi-createprocessw
+type Process struct {
+	hProcess HPROCESS
+	pid      uint32
+	stdin    *_ProcessPipe
+	stdout   *_ProcessPipe
+	stderr   *_ProcessPipe
+}
+
+// Starts a new process with the given command line, which includes the
+// executable and its arguments; opts can be nil.
+//
+// If the output is redirected, it must be consumed while the process runs,
+// otherwise the process will block when the pipe buffer is full.
+//
+// ⚠️ You must defer Process.Close().
+//
+// # Example
+//
+// Running a compiler, and streaming its output into an edit control:
+//
+//	var wnd ui.WindowMain // initialized somewhere
+//	var txtOutput ui.Edit
+//
+//	p, err := win.ProcessStart("go build ./...", &win.ProcessOpts{
+//		Dir:         "C:\\Projects\\foo",
+//		Flags:       co.CREATE_NO_WINDOW,
+//		PipeStdout:  true,
+//		MergeStderr: true,
+//	})
+//	if err != nil {
+//		panic(err)
+//	}
+//
+//	go func() {
+//		defer p.Close()
+//		scanner := bufio.NewScanner(p.Stdout())
+//		for scanner.Scan() {
+//			line := scanner.Text()
+//			wnd.RunUiThread(func() {
+//				txtOutput.ReplaceSelection(line + "\r\n")
+//			})
+//		}
+//		p.Wait(win.NumInfInfinite())
+//		exitCode, _ := p.ExitCode()
+//		println("Exit code:", exitCode)
+//	}()
+//
+// Running a helper tool which is killed when the current process exits, even
+// if it crashes:
+//
+//	hJob, _ := win.CreateJobObject(nil, win.StrOptNone())
+//	defer hJob.CloseHandle()
+//
+//	var info win.JOBOBJECT_EXTENDED_LIMIT_INFORMATION
+//	info.BasicLimitInformation.LimitFlags = co.JOB_OBJECT_LIMIT_KILL_ON_JOB_CLOSE
+//	hJob.SetInformationJobObject(
+//		co.JOB_INFO_ExtendedLimitInformation,
+//		unsafe.Pointer(&info),
+//		uint32(unsafe.Sizeof(info)),
+//	)
+//
+//	p, _ := win.ProcessStart("helper.exe", &win.ProcessOpts{Job: hJob})
+//	defer p.Close()
+func ProcessStart(cmdLine string, opts *ProcessOpts) (*Process, error) {
+	if opts == nil {
+		opts = &ProcessOpts{}
+	}
+
+	// Prevents other processes, created concurrently, from inheriting the
+	// handles of our pipes.
+	syscall.ForkLock.Lock()
+	defer syscall.ForkLock.Unlock()
+
+	me := &Process{}
+	var childEnds []HPIPE // to be closed after the process is created
+	defer func() {
+		for _, hPipe := range childEnds {
+			hPipe.CloseHandle()
+		}
+	}()
+
+	si := STARTUPINFO{}
+	si.SetCb()
+
+	if opts.PipeStdin {
+		parentEnd, childEnd, err := _ProcessCreatePipe(false)
+		if err != nil {
+			me.closePipes()
+			return nil, err
+		}
+		me.stdin = &_ProcessPipe{hPipe: parentEnd}
+		childEnds = append(childEnds, childEnd)
+		si.HStdInput = uintptr(childEnd)
+	}
+
+	if opts.PipeStdout || opts.MergeStderr {
+		parentEnd, childEnd, err := _ProcessCreatePipe(true)
+		if err != nil {
+			me.closePipes()
+			return nil, err
+		}
+		me.stdout = &_ProcessPipe{hPipe: parentEnd}
+		childEnds = append(childEnds, childEnd)
+		si.HStdOutput = uintptr(childEnd)
+		if opts.MergeStderr {
+			si.HStdError = uintptr(childEnd)
+		}
+	}
+
+	if opts.PipeStderr && !opts.MergeStderr {
+		parentEnd, childEnd, err := _ProcessCreatePipe(true)
+		if err != nil {
+			me.closePipes()
+			return nil, err
+		}
+		me.stderr = &_ProcessPipe{hPipe: parentEnd}
+		childEnds = append(childEnds, childEnd)
+		si.HStdError = uintptr(childEnd)
+	}
+
+	var inheritHandles []HANDLE // only these are inherited by the child
+	if opts.PipeStdin || opts.PipeStdout || opts.PipeStderr || opts.MergeStderr {
+		si.DwFlags |= co.STARTF_USESTDHANDLES
+		for _, std := range []struct {
+			id co.STD
+			h  *uintptr
+		}{
+			{co.STD_INPUT_HANDLE, &si.HStdInput},
+			{co.STD_OUTPUT_HANDLE, &si.HStdOutput},
+			{co.STD_ERROR_HANDLE, &si.HStdError},
+		} {
+			if *std.h == 0 { // not redirected, so the child receives our own
+				if hStd, err := GetStdHandle(std.id); err == nil && hStd != 0 &&
+					hStd.SetHandleInformation(co.HANDLE_FLAG_INHERIT, co.HANDLE_FLAG_INHERIT) == nil {
+
+					*std.h = uintptr(hStd)
+				}
+			}
+			if *std.h != 0 {
+				inheritHandles = _ProcessAppendHandle(inheritHandles, HANDLE(*std.h))
+			}
+		}
+	}
+
+	var env map[string]string
+	if opts.Env != nil {
+		env = _ProcessMergeEnv(opts.Env)
+	}
+
+	dir := StrOptNone()
+	if opts.Dir != "" {
+		dir = StrOptSome(opts.Dir)
+	}
+
+	flags := opts.Flags
+	if opts.Job != 0 {
+		flags |= co.CREATE_SUSPENDED // so it doesn't run, or spawn children, outside the job
+	}
+
+	var pi PROCESS_INFORMATION
+	err := CreateProcessWithHandles(StrOptNone(), StrOptSome(cmdLine), nil, nil,
+		inheritHandles, flags, env, dir, &si, &pi)
+	if err != nil {
+		me.closePipes()
+		return nil, fmt.Errorf("CreateProcess: %w", err)
+	}
+	defer pi.HThread.CloseHandle()
+
+	if opts.Job != 0 {
+		if err := opts.Job.AssignProcessToJobObject(pi.HProcess); err != nil {
+			pi.HProcess.TerminateProcess(1)
+			pi.HProcess.CloseHandle()
+			me.closePipes()
+			return nil, fmt.Errorf("AssignProcessToJobObject: %w", err)
+		}
+		if (opts.Flags & co.CREATE_SUSPENDED) == 0 {
+			if _, err := pi.HThread.ResumeThread(); err != nil {
+				pi.HProcess.TerminateProcess(1)
+				pi.HProcess.CloseHandle()
+				me.closePipes()
+				return nil, fmt.Errorf("ResumeThread: %w", err)
+			}
+		}
+	}
+
+	me.hProcess = pi.HProcess
+	me.pid = pi.DwProcessId
+	return me, nil
+}
+
+// Creates an anonymous pipe whose child end is inheritable, and whose parent
+// end is not.
+func _ProcessCreatePipe(childWrites bool) (parentEnd, childEnd HPIPE, e error) {
+	sa := SECURITY_ATTRIBUTES{}
+	sa.SetNLength()
+	sa.SetBInheritHandle(true)
+
+	hRead, hWrite, err := CreatePipe(&sa, 0)
+	if err != nil {
+		return HPIPE(0), HPIPE(0), fmt.Errorf("CreatePipe: %w", err)
+	}
+
+	if childWrites {
+		parentEnd, childEnd = hRead, hWrite
+	} else {
+		parentEnd, childEnd = hWrite, hRead
+	}
+
+	if err := parentEnd.SetHandleInformation(
+		co.HANDLE_FLAG_INHERIT, co.HANDLE_FLAG_NONE); err != nil {
+
+		hRead.CloseHandle()
+		hWrite.CloseHandle()
+		return HPIPE(0), HPIPE(0), fmt.Errorf("SetHandleInformation: %w", err)
+	}
+	return parentEnd, childEnd, nil
+}
+
+// Appends the handle to the list, unless it's already there, since duplicated
+// handles are rejected by PROC_THREAD_ATTRIBUTE_HANDLE_LIST.
+func _ProcessAppendHandle(handles []HANDLE, h HANDLE) []HANDLE {
+	for _, existing := range handles {
+		if existing == h {
+			return handles
+		}
+	}
+	return append(handles, h)
+}
+
+// Merges the given variables over the current environment. Names are case
+// insensitive.
+func _ProcessMergeEnv(vars map[string]string) map[string]string {
+	env := make(map[string]string, len(vars)+32)
+	for _, pair := range os.Environ() {
+		if idx := strings.Index(pair[1:], "="); idx != -1 { // names may start with "="
+			env[pair[:idx+1]] = pair[idx+2:]
+		}
+	}
+
+	for name, val := range vars {
+		for curName := range env {
+			if strings.EqualFold(curName, name) {
+				delete(env, curName)
+			}
+		}
+		if val != "" {
+			env[name] = val
+		}
+	}
+	return env
+}
+
+func (me *Process) closePipes() {
+	for _, pipe := range []*_ProcessPipe{me.stdin, me.stdout, me.stderr} {
+		if pipe != nil {
+			pipe.Close()
+		}
+	}
+}
+
+// Closes the pipes and the process handle. The process itself is not
+// terminated; to do so, call Process.Kill() before.
+//
+// Calls [CloseHandle].
+//
+// [CloseHandle]: https://learn.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
+func (me *Process) Close() error {
+	me.closePipes()
+
+	var e error
+	if me.hProcess != 0 {
+		e = me.hProcess.CloseHandle()
+		me.hProcess = 0
+	}
+	return e
+}
+
+// Returns the exit code of the process, and true; if the process is still
+// running, returns false.
+//
+// Calls [GetExitCodeProcess].
+//
+// [GetExitCodeProcess]: https://learn.microsoft.com/en-us/windows/win32/api/processthreadsapi/nf-processthreadsapi-getexitcodeprocess
+func (me *Process) ExitCode() (uint32, bool) {
+	if exited, err := me.Wait(NumInfNumeric(0)); err != nil {
+		panic(err)
+	} else if !exited {
+		return 0, false // STILL_ACTIVE could be a legit exit code, so we wait
+	}
+
+	exitCode, err := me.hProcess.GetExitCodeProcess()
+	if err != nil {
+		panic(err)
+	}
+	return exitCode, true
+}
+
+// Returns the underlying handle.
+func (me *Process) Hprocess() HPROCESS {
+	return me.hProcess
+}
+
+// Terminates the process with exit code 1.
+//
+// Calls [TerminateProcess].
+//
+// [TerminateProcess]: https://learn.microsoft.com/en-us/windows/win32/api/processthreadsapi/nf-processthreadsapi-terminateprocess
+func (me *Process) Kill() error {
+	if err := me.hProcess.TerminateProcess(1); err != nil {
+		return fmt.Errorf("TerminateProcess: %w", err)
+	}
+	return nil
+}
+
+// Returns the process ID.
+func (me *Process) Pid() uint32 {
+	return me.pid
+}
+
+// Returns the standard error of the process, or nil if it was not redirected
+// with ProcessOpts.PipeStderr.
+func (me *Process) Stderr() io.Reader {
+	if me.stderr == nil {
+		return nil
+	}
+	return me.stderr
+}
+
+// Returns the standard input of the process, or nil if it was not redirected
+// with ProcessOpts.PipeStdin.
+//
+// Close it to signal the end of the input to the process.
+func (me *Process) Stdin() io.WriteCloser {
+	if me.stdin == nil {
+		return nil
+	}
+	return me.stdin
+}
+
+// Returns the standard output of the process, or nil if it was not redirected
+// with ProcessOpts.PipeStdout or ProcessOpts.MergeStderr.
+//
+// The reader returns io.EOF when the process exits and closes its output.
+func (me *Process) Stdout() io.Reader {
+	if me.stdout == nil {
+		return nil
+	}
+	return me.stdout
+}
+
+// Waits for the process to exit, up to the given timeout. Returns true if the
+// process exited, or false if the timeout elapsed.
+//
+// Calls [WaitForSingleObject].
+//
+// [WaitForSingleObject]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-waitforsingleobject
+func (me *Process) Wait(milliseconds NumInf) (bool, error) {
+	ret, err := me.hProcess.WaitForSingleObject(milliseconds)
+	if err != nil {
+		return false, fmt.Errorf("WaitForSingleObject: %w", err)
+	}
+	return ret == co.WAIT_OBJECT_0, nil
+}
+
+//------------------------------------------------------------------------------
+
+// Parent end of an anonymous pipe connected to a child process.
+type _ProcessPipe struct {
+	hPipe HPIPE
+}
+
+func (me *_ProcessPipe) Close() error {
+	var e error
+	if me.hPipe != 0 {
+		e = me.hPipe.CloseHandle()
+		me.hPipe = 0
+	}
+	return e
+}
+
+func (me *_ProcessPipe) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	numRead, err := me.hPipe.ReadFile(p, nil)
+	if err == errco.BROKEN_PIPE { // the child closed its end
+		return int(numRead), io.EOF
+	} else if err != nil {
+		return int(numRead), fmt.Errorf("ReadFile: %w", err)
+	}
+	return int(numRead), nil
+}
+
+func (me *_ProcessPipe) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	written, err := me.hPipe.WriteFile(p, nil)
+	if err != nil {
+		return int(written), fmt.Errorf("WriteFile: %w", err)
+	}
+	return int(written), nil
+}
@@ -0,0 +1,187 @@
+//go:build windows
+
+package win
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/regfile"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Exports the given subkey of a predefined key, with all its values and
+// subkeys, into a regfile.File, which can be saved as a .reg file. If subKey is
+// empty, the whole predefined key is exported.
+//
+// The values are exported with their raw data, so they're kept as they are.
+//
+// Panics if hKeyRoot is not one of HKEY_CLASSES_ROOT, HKEY_CURRENT_USER,
+// HKEY_LOCAL_MACHINE, HKEY_USERS or HKEY_CURRENT_CONFIG.
+//
+// # Example
+//
+//	file, _ := win.RegExport(win.HKEY_CURRENT_USER, "Software\\MyApp")
+//	os.WriteFile("C:\\Temp\\backup.reg", file.Bytes(), 0o644)
+func RegExport(hKeyRoot HKEY, subKey string) (*regfile.File, error) {
+	path := _RegRootName(hKeyRoot)
+	if path == "" {
+		panic(fmt.Sprintf("Cannot export from non-predefined key %#x.", hKeyRoot))
+	}
+	if subKey != "" {
+		path += "\\" + subKey
+	}
+
+	hKey, err := hKeyRoot.RegOpenKeyEx(subKey, co.REG_OPTION_NONE, co.KEY_READ)
+	if err != nil {
+		return nil, fmt.Errorf("RegOpenKeyEx %s: %w", path, err)
+	}
+	defer hKey.RegCloseKey()
+
+	file := &regfile.File{}
+	if err := _RegExportKey(hKey, path, file); err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// Appends the key and, recursively, its subkeys to the file.
+func _RegExportKey(hKey HKEY, path string, file *regfile.File) error {
+	values, err := hKey.RegEnumValue()
+	if err != nil {
+		return fmt.Errorf("RegEnumValue %s: %w", path, err)
+	}
+
+	key := regfile.Key{
+		Path:   path,
+		Values: make([]regfile.Value, 0, len(values)),
+	}
+	for _, value := range values {
+		key.Values = append(key.Values, regfile.Value{
+			Name: value.Name,
+			Type: regfile.TYPE(value.Type),
+			Data: value.Val.val,
+		})
+	}
+	file.Keys = append(file.Keys, key)
+
+	subKeys, err := hKey.RegEnumKeyEx()
+	if err != nil {
+		return fmt.Errorf("RegEnumKeyEx %s: %w", path, err)
+	}
+	for _, subKey := range subKeys {
+		subPath := path + "\\" + subKey
+		hSubKey, err := hKey.RegOpenKeyEx(subKey, co.REG_OPTION_NONE, co.KEY_READ)
+		if err != nil {
+			return fmt.Errorf("RegOpenKeyEx %s: %w", subPath, err)
+		}
+		err = _RegExportKey(hSubKey, subPath, file)
+		hSubKey.RegCloseKey()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Imports the keys and values of a regfile.File, usually parsed from a .reg
+// file, into the registry, in file order. Keys are created if they don't exist,
+// and keys and values marked for deletion are deleted, if they exist.
+//
+// The root of each key path can be written in full, like HKEY_CURRENT_USER, or
+// abbreviated, like HKCU.
+//
+// # Example
+//
+//	data, _ := os.ReadFile("C:\\Temp\\backup.reg")
+//	file, _ := regfile.Parse(data)
+//	err := win.RegImport(file)
+func RegImport(file *regfile.File) error {
+	for i := range file.Keys {
+		if err := _RegImportKey(&file.Keys[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _RegImportKey(key *regfile.Key) error {
+	rootName, subKey, _ := strings.Cut(key.Path, "\\")
+	hKeyRoot, ok := _RegRootKey(rootName)
+	if !ok {
+		return fmt.Errorf("invalid root key in %s", key.Path)
+	}
+
+	if key.Delete {
+		if subKey == "" {
+			return fmt.Errorf("cannot delete root key %s", key.Path)
+		}
+		if err := hKeyRoot.RegDeleteTree(subKey); err != nil && err != errco.FILE_NOT_FOUND {
+			return fmt.Errorf("RegDeleteTree %s: %w", key.Path, err)
+		}
+		return nil
+	}
+
+	hKey, _, err := hKeyRoot.RegCreateKeyEx(subKey,
+		co.REG_OPTION_NON_VOLATILE, co.KEY_SET_VALUE, nil)
+	if err != nil {
+		return fmt.Errorf("RegCreateKeyEx %s: %w", key.Path, err)
+	}
+	defer hKey.RegCloseKey()
+
+	for i := range key.Values {
+		value := &key.Values[i]
+		if value.Delete {
+			if err := hKey.RegDeleteKeyValue("", value.Name); err != nil && err != errco.FILE_NOT_FOUND {
+				return fmt.Errorf("RegDeleteKeyValue %s\\%s: %w", key.Path, value.Name, err)
+			}
+			continue
+		}
+
+		regVal := RegVal{
+			ty:  co.REG(value.Type),
+			val: value.Data,
+		}
+		if err := hKey.RegSetKeyValue(StrOptNone(), StrOptSome(value.Name), regVal); err != nil {
+			return fmt.Errorf("RegSetKeyValue %s\\%s: %w", key.Path, value.Name, err)
+		}
+	}
+	return nil
+}
+
+// Returns the full name of a predefined key, or an empty string.
+func _RegRootName(hKey HKEY) string {
+	switch hKey {
+	case HKEY_CLASSES_ROOT:
+		return "HKEY_CLASSES_ROOT"
+	case HKEY_CURRENT_USER:
+		return "HKEY_CURRENT_USER"
+	case HKEY_LOCAL_MACHINE:
+		return "HKEY_LOCAL_MACHINE"
+	case HKEY_USERS:
+		return "HKEY_USERS"
+	case HKEY_CURRENT_CONFIG:
+		return "HKEY_CURRENT_CONFIG"
+	default:
+		return ""
+	}
+}
+
+// Returns the predefined key with the given name, full or abbreviated.
+func _RegRootKey(name string) (HKEY, bool) {
+	switch strings.ToUpper(name) {
+	case "HKEY_CLASSES_ROOT", "HKCR":
+		return HKEY_CLASSES_ROOT, true
+	case "HKEY_CURRENT_USER", "HKCU":
+		return HKEY_CURRENT_USER, true
+	case "HKEY_LOCAL_MACHINE", "HKLM":
+		return HKEY_LOCAL_MACHINE, true
+	case "HKEY_USERS", "HKU":
+		return HKEY_USERS, true
+	case "HKEY_CURRENT_CONFIG", "HKCC":
+		return HKEY_CURRENT_CONFIG, true
+	default:
+		return HKEY(0), false
+	}
+}
@@ -0,0 +1,287 @@
+//go:build windows
+
+package win
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Loads the values of the registry key into the fields of the struct pointed
+// by dest. Values which don't exist leave their fields untouched, so default
+// values can be set before the call.
+//
+// Each exported field is mapped to the value with its name, unless a tag in
+// the form `reg:"Name,type"` is given, where both parts are optional. A tag
+// `reg:"-"` ignores the field. The supported types are:
+//
+//   - sz and expand_sz: string fields;
+//   - dword and qword: bool and integer fields;
+//   - binary: []byte fields;
+//   - multi_sz: []string fields.
+//
+// If the type is omitted, it's inferred from the field: qword for 64-bit
+// integers, dword for other integers and bool, sz for strings, binary for
+// []byte and multi_sz for []string. When loading, any of the compatible types
+// is accepted; REG_EXPAND_SZ values are not expanded.
+//
+// Panics if dest is not a pointer to a struct, or if a field has an invalid
+// type or tag.
+//
+// # Example
+//
+//	type Settings struct {
+//		Server   string `reg:"ServerName"`
+//		Port     uint32 `reg:",dword"`
+//		Verbose  bool
+//		LogDir   string `reg:"LogDir,expand_sz"`
+//		Internal int    `reg:"-"`
+//	}
+//
+//	settings := Settings{Port: 8080} // default values
+//	err := win.RegLoadStruct(win.HKEY_CURRENT_USER,
+//		"Software\\MyApp", &settings)
+func RegLoadStruct(hKey HKEY, subKey string, dest interface{}) error {
+	fields := _RegStructFields(dest)
+
+	hKeyLoad, err := hKey.RegOpenKeyEx(subKey, co.REG_OPTION_NONE, co.KEY_QUERY_VALUE)
+	if err != nil {
+		return fmt.Errorf("RegOpenKeyEx: %w", err)
+	}
+	defer hKeyLoad.RegCloseKey()
+
+	for _, field := range fields {
+		regVal, err := hKeyLoad.RegGetValue(StrOptNone(), StrOptSome(field.name))
+		if err == errco.FILE_NOT_FOUND {
+			continue // keep the current field value
+		} else if err != nil {
+			return fmt.Errorf("RegGetValue %s: %w", field.name, err)
+		}
+		if err := field.load(&regVal); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Saves the fields of the struct pointed by src into values of the registry
+// key, which is created if it doesn't exist. The fields are mapped as in
+// RegLoadStruct().
+//
+// Panics if src is not a pointer to a struct, or if a field has an invalid type
+// or tag.
+//
+// # Example
+//
+//	type Settings struct {
+//		Server string `reg:"ServerName"`
+//		Port   uint32 `reg:",dword"`
+//	}
+//
+//	settings := Settings{Server: "localhost", Port: 8080}
+//	err := win.RegSaveStruct(win.HKEY_CURRENT_USER,
+//		"Software\\MyApp", &settings)
+func RegSaveStruct(hKey HKEY, subKey string, src interface{}) error {
+	fields := _RegStructFields(src)
+
+	subKeyOpt := StrOptNone()
+	if subKey != "" {
+		subKeyOpt = StrOptSome(subKey)
+	}
+
+	for _, field := range fields {
+		if err := hKey.RegSetKeyValue(
+			subKeyOpt, StrOptSome(field.name), field.save()); err != nil {
+
+			return fmt.Errorf("RegSetKeyValue %s: %w", field.name, err)
+		}
+	}
+	return nil
+}
+
+//------------------------------------------------------------------------------
+
+// A struct field mapped to a registry value.
+type _RegStructField struct {
+	name  string
+	ty    co.REG
+	value reflect.Value
+}
+
+// Parses the fields of the struct pointed by ptr, panicking on errors.
+func _RegStructFields(ptr interface{}) []_RegStructField {
+	rv := reflect.ValueOf(ptr)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("Expected a pointer to struct, got %T.", ptr))
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	fields := make([]_RegStructField, 0, rt.NumField())
+	for i := 0; i < rt.NumField(); i++ {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		tag := sf.Tag.Get("reg")
+		if tag == "-" {
+			continue
+		}
+		name, tyName, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = sf.Name
+		}
+
+		ty, ok := _RegStructType(sf.Type, tyName)
+		if !ok {
+			panic(fmt.Sprintf("Invalid registry type %q for field %s of type %s.",
+				tyName, sf.Name, sf.Type))
+		}
+		fields = append(fields, _RegStructField{name, ty, rv.Field(i)})
+	}
+	return fields
+}
+
+// Validates the type name given in the tag against the field type, or infers
+// it, if not given.
+func _RegStructType(goType reflect.Type, tyName string) (co.REG, bool) {
+	switch goType.Kind() {
+	case reflect.String:
+		switch tyName {
+		case "", "sz":
+			return co.REG_SZ, true
+		case "expand_sz":
+			return co.REG_EXPAND_SZ, true
+		}
+	case reflect.Bool,
+		reflect.Int8, reflect.Int16, reflect.Int32,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		switch tyName {
+		case "", "dword":
+			return co.REG_DWORD, true
+		case "qword":
+			return co.REG_QWORD, true
+		}
+	case reflect.Int, reflect.Int64, reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		switch tyName {
+		case "", "qword":
+			return co.REG_QWORD, true
+		case "dword":
+			return co.REG_DWORD, true
+		}
+	case reflect.Slice:
+		switch goType.Elem().Kind() {
+		case reflect.Uint8:
+			switch tyName {
+			case "", "binary":
+				return co.REG_BINARY, true
+			}
+		case reflect.String:
+			if goType.Elem() != reflect.TypeOf("") {
+				break // named string types can't be converted
+			}
+			switch tyName {
+			case "", "multi_sz":
+				return co.REG_MULTI_SZ, true
+			}
+		}
+	}
+	return co.REG_NONE, false
+}
+
+// Stores the registry value into the field.
+func (me *_RegStructField) load(regVal *RegVal) error {
+	mismatch := func() error {
+		return fmt.Errorf("registry value %s has type %d, incompatible with %s",
+			me.name, regVal.Type(), me.value.Type())
+	}
+
+	switch me.value.Kind() {
+	case reflect.String:
+		if s, ok := regVal.Sz(); ok {
+			me.value.SetString(s)
+		} else if s, ok := regVal.ExpandSz(); ok {
+			me.value.SetString(s)
+		} else {
+			return mismatch()
+		}
+
+	case reflect.Slice:
+		if me.ty == co.REG_MULTI_SZ {
+			if strs, ok := regVal.MultiSz(); ok {
+				me.value.Set(reflect.ValueOf(strs).Convert(me.value.Type()))
+			} else {
+				return mismatch()
+			}
+		} else if data, ok := regVal.Binary(); ok {
+			me.value.SetBytes(data)
+		} else {
+			return mismatch()
+		}
+
+	default: // bool and integers
+		var n uint64
+		if n32, ok := regVal.Dword(); ok {
+			n = uint64(n32)
+		} else if n64, ok := regVal.Qword(); ok {
+			n = n64
+		} else {
+			return mismatch()
+		}
+
+		switch me.value.Kind() {
+		case reflect.Bool:
+			me.value.SetBool(n != 0)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			signed := int64(n)
+			if regVal.Type() == co.REG_DWORD {
+				signed = int64(int32(uint32(n))) // negative numbers are saved as 32-bit
+			}
+			if me.value.OverflowInt(signed) {
+				return fmt.Errorf("registry value %s overflows %s", me.name, me.value.Type())
+			}
+			me.value.SetInt(signed)
+		default:
+			if me.value.OverflowUint(n) {
+				return fmt.Errorf("registry value %s overflows %s", me.name, me.value.Type())
+			}
+			me.value.SetUint(n)
+		}
+	}
+	return nil
+}
+
+// Converts the field into a registry value.
+func (me *_RegStructField) save() RegVal {
+	var n uint64
+	switch me.value.Kind() {
+	case reflect.String:
+		if me.ty == co.REG_EXPAND_SZ {
+			return RegValExpandSz(me.value.String())
+		}
+		return RegValSz(me.value.String())
+	case reflect.Slice:
+		if me.ty == co.REG_MULTI_SZ {
+			return RegValMultiSz(me.value.Convert(reflect.TypeOf([]string{})).Interface().([]string))
+		}
+		return RegValBinary(me.value.Bytes())
+	case reflect.Bool:
+		if me.value.Bool() {
+			n = 1
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n = uint64(me.value.Int())
+	default:
+		n = me.value.Uint()
+	}
+
+	if me.ty == co.REG_QWORD {
+		return RegValQword(n)
+	}
+	return RegValDword(uint32(n))
+}
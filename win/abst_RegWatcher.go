@@ -0,0 +1,167 @@
+//go:build windows
+
+package win
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// Watches a registry key for changes, with [RegNotifyChangeKeyValue] running in
+// a goroutine, delivering the notifications on a channel.
+//
+// Created with RegWatch().
+//
+// [RegNotifyChangeKeyValue]: https://learn.microsoft.com/en-us/windows/win32/api/winreg/nf-winreg-regnotifychangekeyvalue
+type RegWatcher struct {
+	hKey     HKEY
+	hEvStop  HEVENT
+	changes  chan struct{}
+	finished chan struct{}
+	err      error
+}
+
+// Starts watching the given subkey for changes; if subKey is empty, hKey itself
+// is watched. The subkey is opened by the watcher, so hKey can be closed
+// afterwards.
+//
+// The registry doesn't tell what changed, so the values must be read again
+// when a notification arrives. Notifications of changes happening before the
+// previous one is received are coalesced into one.
+//
+// ⚠️ You must defer RegWatcher.Close().
+//
+// # Example
+//
+// Reloading the policies whenever they change:
+//
+//	watcher, _ := win.RegWatch(win.HKEY_CURRENT_USER,
+//		"Software\\Policies\\MyApp", true,
+//		co.REG_NOTIFY_CHANGE_NAME|co.REG_NOTIFY_CHANGE_LAST_SET)
+//	defer watcher.Close()
+//
+//	go func() {
+//		for range watcher.Changes() {
+//			reloadPolicies()
+//		}
+//	}()
+func RegWatch(
+	hKey HKEY,
+	subKey string,
+	watchSubtree bool,
+	notifyFilter co.REG_NOTIFY) (*RegWatcher, error) {
+
+	hKeyWatched, err := hKey.RegOpenKeyEx(subKey, co.REG_OPTION_NONE, co.KEY_NOTIFY)
+	if err != nil {
+		return nil, fmt.Errorf("RegOpenKeyEx: %w", err)
+	}
+
+	hEvChange, err := CreateEvent(nil, false, false, StrOptNone())
+	if err != nil {
+		hKeyWatched.RegCloseKey()
+		return nil, fmt.Errorf("CreateEvent: %w", err)
+	}
+
+	hEvStop, err := CreateEvent(nil, true, false, StrOptNone())
+	if err != nil {
+		hEvChange.CloseHandle()
+		hKeyWatched.RegCloseKey()
+		return nil, fmt.Errorf("CreateEvent: %w", err)
+	}
+
+	me := &RegWatcher{
+		hKey:     hKeyWatched,
+		hEvStop:  hEvStop,
+		changes:  make(chan struct{}, 1),
+		finished: make(chan struct{}),
+	}
+
+	// The first notification is registered before returning, so no change made
+	// right after this call is lost.
+	registered := make(chan error)
+	go me.watch(watchSubtree, notifyFilter, hEvChange, registered)
+	if err := <-registered; err != nil {
+		<-me.finished
+		hEvStop.CloseHandle()
+		hKeyWatched.RegCloseKey()
+		return nil, err
+	}
+	return me, nil
+}
+
+func (me *RegWatcher) watch(
+	watchSubtree bool, notifyFilter co.REG_NOTIFY,
+	hEvChange HEVENT, registered chan<- error) {
+
+	defer close(me.finished)
+	defer close(me.changes)
+	defer hEvChange.CloseHandle()
+
+	// Without REG_NOTIFY_THREAD_AGNOSTIC, the registration is tied to the
+	// thread which made it, so all of them are made in this thread.
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	if err := me.hKey.RegNotifyChangeKeyValue(
+		watchSubtree, notifyFilter, hEvChange, true); err != nil {
+
+		registered <- fmt.Errorf("RegNotifyChangeKeyValue: %w", err)
+		return
+	}
+	registered <- nil
+
+	for {
+		ret, err := WaitForMultipleObjects(
+			[]HANDLE{HANDLE(hEvChange), HANDLE(me.hEvStop)}, false, NumInfInfinite())
+		if err != nil {
+			me.err = fmt.Errorf("WaitForMultipleObjects: %w", err)
+			return
+		} else if ret != co.WAIT_OBJECT_0 { // stop requested
+			return
+		}
+
+		// Each registration delivers a single notification, so it's renewed
+		// before the receiver reads the key, and no change is lost.
+		if err := me.hKey.RegNotifyChangeKeyValue(
+			watchSubtree, notifyFilter, hEvChange, true); err != nil {
+
+			me.err = fmt.Errorf("RegNotifyChangeKeyValue: %w", err)
+			return
+		}
+
+		select {
+		case me.changes <- struct{}{}:
+		default: // a notification is already pending
+		}
+	}
+}
+
+// Returns the channel which receives a value whenever the key changes. The
+// channel is closed when the watcher stops, either by RegWatcher.Close() or by
+// an error, which is then returned by RegWatcher.Err().
+func (me *RegWatcher) Changes() <-chan struct{} {
+	return me.changes
+}
+
+// Stops watching, waits for the goroutine to finish, and closes the handles.
+func (me *RegWatcher) Close() error {
+	if me.hKey == 0 {
+		return nil
+	}
+
+	me.hEvStop.SetEvent()
+	<-me.finished
+
+	me.hEvStop.CloseHandle()
+	err := me.hKey.RegCloseKey()
+	me.hKey = 0
+	return err
+}
+
+// Returns the error which stopped the watcher, if any. Should be called after
+// the RegWatcher.Changes() channel is closed.
+func (me *RegWatcher) Err() error {
+	return me.err
+}
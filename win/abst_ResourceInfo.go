@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"unsafe"
 
+	"github.com/rodrigocfd/windigo/versioninfo"
 	"github.com/rodrigocfd/windigo/win/co"
 )
 
@@ -57,6 +58,18 @@ func (me *ResourceInfo) ProductVersion() (major, minor, patch, build uint16) {
 	}
 }
 
+// Parses the whole version resource with versioninfo.Parse(), so it can be
+// modified and serialized back.
+//
+// # Example
+//
+//	resNfo, _ := win.ResourceInfoLoad("C:\\Temp\\foo.exe")
+//	vi, _ := resNfo.VersionInfo()
+//	fmt.Println(vi.Fixed.FileVersion)
+func (me *ResourceInfo) VersionInfo() (*versioninfo.VersionInfo, error) {
+	return versioninfo.Parse(me.resBuf)
+}
+
 // Returns the string information blocks, one per language and code page, which contain several strings.
 func (me *ResourceInfo) Blocks() []ResourceInfoBlock {
 	type _RawBlock struct {
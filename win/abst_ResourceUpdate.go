@@ -0,0 +1,351 @@
+//go:build windows
+
+package win
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/rodrigocfd/windigo/res"
+	"github.com/rodrigocfd/windigo/versioninfo"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// A resource stored in an executable or DLL file.
+type ResourceEntry struct {
+	Type RsrcType
+	Name ResId
+	Lang LANGID
+	Data []byte // Copy of the resource data.
+}
+
+// Loads an executable or DLL file as data, and returns all its resources, with
+// a copy of their data.
+//
+// # Example
+//
+//	entries, _ := win.ResourceEnum("C:\\Temp\\foo.exe")
+//	for _, entry := range entries {
+//		if rt, ok := entry.Type.Rt(); ok && rt == co.RT_MANIFEST {
+//			fmt.Println(string(entry.Data))
+//		}
+//	}
+func ResourceEnum(exePath string) ([]ResourceEntry, error) {
+	hInst, err := LoadLibraryEx(exePath,
+		co.LOAD_LIBRARY_AS_DATAFILE|co.LOAD_LIBRARY_AS_IMAGE_RESOURCE)
+	if err != nil {
+		return nil, fmt.Errorf("LoadLibraryEx: %w", err)
+	}
+	defer hInst.FreeLibrary()
+
+	var rsrcTypes []RsrcType
+	if err := hInst.EnumResourceTypes(func(rsrcType RsrcType) bool {
+		rsrcTypes = append(rsrcTypes, rsrcType)
+		return true
+	}); err == errco.RESOURCE_DATA_NOT_FOUND || err == errco.RESOURCE_TYPE_NOT_FOUND {
+		return []ResourceEntry{}, nil // no resources at all
+	} else if err != nil {
+		return nil, fmt.Errorf("EnumResourceTypes: %w", err)
+	}
+
+	entries := make([]ResourceEntry, 0, len(rsrcTypes))
+	for _, rsrcType := range rsrcTypes {
+		var names []ResId
+		if err := hInst.EnumResourceNames(rsrcType, func(name ResId) bool {
+			names = append(names, name)
+			return true
+		}); err != nil {
+			return nil, fmt.Errorf("EnumResourceNames: %w", err)
+		}
+
+		for _, name := range names {
+			var langs []LANGID
+			if err := hInst.EnumResourceLanguages(rsrcType, name, func(lang LANGID) bool {
+				langs = append(langs, lang)
+				return true
+			}); err != nil {
+				return nil, fmt.Errorf("EnumResourceLanguages: %w", err)
+			}
+
+			for _, lang := range langs {
+				data, err := _ResourceRead(hInst, rsrcType, name, lang)
+				if err != nil {
+					return nil, err
+				}
+				entries = append(entries, ResourceEntry{rsrcType, name, lang, data})
+			}
+		}
+	}
+	return entries, nil
+}
+
+// Returns a copy of the resource data.
+func _ResourceRead(
+	hInst HINSTANCE, rsrcType RsrcType, name ResId, lang LANGID) ([]byte, error) {
+
+	hRsrc, err := hInst.FindResourceEx(name, rsrcType, lang)
+	if err != nil {
+		return nil, fmt.Errorf("FindResourceEx: %w", err)
+	}
+	hMem, err := hInst.LoadResource(hRsrc)
+	if err != nil {
+		return nil, fmt.Errorf("LoadResource: %w", err)
+	}
+	data, err := hInst.LockResource(hRsrc, hMem)
+	if err != nil {
+		return nil, fmt.Errorf("LockResource: %w", err)
+	}
+	return append([]byte{}, data...), nil
+}
+
+//------------------------------------------------------------------------------
+
+// High-level abstraction to HUPDATERSRC, which adds, replaces and deletes the
+// resources of an executable or DLL file.
+//
+// The current resources are read when the update begins, so icon groups and
+// string tables can be merged with the existing ones. No changes are written
+// to the file until ResourceUpdate.Commit() is called.
+//
+// The icon groups, string tables and other formats are handled by res.File,
+// whose changes are then applied to the file.
+//
+// Created with ResourceUpdateBegin().
+type ResourceUpdate struct {
+	hUpd HUPDATERSRC
+	file res.File // current resources, updated along with the changes
+}
+
+// Begins updating the resources of an executable or DLL file. If
+// deleteExisting is true, all the current resources are removed.
+//
+// The file must not be running.
+//
+// ⚠️ You must defer ResourceUpdate.Discard().
+//
+// # Example
+//
+//	upd, _ := win.ResourceUpdateBegin("C:\\Temp\\foo.exe", false)
+//	defer upd.Discard()
+//
+//	icoData, _ := os.ReadFile("C:\\Temp\\app.ico")
+//	lang := win.MAKELANGID(co.LANG_ENGLISH, co.SUBLANG_ENGLISH_US)
+//	upd.SetIconGroup(win.ResIdInt(1), lang, icoData)
+//	upd.SetStrings(lang, map[uint16]string{1001: "Hello"})
+//	upd.Commit()
+func ResourceUpdateBegin(exePath string, deleteExisting bool) (*ResourceUpdate, error) {
+	me := &ResourceUpdate{}
+	if !deleteExisting {
+		entries, err := ResourceEnum(exePath)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			me.file.Set(_ResFromRsrcType(entry.Type), _ResFromResId(entry.Name),
+				uint16(entry.Lang), entry.Data)
+		}
+	}
+
+	hUpd, err := BeginUpdateResource(exePath, deleteExisting)
+	if err != nil {
+		return nil, fmt.Errorf("BeginUpdateResource: %w", err)
+	}
+	me.hUpd = hUpd
+	return me, nil
+}
+
+// Writes all the changes to the file, and ends the update.
+//
+// Calls [EndUpdateResource].
+//
+// [EndUpdateResource]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-endupdateresourcew
+func (me *ResourceUpdate) Commit() error {
+	return me.end(false)
+}
+
+// Ends the update without writing any changes to the file. Does nothing if the
+// update was already committed, so it can be safely deferred.
+//
+// Calls [EndUpdateResource].
+//
+// [EndUpdateResource]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-endupdateresourcew
+func (me *ResourceUpdate) Discard() error {
+	return me.end(true)
+}
+
+func (me *ResourceUpdate) end(discard bool) error {
+	if me.hUpd == 0 {
+		return nil
+	}
+	err := me.hUpd.EndUpdateResource(discard)
+	me.hUpd = 0
+	if err != nil {
+		return fmt.Errorf("EndUpdateResource: %w", err)
+	}
+	return nil
+}
+
+// Returns the resources as they are with the changes made so far.
+func (me *ResourceUpdate) Resources() []ResourceEntry {
+	entries := make([]ResourceEntry, 0, len(me.file.Resources))
+	for _, r := range me.file.Resources {
+		entries = append(entries, ResourceEntry{_RsrcTypeFromRes(r.Type),
+			_ResIdFromRes(r.Name), LANGID(r.Lang), append([]byte{}, r.Data...)})
+	}
+	return entries
+}
+
+// Adds or replaces the resource with the given type, name and language.
+//
+// Calls [UpdateResource].
+//
+// [UpdateResource]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-updateresourcew
+func (me *ResourceUpdate) Set(
+	rsrcType RsrcType, name ResId, lang LANGID, data []byte) error {
+
+	if len(data) == 0 {
+		panic("Resource data cannot be empty.")
+	}
+	if err := me.hUpd.UpdateResource(rsrcType, name, lang, data); err != nil {
+		return fmt.Errorf("UpdateResource: %w", err)
+	}
+	me.file.Set(_ResFromRsrcType(rsrcType), _ResFromResId(name),
+		uint16(lang), append([]byte{}, data...))
+	return nil
+}
+
+// Deletes the resource with the given type, name and language, if it exists.
+//
+// Calls [UpdateResource].
+//
+// [UpdateResource]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-updateresourcew
+func (me *ResourceUpdate) Delete(rsrcType RsrcType, name ResId, lang LANGID) error {
+	ty, resName := _ResFromRsrcType(rsrcType), _ResFromResId(name)
+	if _, ok := me.file.Find(ty, resName, uint16(lang)); !ok {
+		return nil
+	}
+	if err := me.hUpd.UpdateResource(rsrcType, name, lang, nil); err != nil {
+		return fmt.Errorf("UpdateResource: %w", err)
+	}
+	me.file.Delete(ty, resName, uint16(lang))
+	return nil
+}
+
+// Sets the application manifest, stored as the RT_MANIFEST resource with ID 1,
+// replacing any existing one, in any language.
+func (me *ResourceUpdate) SetManifest(lang LANGID, manifest []byte) error {
+	return me.apply(func(f *res.File) error {
+		f.SetManifest(uint16(lang), manifest)
+		return nil
+	})
+}
+
+// Sets the version information, stored as the RT_VERSION resource with ID 1,
+// replacing any existing one, in any language.
+func (me *ResourceUpdate) SetVersionInfo(
+	lang LANGID, vi *versioninfo.VersionInfo) error {
+
+	return me.apply(func(f *res.File) error {
+		f.SetVersionInfo(uint16(lang), vi)
+		return nil
+	})
+}
+
+// Sets an icon group from the contents of an .ico file, replacing any existing
+// group with the same name, in any language.
+//
+// Each image is stored as an RT_ICON resource, and the group refers to them in
+// an RT_GROUP_ICON resource. The RT_ICON resources of the replaced group are
+// deleted, and their IDs are reused.
+//
+// The application icon, shown by Windows Explorer, is the group with the
+// lowest ID.
+func (me *ResourceUpdate) SetIconGroup(name ResId, lang LANGID, icoData []byte) error {
+	return me.apply(func(f *res.File) error {
+		return f.SetIconGroup(_ResFromResId(name), uint16(lang), icoData)
+	})
+}
+
+// Sets strings of the string table, keyed by their IDs, merging them with the
+// existing ones of the same language. An empty string deletes the string.
+//
+// The strings are stored in RT_STRING blocks of 16 strings each, so a whole
+// block is rewritten when one of its strings changes: the string with ID n is
+// stored at index n%16 of the block whose ID is n/16+1.
+func (me *ResourceUpdate) SetStrings(lang LANGID, strs map[uint16]string) error {
+	return me.apply(func(f *res.File) error {
+		return f.SetStrings(uint16(lang), strs)
+	})
+}
+
+// Makes the change on a copy of the resources, then deletes, adds and replaces
+// the resources which differ.
+func (me *ResourceUpdate) apply(change func(f *res.File) error) error {
+	changed := res.File{
+		Resources: append([]res.Resource{}, me.file.Resources...),
+	}
+	if err := change(&changed); err != nil {
+		return err
+	}
+
+	var deleted, set []res.Resource
+	for _, r := range me.file.Resources {
+		if _, ok := changed.Find(r.Type, r.Name, r.Lang); !ok {
+			deleted = append(deleted, r)
+		}
+	}
+	for _, r := range changed.Resources {
+		if cur, ok := me.file.Find(r.Type, r.Name, r.Lang); !ok || !bytes.Equal(cur.Data, r.Data) {
+			set = append(set, r)
+		}
+	}
+
+	for _, r := range deleted {
+		if err := me.Delete(_RsrcTypeFromRes(r.Type), _ResIdFromRes(r.Name),
+			LANGID(r.Lang)); err != nil {
+
+			return err
+		}
+	}
+	for _, r := range set {
+		if err := me.Set(_RsrcTypeFromRes(r.Type), _ResIdFromRes(r.Name),
+			LANGID(r.Lang), r.Data); err != nil {
+
+			return err
+		}
+	}
+	return nil
+}
+
+func _ResFromRsrcType(rsrcType RsrcType) res.Id {
+	if rt, ok := rsrcType.Rt(); ok {
+		return res.IdInt(uint16(rt))
+	}
+	str, _ := rsrcType.Str()
+	return res.IdStr(str)
+}
+
+func _ResFromResId(name ResId) res.Id {
+	if id, ok := name.Id(); ok {
+		return res.IdInt(uint16(id))
+	}
+	str, _ := name.Str()
+	return res.IdStr(str)
+}
+
+func _RsrcTypeFromRes(id res.Id) RsrcType {
+	if num, ok := id.Int(); ok {
+		return RsrcTypeRt(co.RT(num))
+	}
+	str, _ := id.Str()
+	return RsrcTypeStr(str)
+}
+
+func _ResIdFromRes(id res.Id) ResId {
+	if num, ok := id.Int(); ok {
+		return ResIdInt(int(num))
+	}
+	str, _ := id.Str()
+	return ResIdStr(str)
+}
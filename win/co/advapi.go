@@ -50,17 +50,43 @@ const (
 type REG uint32
 
 const (
-	REG_NONE                REG = 0  // No value type.
-	REG_SZ                  REG = 1  // Unicode nul terminated string.
-	REG_EXPAND_SZ           REG = 2  // Unicode nul terminated string (with environment variable references).
-	REG_BINARY              REG = 3  // Free form binary.
-	REG_DWORD               REG = 4  // 32-bit number.
-	REG_DWORD_LITTLE_ENDIAN REG = 4  // 32-bit number (same as REG_DWORD).
-	REG_DWORD_BIG_ENDIAN    REG = 5  // 32-bit number.
-	REG_LINK                REG = 6  // Symbolic Link (unicode).
-	REG_MULTI_SZ            REG = 7  // Multiple Unicode strings.
-	REG_QWORD               REG = 11 // 64-bit number.
-	REG_QWORD_LITTLE_ENDIAN REG = 11 // 64-bit number (same as REG_QWORD).
+	REG_NONE                       REG = 0  // No value type.
+	REG_SZ                         REG = 1  // Unicode nul terminated string.
+	REG_EXPAND_SZ                  REG = 2  // Unicode nul terminated string (with environment variable references).
+	REG_BINARY                     REG = 3  // Free form binary.
+	REG_DWORD                      REG = 4  // 32-bit number.
+	REG_DWORD_LITTLE_ENDIAN        REG = 4  // 32-bit number (same as REG_DWORD).
+	REG_DWORD_BIG_ENDIAN           REG = 5  // 32-bit number.
+	REG_LINK                       REG = 6  // Symbolic Link (unicode).
+	REG_MULTI_SZ                   REG = 7  // Multiple Unicode strings.
+	REG_RESOURCE_LIST              REG = 8  // Resource list in the resource map.
+	REG_FULL_RESOURCE_DESCRIPTOR   REG = 9  // Resource list in the hardware description.
+	REG_RESOURCE_REQUIREMENTS_LIST REG = 10 // Resource requirements list.
+	REG_QWORD                      REG = 11 // 64-bit number.
+	REG_QWORD_LITTLE_ENDIAN        REG = 11 // 64-bit number (same as REG_QWORD).
+)
+
+// [RegCreateKeyEx] lpdwDisposition.
+//
+// [RegCreateKeyEx]: https://learn.microsoft.com/en-us/windows/win32/api/winreg/nf-winreg-regcreatekeyexw
+type REG_DISPOSITION uint32
+
+const (
+	REG_CREATED_NEW_KEY     REG_DISPOSITION = 0x0000_0001 // The key did not exist and was created.
+	REG_OPENED_EXISTING_KEY REG_DISPOSITION = 0x0000_0002 // The key existed and was simply opened.
+)
+
+// [RegNotifyChangeKeyValue] dwNotifyFilter.
+//
+// [RegNotifyChangeKeyValue]: https://learn.microsoft.com/en-us/windows/win32/api/winreg/nf-winreg-regnotifychangekeyvalue
+type REG_NOTIFY uint32
+
+const (
+	REG_NOTIFY_CHANGE_NAME       REG_NOTIFY = 0x0000_0001 // A subkey is added or deleted.
+	REG_NOTIFY_CHANGE_ATTRIBUTES REG_NOTIFY = 0x0000_0002 // Attributes of the key, such as the security descriptor, are changed.
+	REG_NOTIFY_CHANGE_LAST_SET   REG_NOTIFY = 0x0000_0004 // A value of the key is added, deleted or modified.
+	REG_NOTIFY_CHANGE_SECURITY   REG_NOTIFY = 0x0000_0008 // The security descriptor of the key is changed.
+	REG_NOTIFY_THREAD_AGNOSTIC   REG_NOTIFY = 0x1000_0000 // The registration is not tied to the lifetime of the calling thread.
 )
 
 // [RegOpenKeyEx] ulOptions.
@@ -798,6 +798,68 @@ const (
 	TBSTYLE_EX_DOUBLEBUFFER       TBSTYLE_EX = 0x0000_0080
 )
 
+// [TCITEM] mask.
+//
+// [TCITEM]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tcitemw
+type TCIF uint32
+
+const (
+	TCIF_TEXT       TCIF = 0x0001
+	TCIF_IMAGE      TCIF = 0x0002
+	TCIF_RTLREADING TCIF = 0x0004
+	TCIF_PARAM      TCIF = 0x0008
+	TCIF_STATE      TCIF = 0x0010
+)
+
+// [TCITEM] dwState.
+//
+// [TCITEM]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tcitemw
+type TCIS uint32
+
+const (
+	TCIS_BUTTONPRESSED TCIS = 0x0001
+	TCIS_HIGHLIGHTED   TCIS = 0x0002
+)
+
+// Tab control [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/tab-control-styles
+type TCS WS
+
+const (
+	TCS_SCROLLOPPOSITE    TCS = 0x0001 // Unneeded tabs scroll to the opposite side of the control when a tab is selected.
+	TCS_BOTTOM            TCS = 0x0002 // Tabs appear at the bottom of the control. This value equals TCS_RIGHT.
+	TCS_RIGHT             TCS = 0x0002 // Tabs appear vertically on the right side of controls that use the TCS_VERTICAL style. This value equals TCS_BOTTOM.
+	TCS_MULTISELECT       TCS = 0x0004 // Multiple tabs can be selected by holding down the CTRL key when clicking. This style must be used with the TCS_BUTTONS style.
+	TCS_FLATBUTTONS       TCS = 0x0008 // Selected tabs appear as being indented into the background while other tabs appear as being on the same plane as the background. This style only affects tab controls with the TCS_BUTTONS style.
+	TCS_FORCEICONLEFT     TCS = 0x0010 // Icons are aligned with the left edge of each fixed-width tab. This style can only be used with the TCS_FIXEDWIDTH style.
+	TCS_FORCELABELLEFT    TCS = 0x0020 // Labels are aligned with the left edge of each fixed-width tab. This style can only be used with the TCS_FIXEDWIDTH style, and it implies the TCS_FORCEICONLEFT style.
+	TCS_HOTTRACK          TCS = 0x0040 // Items under the pointer are automatically highlighted.
+	TCS_VERTICAL          TCS = 0x0080 // Tabs appear at the left side of the control, with tab text displayed vertically. This style is valid only when used with the TCS_MULTILINE style.
+	TCS_TABS              TCS = 0x0000 // Tabs appear as tabs, and a border is drawn around the display area. This style is the default.
+	TCS_BUTTONS           TCS = 0x0100 // Tabs appear as buttons, and no border is drawn around the display area.
+	TCS_SINGLELINE        TCS = 0x0000 // Only one row of tabs is displayed. This style is the default.
+	TCS_MULTILINE         TCS = 0x0200 // Multiple rows of tabs are displayed, if necessary, so all tabs are visible at once.
+	TCS_RIGHTJUSTIFY      TCS = 0x0000 // The width of each tab is increased, if necessary, so that each row of tabs fills the entire width of the tab control.
+	TCS_FIXEDWIDTH        TCS = 0x0400 // All tabs are the same width. This style cannot be combined with the TCS_RIGHTJUSTIFY style.
+	TCS_RAGGEDRIGHT       TCS = 0x0800 // Rows of tabs will not be stretched to fill the entire width of the control. This style is the default.
+	TCS_FOCUSONBUTTONDOWN TCS = 0x1000 // The tab control receives the input focus when clicked.
+	TCS_OWNERDRAWFIXED    TCS = 0x2000 // The parent window is responsible for drawing tabs.
+	TCS_TOOLTIPS          TCS = 0x4000 // The tab control has a tooltip control associated with it.
+	TCS_FOCUSNEVER        TCS = 0x8000 // The tab control does not receive the input focus when clicked.
+)
+
+// Tab control [extended styles].
+//
+// [extended styles]: https://learn.microsoft.com/en-us/windows/win32/controls/tab-control-extended-styles
+type TCS_EX uint32
+
+const (
+	TCS_EX_NONE           TCS_EX = 0
+	TCS_EX_FLATSEPARATORS TCS_EX = 0x0000_0001 // The tab control will draw separators between the tab items. This extended style only affects tab controls that have the TCS_BUTTONS and TCS_FLATBUTTONS styles.
+	TCS_EX_REGISTERDROP   TCS_EX = 0x0000_0002 // The tab control generates TCN_GETOBJECT notification codes to request a drop target object when an object is dragged over the tab items in the control.
+)
+
 // [TaskDialog] pszIcon. Originally with TD prefix and ICON suffix.
 //
 // [TaskDialog]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/nf-commctrl-taskdialog
@@ -850,6 +912,36 @@ const (
 	TDF_SIZE_TO_CONTENT             TDF = 0x0100_0000
 )
 
+// [TTM_SETDELAYTIME] duration type.
+//
+// [TTM_SETDELAYTIME]: https://learn.microsoft.com/en-us/windows/win32/controls/ttm-setdelaytime
+type TTDT uint32
+
+const (
+	TTDT_AUTOMATIC TTDT = 0 // Sets the three delay times to default proportions.
+	TTDT_RESHOW    TTDT = 1 // Time it takes for subsequent tooltip windows to appear as the pointer moves from one tool to another.
+	TTDT_AUTOPOP   TTDT = 2 // Time the tooltip window remains visible if the pointer is stationary within a tool's bounding rectangle.
+	TTDT_INITIAL   TTDT = 3 // Time the pointer must remain stationary within a tool's bounding rectangle before the tooltip window appears.
+)
+
+// [TTTOOLINFO] uFlags.
+//
+// [TTTOOLINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tttoolinfow
+type TTF uint32
+
+const (
+	TTF_NONE        TTF = 0
+	TTF_IDISHWND    TTF = 0x0001 // The uId member is the window handle to the tool.
+	TTF_CENTERTIP   TTF = 0x0002 // Centers the tooltip window below the tool specified by the uId member.
+	TTF_RTLREADING  TTF = 0x0004 // Indicates that the tooltip text will be displayed in the opposite direction to the text in the parent window.
+	TTF_SUBCLASS    TTF = 0x0010 // Indicates that the tooltip control should subclass the tool's window to intercept messages.
+	TTF_TRACK       TTF = 0x0020 // Positions the tooltip window next to the tool to which it corresponds and moves the window according to coordinates supplied by the TTM_TRACKPOSITION messages.
+	TTF_ABSOLUTE    TTF = 0x0080 // Positions the tooltip window at the same coordinates provided by TTM_TRACKPOSITION.
+	TTF_TRANSPARENT TTF = 0x0100 // Causes the tooltip control to forward mouse event messages to the parent window.
+	TTF_PARSELINKS  TTF = 0x1000 // Indicates that links in the tooltip text should be parsed.
+	TTF_DI_SETITEM  TTF = 0x8000 // Set in NMTTDISPINFO to make the tooltip control retain the supplied information.
+)
+
 // [EDITBALLOONTIP] ttiIcon.
 //
 // [EDITBALLOONTIP]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-editballoontip
@@ -865,6 +957,21 @@ const (
 	TTI_ERROR_LARGE   TTI = 6
 )
 
+// Tooltip control [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/tooltip-styles
+type TTS WS
+
+const (
+	TTS_ALWAYSTIP      TTS = 0x01  // Indicates that the tooltip control appears when the cursor is on a tool, even if the tooltip control's owner window is inactive.
+	TTS_NOPREFIX       TTS = 0x02  // Prevents the system from stripping ampersand characters from a string or terminating a string at a tab character.
+	TTS_NOANIMATE      TTS = 0x10  // Disables sliding tooltip animation.
+	TTS_NOFADE         TTS = 0x20  // Disables fading tooltip animation.
+	TTS_BALLOON        TTS = 0x40  // Indicates that the tooltip control has the appearance of a cartoon "balloon," with rounded corners and a stem pointing to the item.
+	TTS_CLOSE          TTS = 0x80  // Displays a Close button on the tooltip.
+	TTS_USEVISUALSTYLE TTS = 0x100 // Uses themed hyperlinks.
+)
+
 // [TVM_EXPAND] action flag.
 //
 // [TVM_EXPAND]: https://learn.microsoft.com/en-us/windows/win32/controls/tvm-expand
@@ -1011,3 +1118,20 @@ const (
 	TVS_EX_DIMMEDCHECKBOXES    TVS_EX = 0x0200
 	TVS_EX_DRAWIMAGEASYNC      TVS_EX = 0x0400
 )
+
+// UpDown control [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/up-down-control-styles
+type UDS WS
+
+const (
+	UDS_WRAP        UDS = 0x0001 // Causes the position to "wrap" if it is incremented or decremented beyond the ending or beginning of the range.
+	UDS_SETBUDDYINT UDS = 0x0002 // Causes the up-down control to set the text of the buddy window (using the WM_SETTEXT message) when the position changes. The text consists of the position formatted as a decimal or hexadecimal string.
+	UDS_ALIGNRIGHT  UDS = 0x0004 // Positions the up-down control next to the right edge of the buddy window. The width of the buddy window is decreased to accommodate the width of the up-down control.
+	UDS_ALIGNLEFT   UDS = 0x0008 // Positions the up-down control next to the left edge of the buddy window. The buddy window is moved to the right, and its width is decreased to accommodate the width of the up-down control.
+	UDS_AUTOBUDDY   UDS = 0x0010 // Automatically selects the previous window in the z-order as the up-down control's buddy window.
+	UDS_ARROWKEYS   UDS = 0x0020 // Causes the up-down control to increment and decrement the position when the UP ARROW and DOWN ARROW keys are pressed.
+	UDS_HORZ        UDS = 0x0040 // Causes the up-down control's arrows to point left and right instead of up and down.
+	UDS_NOTHOUSANDS UDS = 0x0080 // Does not insert a thousands separator between every three decimal digits.
+	UDS_HOTTRACK    UDS = 0x0100 // Causes the control to exhibit "hot tracking" behavior.
+)
@@ -310,6 +310,17 @@ const (
 	GMEM_GPTR     GMEM = GMEM_FIXED | GMEM_ZEROINIT
 )
 
+// [SetHandleInformation] flags.
+//
+// [SetHandleInformation]: https://learn.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-sethandleinformation
+type HANDLE_FLAG uint32
+
+const (
+	HANDLE_FLAG_NONE               HANDLE_FLAG = 0
+	HANDLE_FLAG_INHERIT            HANDLE_FLAG = 0x0000_0001
+	HANDLE_FLAG_PROTECT_FROM_CLOSE HANDLE_FLAG = 0x0000_0002
+)
+
 // [HeapAlloc] flags.
 //
 // [HeapAlloc]: https://learn.microsoft.com/en-us/windows/win32/api/heapapi/nf-heapapi-heapalloc
@@ -366,6 +377,65 @@ const (
 	HEAP_REALLOC_ZERO_MEMORY           HEAP_REALLOC = 0x0000_0008
 )
 
+// [JOBOBJECTINFOCLASS] enumeration.
+//
+// [JOBOBJECTINFOCLASS]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi2/nf-jobapi2-setinformationjobobject
+type JOB_INFO uint32
+
+const (
+	JOB_INFO_BasicAccountingInformation         JOB_INFO = 1
+	JOB_INFO_BasicLimitInformation              JOB_INFO = 2
+	JOB_INFO_BasicProcessIdList                 JOB_INFO = 3
+	JOB_INFO_BasicUIRestrictions                JOB_INFO = 4
+	JOB_INFO_EndOfJobTimeInformation            JOB_INFO = 6
+	JOB_INFO_AssociateCompletionPortInformation JOB_INFO = 7
+	JOB_INFO_BasicAndIoAccountingInformation    JOB_INFO = 8
+	JOB_INFO_ExtendedLimitInformation           JOB_INFO = 9
+	JOB_INFO_GroupInformation                   JOB_INFO = 11
+	JOB_INFO_NotificationLimitInformation       JOB_INFO = 12
+	JOB_INFO_LimitViolationInformation          JOB_INFO = 13
+	JOB_INFO_GroupInformationEx                 JOB_INFO = 14
+	JOB_INFO_CpuRateControlInformation          JOB_INFO = 15
+)
+
+// [JOBOBJECT_CPU_RATE_CONTROL_INFORMATION] ControlFlags.
+//
+// [JOBOBJECT_CPU_RATE_CONTROL_INFORMATION]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-jobobject_cpu_rate_control_information
+type JOB_OBJECT_CPU_RATE_CONTROL uint32
+
+const (
+	JOB_OBJECT_CPU_RATE_CONTROL_ENABLE       JOB_OBJECT_CPU_RATE_CONTROL = 0x1
+	JOB_OBJECT_CPU_RATE_CONTROL_WEIGHT_BASED JOB_OBJECT_CPU_RATE_CONTROL = 0x2
+	JOB_OBJECT_CPU_RATE_CONTROL_HARD_CAP     JOB_OBJECT_CPU_RATE_CONTROL = 0x4
+	JOB_OBJECT_CPU_RATE_CONTROL_NOTIFY       JOB_OBJECT_CPU_RATE_CONTROL = 0x8
+	JOB_OBJECT_CPU_RATE_CONTROL_MIN_MAX_RATE JOB_OBJECT_CPU_RATE_CONTROL = 0x10
+)
+
+// [JOBOBJECT_BASIC_LIMIT_INFORMATION] LimitFlags.
+//
+// [JOBOBJECT_BASIC_LIMIT_INFORMATION]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-jobobject_basic_limit_information
+type JOB_OBJECT_LIMIT uint32
+
+const (
+	JOB_OBJECT_LIMIT_NONE JOB_OBJECT_LIMIT = 0
+
+	JOB_OBJECT_LIMIT_ACTIVE_PROCESS             JOB_OBJECT_LIMIT = 0x0000_0008
+	JOB_OBJECT_LIMIT_AFFINITY                   JOB_OBJECT_LIMIT = 0x0000_0010
+	JOB_OBJECT_LIMIT_BREAKAWAY_OK               JOB_OBJECT_LIMIT = 0x0000_0800
+	JOB_OBJECT_LIMIT_DIE_ON_UNHANDLED_EXCEPTION JOB_OBJECT_LIMIT = 0x0000_0400
+	JOB_OBJECT_LIMIT_JOB_MEMORY                 JOB_OBJECT_LIMIT = 0x0000_0200
+	JOB_OBJECT_LIMIT_JOB_TIME                   JOB_OBJECT_LIMIT = 0x0000_0004
+	JOB_OBJECT_LIMIT_KILL_ON_JOB_CLOSE          JOB_OBJECT_LIMIT = 0x0000_2000
+	JOB_OBJECT_LIMIT_PRESERVE_JOB_TIME          JOB_OBJECT_LIMIT = 0x0000_0040
+	JOB_OBJECT_LIMIT_PRIORITY_CLASS             JOB_OBJECT_LIMIT = 0x0000_0020
+	JOB_OBJECT_LIMIT_PROCESS_MEMORY             JOB_OBJECT_LIMIT = 0x0000_0100
+	JOB_OBJECT_LIMIT_PROCESS_TIME               JOB_OBJECT_LIMIT = 0x0000_0002
+	JOB_OBJECT_LIMIT_SCHEDULING_CLASS           JOB_OBJECT_LIMIT = 0x0000_0080
+	JOB_OBJECT_LIMIT_SILENT_BREAKAWAY_OK        JOB_OBJECT_LIMIT = 0x0000_1000
+	JOB_OBJECT_LIMIT_SUBSET_AFFINITY            JOB_OBJECT_LIMIT = 0x0000_4000
+	JOB_OBJECT_LIMIT_WORKINGSET                 JOB_OBJECT_LIMIT = 0x0000_0001
+)
+
 // [Language] identifier.
 //
 // [Language]: https://learn.microsoft.com/en-us/windows/win32/intl/language-identifier-constants-and-strings
@@ -528,6 +598,29 @@ const (
 	LMEM_GPTR     LMEM = LMEM_FIXED | LMEM_ZEROINIT
 )
 
+// [LoadLibraryEx] dwFlags.
+//
+// [LoadLibraryEx]: https://learn.microsoft.com/en-us/windows/win32/api/libloaderapi/nf-libloaderapi-loadlibraryexw
+type LOAD_LIBRARY uint32
+
+const (
+	LOAD_LIBRARY_NONE                         LOAD_LIBRARY = 0
+	LOAD_LIBRARY_DONT_RESOLVE_DLL_REFERENCES  LOAD_LIBRARY = 0x0000_0001
+	LOAD_LIBRARY_AS_DATAFILE                  LOAD_LIBRARY = 0x0000_0002
+	LOAD_LIBRARY_WITH_ALTERED_SEARCH_PATH     LOAD_LIBRARY = 0x0000_0008
+	LOAD_LIBRARY_IGNORE_CODE_AUTHZ_LEVEL      LOAD_LIBRARY = 0x0000_0010
+	LOAD_LIBRARY_AS_IMAGE_RESOURCE            LOAD_LIBRARY = 0x0000_0020
+	LOAD_LIBRARY_AS_DATAFILE_EXCLUSIVE        LOAD_LIBRARY = 0x0000_0040
+	LOAD_LIBRARY_REQUIRE_SIGNED_TARGET        LOAD_LIBRARY = 0x0000_0080
+	LOAD_LIBRARY_SEARCH_DLL_LOAD_DIR          LOAD_LIBRARY = 0x0000_0100
+	LOAD_LIBRARY_SEARCH_APPLICATION_DIR       LOAD_LIBRARY = 0x0000_0200
+	LOAD_LIBRARY_SEARCH_USER_DIRS             LOAD_LIBRARY = 0x0000_0400
+	LOAD_LIBRARY_SEARCH_SYSTEM32              LOAD_LIBRARY = 0x0000_0800
+	LOAD_LIBRARY_SEARCH_DEFAULT_DIRS          LOAD_LIBRARY = 0x0000_1000
+	LOAD_LIBRARY_SAFE_CURRENT_DIRS            LOAD_LIBRARY = 0x0000_2000
+	LOAD_LIBRARY_SEARCH_SYSTEM32_NO_FORWARDER LOAD_LIBRARY = 0x0000_4000
+)
+
 // [LockFileEx] dwFlags.
 //
 // [LockFileEx]: https://learn.microsoft.com/en-us/windows/win32/api/fileapi/nf-fileapi-lockfileex
@@ -781,6 +874,7 @@ const (
 	SECURITY_DELEGATION       SECURITY = 3 << 16
 	SECURITY_CONTEXT_TRACKING SECURITY = 0x0004_0000
 	SECURITY_EFFECTIVE_ONLY   SECURITY = 0x0008_0000
+	SECURITY_SQOS_PRESENT     SECURITY = 0x0010_0000
 )
 
 // Sort order [identifier] for locales.
@@ -2,11 +2,37 @@
 
 package co
 
+import (
+	"fmt"
+	"syscall"
+)
+
 // A COM [class ID], represented as a string.
 //
 // [class ID]: https://learn.microsoft.com/en-us/windows/win32/com/clsid-key-hklm
 type CLSID string
 
+// An [HRESULT] value, returned by COM methods and some messages.
+//
+// [HRESULT]: https://learn.microsoft.com/en-us/openspecs/windows_protocols/ms-erref/0642cb2f-2075-4469-918c-4441e69c548a
+type HRESULT uint32
+
+// Implements error interface.
+func (hr HRESULT) Error() string {
+	return hr.String()
+}
+
+// Returns the contained syscall.Errno.
+func (hr HRESULT) Unwrap() error {
+	return syscall.Errno(hr)
+}
+
+// Implements fmt.Stringer.
+func (hr HRESULT) String() string {
+	return fmt.Sprintf("[%d 0x%08x] %s",
+		uint32(hr), uint32(hr), hr.Unwrap().Error())
+}
+
 // A COM [interface ID], represented as a string.
 //
 // [interface ID]: https://learn.microsoft.com/en-us/office/client-developer/outlook/mapi/iid
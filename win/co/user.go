@@ -369,6 +369,29 @@ const (
 	DPI_AWARE_CTX_UNAWARE_GDISCALED DPI_AWARE_CTX = -5
 )
 
+// Dialog box [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/dlgbox/dialog-box-styles
+type DS WS
+
+const (
+	DS_ABSALIGN      DS = 0x0001
+	DS_SYSMODAL      DS = 0x0002
+	DS_3DLOOK        DS = 0x0004
+	DS_FIXEDSYS      DS = 0x0008
+	DS_NOFAILCREATE  DS = 0x0010
+	DS_LOCALEDIT     DS = 0x0020
+	DS_SETFONT       DS = 0x0040
+	DS_MODALFRAME    DS = 0x0080
+	DS_NOIDLEMSG     DS = 0x0100
+	DS_SETFOREGROUND DS = 0x0200
+	DS_CONTROL       DS = 0x0400
+	DS_CENTER        DS = 0x0800
+	DS_CENTERMOUSE   DS = 0x1000
+	DS_CONTEXTHELP   DS = 0x2000
+	DS_SHELLFONT     DS = DS_SETFONT | DS_FIXEDSYS
+)
+
 // [EnumDisplayDevices] flags.
 //
 // [EnumDisplayDevices]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-enumdisplaydevicesw
@@ -686,6 +709,31 @@ const (
 	LAYOUT_RTL    LAYOUT = 0x0000_0001
 )
 
+// ListBox control [styles].
+//
+// [styles]: https://learn.microsoft.com/en-us/windows/win32/controls/list-box-styles
+type LBS WS
+
+const (
+	LBS_NOTIFY            LBS = 0x0001
+	LBS_SORT              LBS = 0x0002
+	LBS_NOREDRAW          LBS = 0x0004
+	LBS_MULTIPLESEL       LBS = 0x0008
+	LBS_OWNERDRAWFIXED    LBS = 0x0010
+	LBS_OWNERDRAWVARIABLE LBS = 0x0020
+	LBS_HASSTRINGS        LBS = 0x0040
+	LBS_USETABSTOPS       LBS = 0x0080
+	LBS_NOINTEGRALHEIGHT  LBS = 0x0100
+	LBS_MULTICOLUMN       LBS = 0x0200
+	LBS_WANTKEYBOARDINPUT LBS = 0x0400
+	LBS_EXTENDEDSEL       LBS = 0x0800
+	LBS_DISABLENOSCROLL   LBS = 0x1000
+	LBS_NODATA            LBS = 0x2000
+	LBS_NOSEL             LBS = 0x4000
+	LBS_COMBOBOX          LBS = 0x8000
+	LBS_STANDARD          LBS = LBS_NOTIFY | LBS_SORT | LBS(WS_VSCROLL) | LBS(WS_BORDER)
+)
+
 // [LoadImage] fuLoad.
 //
 // [LoadImage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-loadimagew
@@ -458,6 +458,59 @@ const (
 	EM_GETFILELINECOUNT WM = _ECM_FIRST + 23
 )
 
+// RichEdit control [messages] (EM), in addition to the Edit messages.
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-rich-edit-control-reference-messages
+const (
+	EM_CANPASTE             WM = WM_USER + 50
+	EM_DISPLAYBAND          WM = WM_USER + 51
+	EM_EXGETSEL             WM = WM_USER + 52
+	EM_EXLIMITTEXT          WM = WM_USER + 53
+	EM_EXLINEFROMCHAR       WM = WM_USER + 54
+	EM_EXSETSEL             WM = WM_USER + 55
+	EM_FORMATRANGE          WM = WM_USER + 57
+	EM_GETCHARFORMAT        WM = WM_USER + 58
+	EM_GETEVENTMASK         WM = WM_USER + 59
+	EM_GETOLEINTERFACE      WM = WM_USER + 60
+	EM_GETPARAFORMAT        WM = WM_USER + 61
+	EM_GETSELTEXT           WM = WM_USER + 62
+	EM_HIDESELECTION        WM = WM_USER + 63
+	EM_PASTESPECIAL         WM = WM_USER + 64
+	EM_REQUESTRESIZE        WM = WM_USER + 65
+	EM_SELECTIONTYPE        WM = WM_USER + 66
+	EM_SETBKGNDCOLOR        WM = WM_USER + 67
+	EM_SETCHARFORMAT        WM = WM_USER + 68
+	EM_SETEVENTMASK         WM = WM_USER + 69
+	EM_SETOLECALLBACK       WM = WM_USER + 70
+	EM_SETPARAFORMAT        WM = WM_USER + 71
+	EM_SETTARGETDEVICE      WM = WM_USER + 72
+	EM_STREAMIN             WM = WM_USER + 73
+	EM_STREAMOUT            WM = WM_USER + 74
+	EM_GETTEXTRANGE         WM = WM_USER + 75
+	EM_FINDWORDBREAK        WM = WM_USER + 76
+	EM_SETOPTIONS           WM = WM_USER + 77
+	EM_GETOPTIONS           WM = WM_USER + 78
+	EM_SETUNDOLIMIT         WM = WM_USER + 82
+	EM_REDO                 WM = WM_USER + 84
+	EM_CANREDO              WM = WM_USER + 85
+	EM_GETUNDONAME          WM = WM_USER + 86
+	EM_GETREDONAME          WM = WM_USER + 87
+	EM_STOPGROUPTYPING      WM = WM_USER + 88
+	EM_SETTEXTMODE          WM = WM_USER + 89
+	EM_GETTEXTMODE          WM = WM_USER + 90
+	EM_AUTOURLDETECT        WM = WM_USER + 91
+	EM_GETAUTOURLDETECT     WM = WM_USER + 92
+	EM_SETPALETTE           WM = WM_USER + 93
+	EM_GETTEXTEX            WM = WM_USER + 94
+	EM_GETTEXTLENGTHEX      WM = WM_USER + 95
+	EM_SHOWSCROLLBAR        WM = WM_USER + 96
+	EM_SETTEXTEX            WM = WM_USER + 97
+	EM_FINDTEXT             WM = WM_USER + 123
+	EM_FINDTEXTEX           WM = WM_USER + 124
+	EM_SETTYPOGRAPHYOPTIONS WM = WM_USER + 202
+	EM_GETTYPOGRAPHYOPTIONS WM = WM_USER + 203
+)
+
 // Header control [messages] (HDM).
 //
 // [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-header-control-reference-messages
@@ -472,6 +525,53 @@ const (
 	HDM_LAYOUT       WM = _HDM_FIRST + 5
 )
 
+// ListBox control [messages] (LB).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-list-box-control-reference-messages
+const (
+	LB_ADDSTRING           WM = 0x0180
+	LB_INSERTSTRING        WM = 0x0181
+	LB_DELETESTRING        WM = 0x0182
+	LB_SELITEMRANGEEX      WM = 0x0183
+	LB_RESETCONTENT        WM = 0x0184
+	LB_SETSEL              WM = 0x0185
+	LB_SETCURSEL           WM = 0x0186
+	LB_GETSEL              WM = 0x0187
+	LB_GETCURSEL           WM = 0x0188
+	LB_GETTEXT             WM = 0x0189
+	LB_GETTEXTLEN          WM = 0x018a
+	LB_GETCOUNT            WM = 0x018b
+	LB_SELECTSTRING        WM = 0x018c
+	LB_DIR                 WM = 0x018d
+	LB_GETTOPINDEX         WM = 0x018e
+	LB_FINDSTRING          WM = 0x018f
+	LB_GETSELCOUNT         WM = 0x0190
+	LB_GETSELITEMS         WM = 0x0191
+	LB_SETTABSTOPS         WM = 0x0192
+	LB_GETHORIZONTALEXTENT WM = 0x0193
+	LB_SETHORIZONTALEXTENT WM = 0x0194
+	LB_SETCOLUMNWIDTH      WM = 0x0195
+	LB_ADDFILE             WM = 0x0196
+	LB_SETTOPINDEX         WM = 0x0197
+	LB_GETITEMRECT         WM = 0x0198
+	LB_GETITEMDATA         WM = 0x0199
+	LB_SETITEMDATA         WM = 0x019a
+	LB_SELITEMRANGE        WM = 0x019b
+	LB_SETANCHORINDEX      WM = 0x019c
+	LB_GETANCHORINDEX      WM = 0x019d
+	LB_SETCARETINDEX       WM = 0x019e
+	LB_GETCARETINDEX       WM = 0x019f
+	LB_SETITEMHEIGHT       WM = 0x01a0
+	LB_GETITEMHEIGHT       WM = 0x01a1
+	LB_FINDSTRINGEXACT     WM = 0x01a2
+	LB_SETLOCALE           WM = 0x01a5
+	LB_GETLOCALE           WM = 0x01a6
+	LB_SETCOUNT            WM = 0x01a7
+	LB_INITSTORAGE         WM = 0x01a8
+	LB_ITEMFROMPOINT       WM = 0x01a9
+	LB_GETLISTBOXINFO      WM = 0x01b2
+)
+
 // ListView control [messages] (LVM).
 //
 // [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-list-view-control-reference-messages
@@ -828,6 +928,83 @@ const (
 	TBM_GETUNICODEFORMAT WM = CCM_GETUNICODEFORMAT
 )
 
+// Tab control [messages] (TCM).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-tab-control-reference-messages
+const (
+	_TCM_FIRST WM = 0x1300
+
+	TCM_GETIMAGELIST     WM = _TCM_FIRST + 2
+	TCM_SETIMAGELIST     WM = _TCM_FIRST + 3
+	TCM_GETITEMCOUNT     WM = _TCM_FIRST + 4
+	TCM_GETITEM          WM = _TCM_FIRST + 60
+	TCM_SETITEM          WM = _TCM_FIRST + 61
+	TCM_INSERTITEM       WM = _TCM_FIRST + 62
+	TCM_DELETEITEM       WM = _TCM_FIRST + 8
+	TCM_DELETEALLITEMS   WM = _TCM_FIRST + 9
+	TCM_GETITEMRECT      WM = _TCM_FIRST + 10
+	TCM_GETCURSEL        WM = _TCM_FIRST + 11
+	TCM_SETCURSEL        WM = _TCM_FIRST + 12
+	TCM_HITTEST          WM = _TCM_FIRST + 13
+	TCM_SETITEMEXTRA     WM = _TCM_FIRST + 14
+	TCM_ADJUSTRECT       WM = _TCM_FIRST + 40
+	TCM_SETITEMSIZE      WM = _TCM_FIRST + 41
+	TCM_REMOVEIMAGE      WM = _TCM_FIRST + 42
+	TCM_SETPADDING       WM = _TCM_FIRST + 43
+	TCM_GETROWCOUNT      WM = _TCM_FIRST + 44
+	TCM_GETTOOLTIPS      WM = _TCM_FIRST + 45
+	TCM_SETTOOLTIPS      WM = _TCM_FIRST + 46
+	TCM_GETCURFOCUS      WM = _TCM_FIRST + 47
+	TCM_SETCURFOCUS      WM = _TCM_FIRST + 48
+	TCM_SETMINTABWIDTH   WM = _TCM_FIRST + 49
+	TCM_DESELECTALL      WM = _TCM_FIRST + 50
+	TCM_HIGHLIGHTITEM    WM = _TCM_FIRST + 51
+	TCM_SETEXTENDEDSTYLE WM = _TCM_FIRST + 52
+	TCM_GETEXTENDEDSTYLE WM = _TCM_FIRST + 53
+	TCM_SETUNICODEFORMAT WM = CCM_SETUNICODEFORMAT
+	TCM_GETUNICODEFORMAT WM = CCM_GETUNICODEFORMAT
+)
+
+// Tooltip control [messages] (TTM).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-tooltip-control-reference-messages
+const (
+	TTM_ACTIVATE        WM = WM_USER + 1
+	TTM_SETDELAYTIME    WM = WM_USER + 3
+	TTM_RELAYEVENT      WM = WM_USER + 7
+	TTM_GETTOOLCOUNT    WM = WM_USER + 13
+	TTM_WINDOWFROMPOINT WM = WM_USER + 16
+	TTM_TRACKACTIVATE   WM = WM_USER + 17
+	TTM_TRACKPOSITION   WM = WM_USER + 18
+	TTM_SETTIPBKCOLOR   WM = WM_USER + 19
+	TTM_SETTIPTEXTCOLOR WM = WM_USER + 20
+	TTM_GETDELAYTIME    WM = WM_USER + 21
+	TTM_GETTIPBKCOLOR   WM = WM_USER + 22
+	TTM_GETTIPTEXTCOLOR WM = WM_USER + 23
+	TTM_SETMAXTIPWIDTH  WM = WM_USER + 24
+	TTM_GETMAXTIPWIDTH  WM = WM_USER + 25
+	TTM_SETMARGIN       WM = WM_USER + 26
+	TTM_GETMARGIN       WM = WM_USER + 27
+	TTM_POP             WM = WM_USER + 28
+	TTM_UPDATE          WM = WM_USER + 29
+	TTM_GETBUBBLESIZE   WM = WM_USER + 30
+	TTM_ADJUSTRECT      WM = WM_USER + 31
+	TTM_SETTITLE        WM = WM_USER + 33
+	TTM_POPUP           WM = WM_USER + 34
+	TTM_GETTITLE        WM = WM_USER + 35
+	TTM_ADDTOOL         WM = WM_USER + 50
+	TTM_DELTOOL         WM = WM_USER + 51
+	TTM_NEWTOOLRECT     WM = WM_USER + 52
+	TTM_GETTOOLINFO     WM = WM_USER + 53
+	TTM_SETTOOLINFO     WM = WM_USER + 54
+	TTM_HITTEST         WM = WM_USER + 55
+	TTM_GETTEXT         WM = WM_USER + 56
+	TTM_UPDATETIPTEXT   WM = WM_USER + 57
+	TTM_ENUMTOOLS       WM = WM_USER + 58
+	TTM_GETCURRENTTOOL  WM = WM_USER + 59
+	TTM_SETWINDOWTHEME  WM = CCM_SETWINDOWTHEME
+)
+
 // TreeView control [messages] (TVM).
 //
 // [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-tree-view-control-reference-messages
@@ -886,3 +1063,25 @@ const (
 	TVM_SHOWINFOTIP         WM = _TVM_FIRST + 71
 	TVM_GETITEMPARTRECT     WM = _TVM_FIRST + 72
 )
+
+// UpDown control [messages] (UDM).
+//
+// [messages]: https://learn.microsoft.com/en-us/windows/win32/controls/bumper-up-down-control-reference-messages
+const (
+	UDM_SETRANGE         WM = WM_USER + 101
+	UDM_GETRANGE         WM = WM_USER + 102
+	UDM_SETPOS           WM = WM_USER + 103
+	UDM_GETPOS           WM = WM_USER + 104
+	UDM_SETBUDDY         WM = WM_USER + 105
+	UDM_GETBUDDY         WM = WM_USER + 106
+	UDM_SETACCEL         WM = WM_USER + 107
+	UDM_GETACCEL         WM = WM_USER + 108
+	UDM_SETBASE          WM = WM_USER + 109
+	UDM_GETBASE          WM = WM_USER + 110
+	UDM_SETRANGE32       WM = WM_USER + 111
+	UDM_GETRANGE32       WM = WM_USER + 112
+	UDM_SETPOS32         WM = WM_USER + 113
+	UDM_GETPOS32         WM = WM_USER + 114
+	UDM_SETUNICODEFORMAT WM = CCM_SETUNICODEFORMAT
+	UDM_GETUNICODEFORMAT WM = CCM_GETUNICODEFORMAT
+)
@@ -21,7 +21,7 @@ type IErrorLog interface {
 	// [AddError] COM method.
 	//
 	// [AddError]: https://learn.microsoft.com/en-us/windows/win32/api/oaidl/nf-oaidl-ierrorlog-adderror
-	AddError(propName string, exceps []EXCEPINFO)
+	AddError(propName string, excep *EXCEPINFO)
 }
 
 type _IErrorLog struct{ com.IUnknown }
@@ -33,12 +33,12 @@ func NewIErrorLog(base com.IUnknown) IErrorLog {
 	return &_IErrorLog{IUnknown: base}
 }
 
-func (me *_IErrorLog) AddError(propName string, exceps []EXCEPINFO) {
+func (me *_IErrorLog) AddError(propName string, excep *EXCEPINFO) {
 	ret, _, _ := syscall.SyscallN(
-		(*automvt.IPropertyBag)(unsafe.Pointer(*me.Ptr())).Write,
+		(*automvt.IErrorLog)(unsafe.Pointer(*me.Ptr())).AddError,
 		uintptr(unsafe.Pointer(me.Ptr())),
 		uintptr(unsafe.Pointer(win.Str.ToNativePtr(propName))),
-		uintptr(unsafe.Pointer(&exceps[0])))
+		uintptr(unsafe.Pointer(excep)))
 
 	if hr := errco.ERROR(ret); hr != errco.S_OK {
 		panic(hr)
@@ -0,0 +1,48 @@
+//go:build windows
+
+package autom
+
+import (
+	"sync"
+	"syscall"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/autom/automco"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Go implementation of the [IErrorLog] COM interface, to be passed to
+// NewIErrorLogImpl().
+//
+// The EXCEPINFO is owned by the caller, don't release its strings.
+//
+// [IErrorLog]: https://learn.microsoft.com/en-us/windows/win32/api/oaidl/nn-oaidl-ierrorlog
+type IErrorLogImpl interface {
+	AddError(propName string, excep *EXCEPINFO)
+}
+
+// Creates an IErrorLog COM object whose methods are implemented in Go.
+//
+// ⚠️ You must defer IErrorLog.Release().
+func NewIErrorLogImpl(impl IErrorLogImpl) IErrorLog {
+	_globalIErrorLogOnce.Do(func() {
+		_globalIErrorLogVtbl = com.NewGoVtbl(
+			[]co.IID{automco.IID_IErrorLog},
+			syscall.NewCallback(
+				func(this *com.GoObj, pszPropName *uint16, pExcepInfo *EXCEPINFO) uintptr {
+					this.Impl().(IErrorLogImpl).AddError(
+						win.Str.FromNativePtr(pszPropName), pExcepInfo)
+					return uintptr(errco.S_OK)
+				},
+			),
+		)
+	})
+	return NewIErrorLog(com.NewGoObj(_globalIErrorLogVtbl, impl))
+}
+
+var (
+	_globalIErrorLogOnce sync.Once
+	_globalIErrorLogVtbl *com.GoVtbl
+)
@@ -0,0 +1,156 @@
+//go:build windows
+
+package com
+
+import (
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com/comco"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Virtual table of a COM object implemented in Go.
+//
+// A GoVtbl is created once per COM interface, usually stored in a global
+// variable, and shared by all the GoObj instances of that interface.
+type GoVtbl struct {
+	iids  []win.GUID
+	funcs []uintptr // QueryInterface, AddRef and Release, then the methods
+}
+
+// Creates a virtual table for a COM object implemented in Go.
+//
+// The iids are the interfaces answered by QueryInterface, besides IUnknown,
+// which is always answered. The methods are the callbacks created with
+// syscall.NewCallback(), in the exact order of the COM virtual table, skipping
+// the three IUnknown methods, which are automatically provided.
+//
+// Each callback receives a *com.GoObj as its first argument, from which the Go
+// implementation can be retrieved with GoObj.Impl().
+//
+// # Example
+//
+//	var vtbl = com.NewGoVtbl(
+//		[]co.IID{shellco.IID_IDropSource},
+//		syscall.NewCallback(
+//			func(this *com.GoObj, escapePressed int32, keyState uint32) uintptr {
+//				impl := this.Impl().(shell.IDropSourceImpl)
+//				// ...
+//			},
+//		),
+//		// ...
+//	)
+func NewGoVtbl(iids []co.IID, methods ...uintptr) *GoVtbl {
+	_globalGoObjOnce.Do(func() {
+		_globalGoObjQueryInterface = syscall.NewCallback(_GoObjQueryInterface)
+		_globalGoObjAddRef = syscall.NewCallback(_GoObjAddRef)
+		_globalGoObjRelease = syscall.NewCallback(_GoObjRelease)
+	})
+
+	vtbl := &GoVtbl{
+		iids:  make([]win.GUID, 0, len(iids)),
+		funcs: make([]uintptr, 0, 3+len(methods)),
+	}
+	for _, iid := range iids {
+		vtbl.iids = append(vtbl.iids, *win.GuidFromIid(iid))
+	}
+	vtbl.funcs = append(vtbl.funcs,
+		_globalGoObjQueryInterface, _globalGoObjAddRef, _globalGoObjRelease)
+	vtbl.funcs = append(vtbl.funcs, methods...)
+	return vtbl
+}
+
+func (me *GoVtbl) answers(riid *win.GUID) bool {
+	if *riid == *win.GuidFromIid(comco.IID_IUnknown) {
+		return true
+	}
+	for i := range me.iids {
+		if *riid == me.iids[i] {
+			return true
+		}
+	}
+	return false
+}
+
+//------------------------------------------------------------------------------
+
+// A COM object implemented in Go, whose memory layout is a pointer to its
+// virtual table, as expected by COM.
+//
+// The object is kept alive while its reference count is greater than zero, even
+// if it's referenced only by native code.
+type GoObj struct {
+	vt       *uintptr // must be the first field
+	vtbl     *GoVtbl
+	refCount uint32
+	impl     interface{}
+}
+
+// Creates a new COM object implemented in Go, with a reference count of 1.
+//
+// This function is the building block of the Go-implemented COM objects, and
+// it should be used only if you're implementing a COM interface yourself.
+//
+// ⚠️ You must defer IUnknown.Release().
+func NewGoObj(vtbl *GoVtbl, impl interface{}) IUnknown {
+	obj := &GoObj{
+		vt:       &vtbl.funcs[0],
+		vtbl:     vtbl,
+		refCount: 1,
+		impl:     impl,
+	}
+
+	_globalGoObjsMutex.Lock()
+	_globalGoObjs[obj] = struct{}{} // keep the object alive while referenced
+	_globalGoObjsMutex.Unlock()
+
+	return NewIUnknown((**comvt.IUnknown)(unsafe.Pointer(obj)))
+}
+
+// Returns the Go implementation passed to NewGoObj().
+func (me *GoObj) Impl() interface{} {
+	return me.impl
+}
+
+var (
+	_globalGoObjOnce           sync.Once
+	_globalGoObjQueryInterface uintptr
+	_globalGoObjAddRef         uintptr
+	_globalGoObjRelease        uintptr
+
+	_globalGoObjs      = make(map[*GoObj]struct{}, 4)
+	_globalGoObjsMutex = sync.Mutex{}
+)
+
+func _GoObjQueryInterface(this *GoObj, riid *win.GUID, ppv **GoObj) uintptr {
+	if ppv == nil {
+		return uintptr(errco.E_POINTER)
+	}
+	if !this.vtbl.answers(riid) {
+		*ppv = nil
+		return uintptr(errco.E_NOINTERFACE)
+	}
+	atomic.AddUint32(&this.refCount, 1)
+	*ppv = this
+	return uintptr(errco.S_OK)
+}
+
+func _GoObjAddRef(this *GoObj) uintptr {
+	return uintptr(atomic.AddUint32(&this.refCount, 1))
+}
+
+func _GoObjRelease(this *GoObj) uintptr {
+	refCount := atomic.AddUint32(&this.refCount, ^uint32(0))
+	if refCount == 0 {
+		_globalGoObjsMutex.Lock()
+		delete(_globalGoObjs, this) // now the object can be collected
+		_globalGoObjsMutex.Unlock()
+	}
+	return uintptr(refCount)
+}
@@ -1,4 +1,4 @@
-//go:build windows
+//go:build windows && !arm64
 
 package d2d1
 
@@ -29,6 +29,35 @@ type ID2D1Factory interface {
 	CreateHwndRenderTarget(targetProps *RENDER_TARGET_PROPERTIES,
 		hwndTargetProps *HWND_RENDER_TARGET_PROPERTIES) ID2D1HwndRenderTarget
 
+	// [CreatePathGeometry] COM method.
+	//
+	// ⚠️ You must defer ID2D1PathGeometry.Release() on the returned object.
+	//
+	// [CreatePathGeometry]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1factory-createpathgeometry
+	CreatePathGeometry() ID2D1PathGeometry
+
+	// [CreateStrokeStyle] COM method.
+	//
+	// The dashes are used only with DASH_STYLE_CUSTOM, and can be nil.
+	//
+	// ⚠️ You must defer ID2D1StrokeStyle.Release() on the returned object.
+	//
+	// # Example
+	//
+	//	var factory d2d1.ID2D1Factory // initialized somewhere
+	//
+	//	dotted := factory.CreateStrokeStyle(&d2d1.STROKE_STYLE_PROPERTIES{
+	//		DashCap:    d2d1co.CAP_STYLE_ROUND,
+	//		MiterLimit: 10,
+	//		DashStyle:  d2d1co.DASH_STYLE_DOT,
+	//	}, nil)
+	//	defer dotted.Release()
+	//
+	// [CreateStrokeStyle]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1factory-createstrokestyle(constd2d1_stroke_style_properties__constfloat_uint32_id2d1strokestyle)
+	CreateStrokeStyle(
+		strokeStyleProperties *STROKE_STYLE_PROPERTIES,
+		dashes []float32) ID2D1StrokeStyle
+
 	// [ReloadSystemMetrics] COM method.
 	//
 	// [ReloadSystemMetrics]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1factory-reloadsystemmetrics
@@ -90,6 +119,44 @@ func (me *_ID2D1Factory) CreateHwndRenderTarget(
 	}
 }
 
+func (me *_ID2D1Factory) CreatePathGeometry() ID2D1PathGeometry {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Factory)(unsafe.Pointer(*me.Ptr())).CreatePathGeometry,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1PathGeometry(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1Factory) CreateStrokeStyle(
+	strokeStyleProperties *STROKE_STYLE_PROPERTIES,
+	dashes []float32) ID2D1StrokeStyle {
+
+	var pDashes *float32
+	if len(dashes) > 0 {
+		pDashes = &dashes[0]
+	}
+
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Factory)(unsafe.Pointer(*me.Ptr())).CreateStrokeStyle,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(strokeStyleProperties)),
+		uintptr(unsafe.Pointer(pDashes)), uintptr(uint32(len(dashes))),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1StrokeStyle(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
 func (me *_ID2D1Factory) ReloadSystemMetrics() {
 	ret, _, _ := syscall.SyscallN(
 		(*d2d1vt.ID2D1Factory)(unsafe.Pointer(*me.Ptr())).ReloadSystemMetrics,
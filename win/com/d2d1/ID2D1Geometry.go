@@ -0,0 +1,168 @@
+//go:build windows && !arm64
+
+package d2d1
+
+import (
+	"math"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// [ID2D1Geometry] COM interface.
+//
+// For the methods which receive a flattening tolerance, pass
+// d2d1co.DEFAULT_FLATTENING_TOLERANCE if unsure.
+//
+// [ID2D1Geometry]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1geometry
+type ID2D1Geometry interface {
+	ID2D1Resource
+
+	// [ComputeArea] COM method.
+	//
+	// The world transform can be nil.
+	//
+	// [ComputeArea]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometry-computearea(constd2d1_matrix_3x2_f__float_float)
+	ComputeArea(worldTransform *MATRIX_3X2_F, flatteningTolerance float32) float32
+
+	// [ComputeLength] COM method.
+	//
+	// The world transform can be nil.
+	//
+	// [ComputeLength]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometry-computelength(constd2d1_matrix_3x2_f__float_float)
+	ComputeLength(worldTransform *MATRIX_3X2_F, flatteningTolerance float32) float32
+
+	// [FillContainsPoint] COM method.
+	//
+	// The world transform can be nil.
+	//
+	// [FillContainsPoint]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometry-fillcontainspoint(d2d1_point_2f_constd2d1_matrix_3x2_f__float_bool)
+	FillContainsPoint(point POINT_2F,
+		worldTransform *MATRIX_3X2_F, flatteningTolerance float32) bool
+
+	// [GetBounds] COM method.
+	//
+	// The world transform can be nil.
+	//
+	// [GetBounds]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometry-getbounds(constd2d1_matrix_3x2_f__d2d1_rect_f)
+	GetBounds(worldTransform *MATRIX_3X2_F) RECT_F
+
+	// [StrokeContainsPoint] COM method.
+	//
+	// The stroke style and the world transform can be nil.
+	//
+	// [StrokeContainsPoint]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1geometry-strokecontainspoint(d2d1_point_2f_float_id2d1strokestyle_constd2d1_matrix_3x2_f__float_bool)
+	StrokeContainsPoint(point POINT_2F, strokeWidth float32,
+		strokeStyle ID2D1StrokeStyle, worldTransform *MATRIX_3X2_F,
+		flatteningTolerance float32) bool
+}
+
+type _ID2D1Geometry struct{ ID2D1Resource }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1Geometry.Release().
+func NewID2D1Geometry(base com.IUnknown) ID2D1Geometry {
+	return &_ID2D1Geometry{ID2D1Resource: NewID2D1Resource(base)}
+}
+
+func (me *_ID2D1Geometry) ComputeArea(
+	worldTransform *MATRIX_3X2_F, flatteningTolerance float32) float32 {
+
+	var area float32
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Geometry)(unsafe.Pointer(*me.Ptr())).ComputeArea,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(worldTransform)),
+		uintptr(math.Float32bits(flatteningTolerance)),
+		uintptr(unsafe.Pointer(&area)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return area
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1Geometry) ComputeLength(
+	worldTransform *MATRIX_3X2_F, flatteningTolerance float32) float32 {
+
+	var length float32
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Geometry)(unsafe.Pointer(*me.Ptr())).ComputeLength,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(worldTransform)),
+		uintptr(math.Float32bits(flatteningTolerance)),
+		uintptr(unsafe.Pointer(&length)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return length
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1Geometry) FillContainsPoint(
+	point POINT_2F,
+	worldTransform *MATRIX_3X2_F, flatteningTolerance float32) bool {
+
+	var contains int32 // BOOL
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Geometry)(unsafe.Pointer(*me.Ptr())).FillContainsPoint,
+		_Point2fArgs(
+			[]uintptr{uintptr(unsafe.Pointer(me.Ptr()))},
+			point,
+			uintptr(unsafe.Pointer(worldTransform)),
+			uintptr(math.Float32bits(flatteningTolerance)),
+			uintptr(unsafe.Pointer(&contains)),
+		)...)
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return contains != 0
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1Geometry) GetBounds(worldTransform *MATRIX_3X2_F) RECT_F {
+	var bounds RECT_F
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Geometry)(unsafe.Pointer(*me.Ptr())).GetBounds,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(worldTransform)),
+		uintptr(unsafe.Pointer(&bounds)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return bounds
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1Geometry) StrokeContainsPoint(
+	point POINT_2F, strokeWidth float32,
+	strokeStyle ID2D1StrokeStyle, worldTransform *MATRIX_3X2_F,
+	flatteningTolerance float32) bool {
+
+	var contains int32 // BOOL
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1Geometry)(unsafe.Pointer(*me.Ptr())).StrokeContainsPoint,
+		_Point2fArgs(
+			[]uintptr{uintptr(unsafe.Pointer(me.Ptr()))},
+			point,
+			uintptr(math.Float32bits(strokeWidth)),
+			_StrokeStylePtr(strokeStyle),
+			uintptr(unsafe.Pointer(worldTransform)),
+			uintptr(math.Float32bits(flatteningTolerance)),
+			uintptr(unsafe.Pointer(&contains)),
+		)...)
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return contains != 0
+	} else {
+		panic(hr)
+	}
+}
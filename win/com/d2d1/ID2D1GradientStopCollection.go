@@ -0,0 +1,85 @@
+//go:build windows && !arm64
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1co"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+)
+
+// [ID2D1GradientStopCollection] COM interface.
+//
+// [ID2D1GradientStopCollection]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1gradientstopcollection
+type ID2D1GradientStopCollection interface {
+	ID2D1Resource
+
+	// [GetColorInterpolationGamma] COM method.
+	//
+	// [GetColorInterpolationGamma]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1gradientstopcollection-getcolorinterpolationgamma
+	GetColorInterpolationGamma() d2d1co.GAMMA
+
+	// [GetExtendMode] COM method.
+	//
+	// [GetExtendMode]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1gradientstopcollection-getextendmode
+	GetExtendMode() d2d1co.EXTEND_MODE
+
+	// [GetGradientStopCount] COM method.
+	//
+	// [GetGradientStopCount]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1gradientstopcollection-getgradientstopcount
+	GetGradientStopCount() uint32
+
+	// [GetGradientStops] COM method.
+	//
+	// Returns all the gradient stops at once.
+	//
+	// [GetGradientStops]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1gradientstopcollection-getgradientstops
+	GetGradientStops() []GRADIENT_STOP
+}
+
+type _ID2D1GradientStopCollection struct{ ID2D1Resource }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1GradientStopCollection.Release().
+func NewID2D1GradientStopCollection(base com.IUnknown) ID2D1GradientStopCollection {
+	return &_ID2D1GradientStopCollection{ID2D1Resource: NewID2D1Resource(base)}
+}
+
+func (me *_ID2D1GradientStopCollection) GetColorInterpolationGamma() d2d1co.GAMMA {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1GradientStopCollection)(unsafe.Pointer(*me.Ptr())).GetColorInterpolationGamma,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return d2d1co.GAMMA(ret)
+}
+
+func (me *_ID2D1GradientStopCollection) GetExtendMode() d2d1co.EXTEND_MODE {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1GradientStopCollection)(unsafe.Pointer(*me.Ptr())).GetExtendMode,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return d2d1co.EXTEND_MODE(ret)
+}
+
+func (me *_ID2D1GradientStopCollection) GetGradientStopCount() uint32 {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1GradientStopCollection)(unsafe.Pointer(*me.Ptr())).GetGradientStopCount,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return uint32(ret)
+}
+
+func (me *_ID2D1GradientStopCollection) GetGradientStops() []GRADIENT_STOP {
+	count := me.GetGradientStopCount()
+	if count == 0 {
+		return []GRADIENT_STOP{}
+	}
+
+	stops := make([]GRADIENT_STOP, count)
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1GradientStopCollection)(unsafe.Pointer(*me.Ptr())).GetGradientStops,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&stops[0])), uintptr(count))
+	return stops
+}
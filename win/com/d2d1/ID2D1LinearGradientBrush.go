@@ -0,0 +1,95 @@
+//go:build windows && !arm64
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+)
+
+// [ID2D1LinearGradientBrush] COM interface.
+//
+// [ID2D1LinearGradientBrush]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1lineargradientbrush
+type ID2D1LinearGradientBrush interface {
+	ID2D1Brush
+
+	// [GetEndPoint] COM method.
+	//
+	// [GetEndPoint]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1lineargradientbrush-getendpoint
+	GetEndPoint() POINT_2F
+
+	// [GetGradientStopCollection] COM method.
+	//
+	// ⚠️ You must defer ID2D1GradientStopCollection.Release() on the returned
+	// object.
+	//
+	// [GetGradientStopCollection]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1lineargradientbrush-getgradientstopcollection
+	GetGradientStopCollection() ID2D1GradientStopCollection
+
+	// [GetStartPoint] COM method.
+	//
+	// [GetStartPoint]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1lineargradientbrush-getstartpoint
+	GetStartPoint() POINT_2F
+
+	// [SetEndPoint] COM method.
+	//
+	// [SetEndPoint]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1lineargradientbrush-setendpoint
+	SetEndPoint(endPoint POINT_2F)
+
+	// [SetStartPoint] COM method.
+	//
+	// [SetStartPoint]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1lineargradientbrush-setstartpoint
+	SetStartPoint(startPoint POINT_2F)
+}
+
+type _ID2D1LinearGradientBrush struct{ ID2D1Brush }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1LinearGradientBrush.Release().
+func NewID2D1LinearGradientBrush(base com.IUnknown) ID2D1LinearGradientBrush {
+	return &_ID2D1LinearGradientBrush{ID2D1Brush: NewID2D1Brush(base)}
+}
+
+func (me *_ID2D1LinearGradientBrush) GetEndPoint() POINT_2F {
+	var pt POINT_2F
+	syscall.SyscallN( // struct returned through a hidden pointer
+		(*d2d1vt.ID2D1LinearGradientBrush)(unsafe.Pointer(*me.Ptr())).GetEndPoint,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pt)))
+	return pt
+}
+
+func (me *_ID2D1LinearGradientBrush) GetGradientStopCollection() ID2D1GradientStopCollection {
+	var ppQueried **comvt.IUnknown
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1LinearGradientBrush)(unsafe.Pointer(*me.Ptr())).GetGradientStopCollection,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppQueried)))
+	return NewID2D1GradientStopCollection(com.NewIUnknown(ppQueried))
+}
+
+func (me *_ID2D1LinearGradientBrush) GetStartPoint() POINT_2F {
+	var pt POINT_2F
+	syscall.SyscallN( // struct returned through a hidden pointer
+		(*d2d1vt.ID2D1LinearGradientBrush)(unsafe.Pointer(*me.Ptr())).GetStartPoint,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&pt)))
+	return pt
+}
+
+func (me *_ID2D1LinearGradientBrush) SetEndPoint(endPoint POINT_2F) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1LinearGradientBrush)(unsafe.Pointer(*me.Ptr())).SetEndPoint,
+		_Point2fArgs([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))}, endPoint)...)
+}
+
+func (me *_ID2D1LinearGradientBrush) SetStartPoint(startPoint POINT_2F) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1LinearGradientBrush)(unsafe.Pointer(*me.Ptr())).SetStartPoint,
+		_Point2fArgs([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))}, startPoint)...)
+}
@@ -0,0 +1,122 @@
+//go:build windows && !arm64
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// [ID2D1PathGeometry] COM interface.
+//
+// [ID2D1PathGeometry]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1pathgeometry
+type ID2D1PathGeometry interface {
+	ID2D1Geometry
+
+	// [GetFigureCount] COM method.
+	//
+	// [GetFigureCount]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1pathgeometry-getfigurecount
+	GetFigureCount() uint32
+
+	// [GetSegmentCount] COM method.
+	//
+	// [GetSegmentCount]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1pathgeometry-getsegmentcount
+	GetSegmentCount() uint32
+
+	// [Open] COM method.
+	//
+	// The geometry can be opened only once, and it can be drawn only after
+	// ID2D1GeometrySink.Close() is called.
+	//
+	// ⚠️ You must defer ID2D1GeometrySink.Release() on the returned object.
+	//
+	// # Example
+	//
+	//	var factory d2d1.ID2D1Factory // initialized somewhere
+	//
+	//	path := factory.CreatePathGeometry()
+	//	defer path.Release()
+	//
+	//	sink := path.Open()
+	//	defer sink.Release()
+	//
+	//	sink.BeginFigure(d2d1.POINT_2F{X: 0, Y: 100}, d2d1co.FIGURE_BEGIN_HOLLOW)
+	//	sink.AddLines([]d2d1.POINT_2F{{X: 50, Y: 20}, {X: 100, Y: 60}})
+	//	sink.EndFigure(d2d1co.FIGURE_END_OPEN)
+	//	sink.Close()
+	//
+	// [Open]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1pathgeometry-open
+	Open() ID2D1GeometrySink
+
+	// [Stream] COM method.
+	//
+	// [Stream]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1pathgeometry-stream
+	Stream(geometrySink ID2D1GeometrySink)
+}
+
+type _ID2D1PathGeometry struct{ ID2D1Geometry }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1PathGeometry.Release().
+func NewID2D1PathGeometry(base com.IUnknown) ID2D1PathGeometry {
+	return &_ID2D1PathGeometry{ID2D1Geometry: NewID2D1Geometry(base)}
+}
+
+func (me *_ID2D1PathGeometry) GetFigureCount() uint32 {
+	var count uint32
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1PathGeometry)(unsafe.Pointer(*me.Ptr())).GetFigureCount,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&count)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return count
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1PathGeometry) GetSegmentCount() uint32 {
+	var count uint32
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1PathGeometry)(unsafe.Pointer(*me.Ptr())).GetSegmentCount,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&count)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return count
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1PathGeometry) Open() ID2D1GeometrySink {
+	var ppQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1PathGeometry)(unsafe.Pointer(*me.Ptr())).Open,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1GeometrySink(com.NewIUnknown(ppQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1PathGeometry) Stream(geometrySink ID2D1GeometrySink) {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1PathGeometry)(unsafe.Pointer(*me.Ptr())).Stream,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(geometrySink.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
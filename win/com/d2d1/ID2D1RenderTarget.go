@@ -1,8 +1,9 @@
-//go:build windows
+//go:build windows && !arm64
 
 package d2d1
 
 import (
+	"math"
 	"syscall"
 	"unsafe"
 
@@ -32,6 +33,16 @@ type ID2D1RenderTarget interface {
 	// [Clear]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-clear(constd2d1_color_f)
 	Clear(clearColor *COLOR_F)
 
+	// [CreateGradientStopCollection] COM method.
+	//
+	// ⚠️ You must defer ID2D1GradientStopCollection.Release() on the
+	// returned object.
+	//
+	// [CreateGradientStopCollection]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-creategradientstopcollection(constd2d1_gradient_stop_uint32_d2d1_gamma_d2d1_extend_mode_id2d1gradientstopcollection)
+	CreateGradientStopCollection(gradientStops []GRADIENT_STOP,
+		colorInterpolationGamma d2d1co.GAMMA,
+		extendMode d2d1co.EXTEND_MODE) ID2D1GradientStopCollection
+
 	// [CreateLayer] COM method.
 	//
 	// ⚠️ You must defer ID2D1Layer.Release().
@@ -39,6 +50,36 @@ type ID2D1RenderTarget interface {
 	// [CreateLayer]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-createlayer(d2d1_size_f_id2d1layer)
 	CreateLayer(size SIZE_F) ID2D1Layer
 
+	// [CreateLinearGradientBrush] COM method.
+	//
+	// The brush properties can be nil.
+	//
+	// ⚠️ You must defer ID2D1LinearGradientBrush.Release() on the returned
+	// object.
+	//
+	// # Example
+	//
+	//	var rt d2d1.ID2D1RenderTarget // initialized somewhere
+	//
+	//	stops := rt.CreateGradientStopCollection([]d2d1.GRADIENT_STOP{
+	//		{Position: 0, Color: d2d1.ColorFromRgb(win.RGB(255, 0, 0))},
+	//		{Position: 1, Color: d2d1.ColorFromRgb(win.RGB(0, 0, 255))},
+	//	}, d2d1co.GAMMA_2_2, d2d1co.EXTEND_MODE_CLAMP)
+	//	defer stops.Release()
+	//
+	//	brush := rt.CreateLinearGradientBrush(
+	//		&d2d1.LINEAR_GRADIENT_BRUSH_PROPERTIES{
+	//			StartPoint: d2d1.POINT_2F{X: 0, Y: 0},
+	//			EndPoint:   d2d1.POINT_2F{X: 0, Y: 200},
+	//		}, nil, stops)
+	//	defer brush.Release()
+	//
+	// [CreateLinearGradientBrush]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-createlineargradientbrush(constd2d1_linear_gradient_brush_properties__constd2d1_brush_properties__id2d1gradientstopcollection_id2d1lineargradientbrush)
+	CreateLinearGradientBrush(
+		linearGradientBrushProperties *LINEAR_GRADIENT_BRUSH_PROPERTIES,
+		brushProperties *BRUSH_PROPERTIES,
+		gradientStopCollection ID2D1GradientStopCollection) ID2D1LinearGradientBrush
+
 	// [CreateMesh] COM method.
 	//
 	// ⚠️ You must defer ID2D1Mesh.Release().
@@ -46,11 +87,95 @@ type ID2D1RenderTarget interface {
 	// [CreateMesh]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-createmesh
 	CreateMesh() ID2D1Mesh
 
+	// [CreateSolidColorBrush] COM method.
+	//
+	// The brush properties can be nil.
+	//
+	// ⚠️ You must defer ID2D1SolidColorBrush.Release() on the returned object.
+	//
+	// [CreateSolidColorBrush]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-createsolidcolorbrush(constd2d1_color_f__constd2d1_brush_properties__id2d1solidcolorbrush)
+	CreateSolidColorBrush(color *COLOR_F,
+		brushProperties *BRUSH_PROPERTIES) ID2D1SolidColorBrush
+
+	// [DrawEllipse] COM method.
+	//
+	// The stroke style can be nil.
+	//
+	// [DrawEllipse]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-drawellipse(constd2d1_ellipse__id2d1brush_float_id2d1strokestyle)
+	DrawEllipse(ellipse *ELLIPSE, brush ID2D1Brush,
+		strokeWidth float32, strokeStyle ID2D1StrokeStyle)
+
+	// [DrawGeometry] COM method.
+	//
+	// The stroke style can be nil.
+	//
+	// [DrawGeometry]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-drawgeometry
+	DrawGeometry(geometry ID2D1Geometry, brush ID2D1Brush,
+		strokeWidth float32, strokeStyle ID2D1StrokeStyle)
+
+	// [DrawLine] COM method.
+	//
+	// The stroke style can be nil.
+	//
+	// # Example
+	//
+	//	var rt d2d1.ID2D1RenderTarget // initialized somewhere
+	//
+	//	brush := rt.CreateSolidColorBrush(&d2d1.COLOR_F{R: 1, A: 1}, nil)
+	//	defer brush.Release()
+	//
+	//	rt.BeginDraw()
+	//	rt.DrawLine(d2d1.POINT_2F{X: 10, Y: 10}, d2d1.POINT_2F{X: 100, Y: 80},
+	//		brush, 2, nil)
+	//	rt.EndDraw()
+	//
+	// [DrawLine]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-drawline
+	DrawLine(point0, point1 POINT_2F, brush ID2D1Brush,
+		strokeWidth float32, strokeStyle ID2D1StrokeStyle)
+
+	// [DrawRectangle] COM method.
+	//
+	// The stroke style can be nil.
+	//
+	// [DrawRectangle]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-drawrectangle(constd2d1_rect_f__id2d1brush_float_id2d1strokestyle)
+	DrawRectangle(rect *RECT_F, brush ID2D1Brush,
+		strokeWidth float32, strokeStyle ID2D1StrokeStyle)
+
+	// [DrawRoundedRectangle] COM method.
+	//
+	// The stroke style can be nil.
+	//
+	// [DrawRoundedRectangle]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-drawroundedrectangle(constd2d1_rounded_rect__id2d1brush_float_id2d1strokestyle)
+	DrawRoundedRectangle(roundedRect *ROUNDED_RECT, brush ID2D1Brush,
+		strokeWidth float32, strokeStyle ID2D1StrokeStyle)
+
 	// [EndDraw] COM method.
 	//
 	// [EndDraw]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-enddraw
 	EndDraw() (tag1, tag2 uint64)
 
+	// [FillEllipse] COM method.
+	//
+	// [FillEllipse]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-fillellipse(constd2d1_ellipse__id2d1brush)
+	FillEllipse(ellipse *ELLIPSE, brush ID2D1Brush)
+
+	// [FillGeometry] COM method.
+	//
+	// The opacity brush can be nil.
+	//
+	// [FillGeometry]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-fillgeometry
+	FillGeometry(geometry ID2D1Geometry, brush, opacityBrush ID2D1Brush)
+
+	// [FillRectangle] COM method.
+	//
+	// [FillRectangle]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-fillrectangle(constd2d1_rect_f__id2d1brush)
+	FillRectangle(rect *RECT_F, brush ID2D1Brush)
+
+	// [FillRoundedRectangle] COM method.
+	//
+	// [FillRoundedRectangle]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-fillroundedrectangle(constd2d1_rounded_rect__id2d1brush)
+	FillRoundedRectangle(roundedRect *ROUNDED_RECT, brush ID2D1Brush)
+
 	// [Flush] COM method.
 	//
 	// [Flush]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-flush
@@ -86,11 +211,43 @@ type ID2D1RenderTarget interface {
 	// [GetSize]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-getsize
 	GetSize() SIZE_F
 
+	// [GetTransform] COM method.
+	//
+	// [GetTransform]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-gettransform
+	GetTransform() MATRIX_3X2_F
+
 	// [IsSupported] COM method.
 	//
 	// [IsSupported]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-issupported(constd2d1_render_target_properties)
 	IsSupported(renderTargetProperties *RENDER_TARGET_PROPERTIES) bool
 
+	// [PopAxisAlignedClip] COM method.
+	//
+	// [PopAxisAlignedClip]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-popaxisalignedclip
+	PopAxisAlignedClip()
+
+	// [PopLayer] COM method.
+	//
+	// [PopLayer]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-poplayer
+	PopLayer()
+
+	// [PushAxisAlignedClip] COM method.
+	//
+	// ⚠️ You must call ID2D1RenderTarget.PopAxisAlignedClip() after drawing.
+	//
+	// [PushAxisAlignedClip]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-pushaxisalignedclip(constd2d1_rect_f__d2d1_antialias_mode)
+	PushAxisAlignedClip(clipRect *RECT_F,
+		antialiasMode d2d1co.ANTIALIAS_MODE)
+
+	// [PushLayer] COM method.
+	//
+	// Prefer creating the parameters with LayerParameters().
+	//
+	// ⚠️ You must call ID2D1RenderTarget.PopLayer() after drawing.
+	//
+	// [PushLayer]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-pushlayer(constd2d1_layer_parameters__id2d1layer)
+	PushLayer(layerParameters *LAYER_PARAMETERS, layer ID2D1Layer)
+
 	// [SetAntialiasMode] COM method.
 	//
 	// [SetAntialiasMode]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-setantialiasmode
@@ -100,6 +257,11 @@ type ID2D1RenderTarget interface {
 	//
 	// [SetDpi]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-setdpi
 	SetDpi(dpiX, dpiY float32)
+
+	// [SetTransform] COM method.
+	//
+	// [SetTransform]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1rendertarget-settransform(constd2d1_matrix_3x2_f_)
+	SetTransform(transform *MATRIX_3X2_F)
 }
 
 type _ID2D1RenderTarget struct{ ID2D1Resource }
@@ -132,12 +294,33 @@ func (me *_ID2D1RenderTarget) Clear(clearColor *COLOR_F) {
 	}
 }
 
+func (me *_ID2D1RenderTarget) CreateGradientStopCollection(
+	gradientStops []GRADIENT_STOP,
+	colorInterpolationGamma d2d1co.GAMMA,
+	extendMode d2d1co.EXTEND_MODE) ID2D1GradientStopCollection {
+
+	var ppQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).CreateGradientStopCollection,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&gradientStops[0])),
+		uintptr(uint32(len(gradientStops))),
+		uintptr(colorInterpolationGamma), uintptr(extendMode),
+		uintptr(unsafe.Pointer(&ppQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1GradientStopCollection(com.NewIUnknown(ppQueried))
+	} else {
+		panic(hr)
+	}
+}
+
 func (me *_ID2D1RenderTarget) CreateLayer(size SIZE_F) ID2D1Layer {
 	var ppQueried **comvt.IUnknown
 	ret, _, _ := syscall.SyscallN(
 		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).CreateLayer,
 		uintptr(unsafe.Pointer(me.Ptr())),
-		uintptr(util.Make64(uint32(size.Width), uint32(size.Height))),
+		uintptr(unsafe.Pointer(&size)),
 		uintptr(unsafe.Pointer(&ppQueried)))
 
 	if hr := errco.ERROR(ret); hr == errco.S_OK {
@@ -147,6 +330,27 @@ func (me *_ID2D1RenderTarget) CreateLayer(size SIZE_F) ID2D1Layer {
 	}
 }
 
+func (me *_ID2D1RenderTarget) CreateLinearGradientBrush(
+	linearGradientBrushProperties *LINEAR_GRADIENT_BRUSH_PROPERTIES,
+	brushProperties *BRUSH_PROPERTIES,
+	gradientStopCollection ID2D1GradientStopCollection) ID2D1LinearGradientBrush {
+
+	var ppQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).CreateLinearGradientBrush,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(linearGradientBrushProperties)),
+		uintptr(unsafe.Pointer(brushProperties)),
+		uintptr(unsafe.Pointer(gradientStopCollection.Ptr())),
+		uintptr(unsafe.Pointer(&ppQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1LinearGradientBrush(com.NewIUnknown(ppQueried))
+	} else {
+		panic(hr)
+	}
+}
+
 func (me *_ID2D1RenderTarget) CreateMesh() ID2D1Mesh {
 	var ppQueried **comvt.IUnknown
 	ret, _, _ := syscall.SyscallN(
@@ -161,6 +365,91 @@ func (me *_ID2D1RenderTarget) CreateMesh() ID2D1Mesh {
 	}
 }
 
+func (me *_ID2D1RenderTarget) CreateSolidColorBrush(
+	color *COLOR_F, brushProperties *BRUSH_PROPERTIES) ID2D1SolidColorBrush {
+
+	var ppQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).CreateSolidColorBrush,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(color)),
+		uintptr(unsafe.Pointer(brushProperties)),
+		uintptr(unsafe.Pointer(&ppQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewID2D1SolidColorBrush(com.NewIUnknown(ppQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_ID2D1RenderTarget) DrawEllipse(
+	ellipse *ELLIPSE, brush ID2D1Brush,
+	strokeWidth float32, strokeStyle ID2D1StrokeStyle) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).DrawEllipse,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(ellipse)),
+		uintptr(unsafe.Pointer(brush.Ptr())),
+		uintptr(math.Float32bits(strokeWidth)),
+		_StrokeStylePtr(strokeStyle))
+}
+
+func (me *_ID2D1RenderTarget) DrawGeometry(
+	geometry ID2D1Geometry, brush ID2D1Brush,
+	strokeWidth float32, strokeStyle ID2D1StrokeStyle) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).DrawGeometry,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(geometry.Ptr())),
+		uintptr(unsafe.Pointer(brush.Ptr())),
+		uintptr(math.Float32bits(strokeWidth)),
+		_StrokeStylePtr(strokeStyle))
+}
+
+func (me *_ID2D1RenderTarget) DrawLine(
+	point0, point1 POINT_2F, brush ID2D1Brush,
+	strokeWidth float32, strokeStyle ID2D1StrokeStyle) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).DrawLine,
+		_Point2fArgs(
+			_Point2fArgs([]uintptr{uintptr(unsafe.Pointer(me.Ptr()))}, point0),
+			point1,
+			uintptr(unsafe.Pointer(brush.Ptr())),
+			uintptr(math.Float32bits(strokeWidth)),
+			_StrokeStylePtr(strokeStyle),
+		)...)
+}
+
+func (me *_ID2D1RenderTarget) DrawRectangle(
+	rect *RECT_F, brush ID2D1Brush,
+	strokeWidth float32, strokeStyle ID2D1StrokeStyle) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).DrawRectangle,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(rect)),
+		uintptr(unsafe.Pointer(brush.Ptr())),
+		uintptr(math.Float32bits(strokeWidth)),
+		_StrokeStylePtr(strokeStyle))
+}
+
+func (me *_ID2D1RenderTarget) DrawRoundedRectangle(
+	roundedRect *ROUNDED_RECT, brush ID2D1Brush,
+	strokeWidth float32, strokeStyle ID2D1StrokeStyle) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).DrawRoundedRectangle,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(roundedRect)),
+		uintptr(unsafe.Pointer(brush.Ptr())),
+		uintptr(math.Float32bits(strokeWidth)),
+		_StrokeStylePtr(strokeStyle))
+}
+
 func (me *_ID2D1RenderTarget) EndDraw() (tag1, tag2 uint64) {
 	ret, _, _ := syscall.SyscallN(
 		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).EndDraw,
@@ -174,6 +463,48 @@ func (me *_ID2D1RenderTarget) EndDraw() (tag1, tag2 uint64) {
 	}
 }
 
+func (me *_ID2D1RenderTarget) FillEllipse(ellipse *ELLIPSE, brush ID2D1Brush) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).FillEllipse,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(ellipse)),
+		uintptr(unsafe.Pointer(brush.Ptr())))
+}
+
+func (me *_ID2D1RenderTarget) FillGeometry(
+	geometry ID2D1Geometry, brush, opacityBrush ID2D1Brush) {
+
+	var pOpacityBrush uintptr
+	if opacityBrush != nil {
+		pOpacityBrush = uintptr(unsafe.Pointer(opacityBrush.Ptr()))
+	}
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).FillGeometry,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(geometry.Ptr())),
+		uintptr(unsafe.Pointer(brush.Ptr())),
+		pOpacityBrush)
+}
+
+func (me *_ID2D1RenderTarget) FillRectangle(rect *RECT_F, brush ID2D1Brush) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).FillRectangle,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(rect)),
+		uintptr(unsafe.Pointer(brush.Ptr())))
+}
+
+func (me *_ID2D1RenderTarget) FillRoundedRectangle(
+	roundedRect *ROUNDED_RECT, brush ID2D1Brush) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).FillRoundedRectangle,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(roundedRect)),
+		uintptr(unsafe.Pointer(brush.Ptr())))
+}
+
 func (me *_ID2D1RenderTarget) Flush() (tag1, tag2 uint64) {
 	ret, _, _ := syscall.SyscallN(
 		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).Flush,
@@ -243,6 +574,15 @@ func (me *_ID2D1RenderTarget) GetSize() SIZE_F {
 	return SIZE_F{Width: float32(lo), Height: float32(hi)}
 }
 
+func (me *_ID2D1RenderTarget) GetTransform() MATRIX_3X2_F {
+	var transform MATRIX_3X2_F
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).GetTransform,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&transform)))
+	return transform
+}
+
 func (me *_ID2D1RenderTarget) IsSupported(
 	renderTargetProperties *RENDER_TARGET_PROPERTIES) bool {
 
@@ -254,6 +594,37 @@ func (me *_ID2D1RenderTarget) IsSupported(
 	return ret != 0
 }
 
+func (me *_ID2D1RenderTarget) PopAxisAlignedClip() {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).PopAxisAlignedClip,
+		uintptr(unsafe.Pointer(me.Ptr())))
+}
+
+func (me *_ID2D1RenderTarget) PopLayer() {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).PopLayer,
+		uintptr(unsafe.Pointer(me.Ptr())))
+}
+
+func (me *_ID2D1RenderTarget) PushAxisAlignedClip(
+	clipRect *RECT_F, antialiasMode d2d1co.ANTIALIAS_MODE) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).PushAxisAlignedClip,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(clipRect)), uintptr(antialiasMode))
+}
+
+func (me *_ID2D1RenderTarget) PushLayer(
+	layerParameters *LAYER_PARAMETERS, layer ID2D1Layer) {
+
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).PushLayer,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(layerParameters)),
+		uintptr(unsafe.Pointer(layer.Ptr())))
+}
+
 func (me *_ID2D1RenderTarget) SetAntialiasMode(
 	antialiasMode d2d1co.ANTIALIAS_MODE) {
 
@@ -269,3 +640,10 @@ func (me *_ID2D1RenderTarget) SetDpi(dpiX, dpiY float32) {
 		uintptr(unsafe.Pointer(me.Ptr())),
 		uintptr(dpiX), uintptr(dpiY))
 }
+
+func (me *_ID2D1RenderTarget) SetTransform(transform *MATRIX_3X2_F) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1RenderTarget)(unsafe.Pointer(*me.Ptr())).SetTransform,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(transform)))
+}
@@ -0,0 +1,53 @@
+//go:build windows && !arm64
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+)
+
+// [ID2D1SolidColorBrush] COM interface.
+//
+// [ID2D1SolidColorBrush]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1solidcolorbrush
+type ID2D1SolidColorBrush interface {
+	ID2D1Brush
+
+	// [GetColor] COM method.
+	//
+	// [GetColor]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1solidcolorbrush-getcolor
+	GetColor() COLOR_F
+
+	// [SetColor] COM method.
+	//
+	// [SetColor]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1solidcolorbrush-setcolor(constd2d1_color_f_)
+	SetColor(color *COLOR_F)
+}
+
+type _ID2D1SolidColorBrush struct{ ID2D1Brush }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1SolidColorBrush.Release().
+func NewID2D1SolidColorBrush(base com.IUnknown) ID2D1SolidColorBrush {
+	return &_ID2D1SolidColorBrush{ID2D1Brush: NewID2D1Brush(base)}
+}
+
+func (me *_ID2D1SolidColorBrush) GetColor() COLOR_F {
+	var color COLOR_F
+	syscall.SyscallN( // struct returned through a hidden pointer
+		(*d2d1vt.ID2D1SolidColorBrush)(unsafe.Pointer(*me.Ptr())).GetColor,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&color)))
+	return color
+}
+
+func (me *_ID2D1SolidColorBrush) SetColor(color *COLOR_F) {
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1SolidColorBrush)(unsafe.Pointer(*me.Ptr())).SetColor,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(color)))
+}
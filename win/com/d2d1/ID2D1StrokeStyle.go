@@ -0,0 +1,129 @@
+//go:build windows && !arm64
+
+package d2d1
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1co"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1vt"
+)
+
+// [ID2D1StrokeStyle] COM interface.
+//
+// [ID2D1StrokeStyle]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1strokestyle
+type ID2D1StrokeStyle interface {
+	ID2D1Resource
+
+	// [GetDashCap] COM method.
+	//
+	// [GetDashCap]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1strokestyle-getdashcap
+	GetDashCap() d2d1co.CAP_STYLE
+
+	// [GetDashes] COM method.
+	//
+	// Returns all the dashes at once.
+	//
+	// [GetDashes]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1strokestyle-getdashes
+	GetDashes() []float32
+
+	// [GetDashesCount] COM method.
+	//
+	// [GetDashesCount]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1strokestyle-getdashescount
+	GetDashesCount() uint32
+
+	// [GetDashStyle] COM method.
+	//
+	// [GetDashStyle]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1strokestyle-getdashstyle
+	GetDashStyle() d2d1co.DASH_STYLE
+
+	// [GetEndCap] COM method.
+	//
+	// [GetEndCap]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1strokestyle-getendcap
+	GetEndCap() d2d1co.CAP_STYLE
+
+	// [GetLineJoin] COM method.
+	//
+	// [GetLineJoin]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1strokestyle-getlinejoin
+	GetLineJoin() d2d1co.LINE_JOIN
+
+	// [GetStartCap] COM method.
+	//
+	// [GetStartCap]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nf-d2d1-id2d1strokestyle-getstartcap
+	GetStartCap() d2d1co.CAP_STYLE
+}
+
+type _ID2D1StrokeStyle struct{ ID2D1Resource }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer ID2D1StrokeStyle.Release().
+func NewID2D1StrokeStyle(base com.IUnknown) ID2D1StrokeStyle {
+	return &_ID2D1StrokeStyle{ID2D1Resource: NewID2D1Resource(base)}
+}
+
+func (me *_ID2D1StrokeStyle) GetDashCap() d2d1co.CAP_STYLE {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1StrokeStyle)(unsafe.Pointer(*me.Ptr())).GetDashCap,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return d2d1co.CAP_STYLE(ret)
+}
+
+func (me *_ID2D1StrokeStyle) GetDashes() []float32 {
+	count := me.GetDashesCount()
+	if count == 0 {
+		return []float32{}
+	}
+
+	dashes := make([]float32, count)
+	syscall.SyscallN(
+		(*d2d1vt.ID2D1StrokeStyle)(unsafe.Pointer(*me.Ptr())).GetDashes,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&dashes[0])), uintptr(count))
+	return dashes
+}
+
+func (me *_ID2D1StrokeStyle) GetDashesCount() uint32 {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1StrokeStyle)(unsafe.Pointer(*me.Ptr())).GetDashesCount,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return uint32(ret)
+}
+
+func (me *_ID2D1StrokeStyle) GetDashStyle() d2d1co.DASH_STYLE {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1StrokeStyle)(unsafe.Pointer(*me.Ptr())).GetDashStyle,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return d2d1co.DASH_STYLE(ret)
+}
+
+func (me *_ID2D1StrokeStyle) GetEndCap() d2d1co.CAP_STYLE {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1StrokeStyle)(unsafe.Pointer(*me.Ptr())).GetEndCap,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return d2d1co.CAP_STYLE(ret)
+}
+
+func (me *_ID2D1StrokeStyle) GetLineJoin() d2d1co.LINE_JOIN {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1StrokeStyle)(unsafe.Pointer(*me.Ptr())).GetLineJoin,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return d2d1co.LINE_JOIN(ret)
+}
+
+func (me *_ID2D1StrokeStyle) GetStartCap() d2d1co.CAP_STYLE {
+	ret, _, _ := syscall.SyscallN(
+		(*d2d1vt.ID2D1StrokeStyle)(unsafe.Pointer(*me.Ptr())).GetStartCap,
+		uintptr(unsafe.Pointer(me.Ptr())))
+	return d2d1co.CAP_STYLE(ret)
+}
+
+// Returns the raw pointer of the stroke style, or zero if nil.
+func _StrokeStylePtr(strokeStyle ID2D1StrokeStyle) uintptr {
+	if strokeStyle == nil {
+		return 0
+	}
+	return uintptr(unsafe.Pointer(strokeStyle.Ptr()))
+}
@@ -0,0 +1,14 @@
+//go:build windows && !arm64
+
+package d2d1
+
+import (
+	"math"
+)
+
+// In 32-bit, a POINT_2F passed by value takes two arguments.
+
+func _Point2fArgs(before []uintptr, pt POINT_2F, after ...uintptr) []uintptr {
+	return append(append(before,
+		uintptr(math.Float32bits(pt.X)), uintptr(math.Float32bits(pt.Y))), after...)
+}
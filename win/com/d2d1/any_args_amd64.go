@@ -0,0 +1,14 @@
+//go:build windows && !arm64
+
+package d2d1
+
+import (
+	"math"
+)
+
+// In 64-bit, a POINT_2F passed by value is packed into a single argument.
+
+func _Point2fArgs(before []uintptr, pt POINT_2F, after ...uintptr) []uintptr {
+	packed := uintptr(math.Float32bits(pt.X)) | uintptr(math.Float32bits(pt.Y))<<32
+	return append(append(before, packed), after...)
+}
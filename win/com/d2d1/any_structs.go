@@ -1,12 +1,40 @@
-//go:build windows
+//go:build windows && !arm64
 
 package d2d1
 
 import (
+	"unsafe"
+
 	"github.com/rodrigocfd/windigo/win"
 	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1co"
 )
 
+// [ARC_SEGMENT] struct.
+//
+// [ARC_SEGMENT]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_arc_segment
+type ARC_SEGMENT struct {
+	Point          POINT_2F
+	Size           SIZE_F
+	RotationAngle  float32
+	SweepDirection d2d1co.SWEEP_DIRECTION
+	ArcSize        d2d1co.ARC_SIZE
+}
+
+// [BEZIER_SEGMENT] struct.
+//
+// [BEZIER_SEGMENT]: https://learn.microsoft.com/en-us/windows/win32/api/dcommon/ns-dcommon-d2d1_bezier_segment
+type BEZIER_SEGMENT struct {
+	Point1, Point2, Point3 POINT_2F
+}
+
+// [BRUSH_PROPERTIES] struct.
+//
+// [BRUSH_PROPERTIES]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_brush_properties
+type BRUSH_PROPERTIES struct {
+	Opacity   float32
+	Transform MATRIX_3X2_F
+}
+
 // [COLOR_F] struct;
 //
 // [COLOR_F]: https://learn.microsoft.com/en-us/windows/win32/Direct2D/d2d1-color-f
@@ -14,6 +42,14 @@ type COLOR_F struct {
 	R, G, B, A float32
 }
 
+// [ELLIPSE] struct.
+//
+// [ELLIPSE]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_ellipse
+type ELLIPSE struct {
+	Point            POINT_2F
+	RadiusX, RadiusY float32
+}
+
 // [FACTORY_OPTIONS] struct.
 //
 // [FACTORY_OPTIONS]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_factory_options
@@ -21,6 +57,14 @@ type FACTORY_OPTIONS struct {
 	DebugLevel d2d1co.DEBUG_LEVEL
 }
 
+// [GRADIENT_STOP] struct.
+//
+// [GRADIENT_STOP]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_gradient_stop
+type GRADIENT_STOP struct {
+	Position float32
+	Color    COLOR_F
+}
+
 // [HWND_RENDER_TARGET_PROPERTIES] struct.
 //
 // [HWND_RENDER_TARGET_PROPERTIES]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_hwnd_render_target_properties
@@ -30,6 +74,64 @@ type HWND_RENDER_TARGET_PROPERTIES struct {
 	PresentOptions d2d1co.PRESENT_OPTIONS
 }
 
+// [LAYER_PARAMETERS] struct.
+//
+// Prefer creating it with LayerParameters(), which sets the default values.
+//
+// [LAYER_PARAMETERS]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_layer_parameters
+type LAYER_PARAMETERS struct {
+	ContentBounds     RECT_F
+	geometricMask     uintptr
+	MaskAntialiasMode d2d1co.ANTIALIAS_MODE
+	MaskTransform     MATRIX_3X2_F
+	Opacity           float32
+	opacityBrush      uintptr
+	LayerOptions      d2d1co.LAYER_OPTIONS
+}
+
+// Sets the geometric mask; nil means no mask.
+//
+// The object is not AddRef'd, so it must be kept alive until PopLayer() is
+// called.
+func (lp *LAYER_PARAMETERS) SetGeometricMask(geometry ID2D1Geometry) {
+	if geometry == nil {
+		lp.geometricMask = 0
+	} else {
+		lp.geometricMask = uintptr(unsafe.Pointer(geometry.Ptr()))
+	}
+}
+
+// Sets the opacity brush; nil means no opacity brush.
+//
+// The object is not AddRef'd, so it must be kept alive until PopLayer() is
+// called.
+func (lp *LAYER_PARAMETERS) SetOpacityBrush(brush ID2D1Brush) {
+	if brush == nil {
+		lp.opacityBrush = 0
+	} else {
+		lp.opacityBrush = uintptr(unsafe.Pointer(brush.Ptr()))
+	}
+}
+
+// [LINEAR_GRADIENT_BRUSH_PROPERTIES] struct.
+//
+// [LINEAR_GRADIENT_BRUSH_PROPERTIES]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_linear_gradient_brush_properties
+type LINEAR_GRADIENT_BRUSH_PROPERTIES struct {
+	StartPoint, EndPoint POINT_2F
+}
+
+// [MATRIX_3X2_F] struct.
+//
+// Prefer creating it with MatrixIdentity(), MatrixTranslation(),
+// MatrixScale(), MatrixRotation() or MatrixSkew().
+//
+// [MATRIX_3X2_F]: https://learn.microsoft.com/en-us/windows/win32/api/dcommon/ns-dcommon-d2d_matrix_3x2_f
+type MATRIX_3X2_F struct {
+	M11, M12 float32
+	M21, M22 float32
+	Dx, Dy   float32
+}
+
 // [PIXEL_FORMAT] struct.
 //
 // [PIXEL_FORMAT]: https://learn.microsoft.com/en-us/windows/win32/api/dcommon/ns-dcommon-d2d1_pixel_format
@@ -45,6 +147,20 @@ type POINT_2F struct {
 	X, Y float32
 }
 
+// [QUADRATIC_BEZIER_SEGMENT] struct.
+//
+// [QUADRATIC_BEZIER_SEGMENT]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_quadratic_bezier_segment
+type QUADRATIC_BEZIER_SEGMENT struct {
+	Point1, Point2 POINT_2F
+}
+
+// [RECT_F] struct.
+//
+// [RECT_F]: https://learn.microsoft.com/en-us/windows/win32/direct2d/d2d1-rect-f
+type RECT_F struct {
+	Left, Top, Right, Bottom float32
+}
+
 // [RENDER_TARGET_PROPERTIES] struct.
 //
 // [RENDER_TARGET_PROPERTIES]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_render_target_properties
@@ -57,6 +173,14 @@ type RENDER_TARGET_PROPERTIES struct {
 	MinLevel    d2d1co.FEATURE_LEVEL
 }
 
+// [ROUNDED_RECT] struct.
+//
+// [ROUNDED_RECT]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_rounded_rect
+type ROUNDED_RECT struct {
+	Rect             RECT_F
+	RadiusX, RadiusY float32
+}
+
 // [SIZE_F] struct.
 //
 // [SIZE_F]: https://learn.microsoft.com/en-us/windows/win32/direct2d/d2d1-size-f
@@ -71,6 +195,19 @@ type SIZE_U struct {
 	Width, Height uint32
 }
 
+// [STROKE_STYLE_PROPERTIES] struct.
+//
+// [STROKE_STYLE_PROPERTIES]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_stroke_style_properties
+type STROKE_STYLE_PROPERTIES struct {
+	StartCap   d2d1co.CAP_STYLE
+	EndCap     d2d1co.CAP_STYLE
+	DashCap    d2d1co.CAP_STYLE
+	LineJoin   d2d1co.LINE_JOIN
+	MiterLimit float32
+	DashStyle  d2d1co.DASH_STYLE
+	DashOffset float32
+}
+
 // [TRIANGLE] struct.
 //
 // [TRIANGLE]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ns-d2d1-d2d1_triangle
@@ -0,0 +1,161 @@
+//go:build windows && !arm64
+
+package d2d1
+
+import (
+	"math"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/com/d2d1/d2d1co"
+)
+
+// Creates an opaque COLOR_F from a COLORREF.
+func ColorFromRgb(rgb win.COLORREF) COLOR_F {
+	return ColorFromRgba(rgb, 1)
+}
+
+// Creates a COLOR_F from a COLORREF, with the given alpha, from 0 to 1.
+func ColorFromRgba(rgb win.COLORREF, alpha float32) COLOR_F {
+	return COLOR_F{
+		R: float32(rgb.Red()) / 255,
+		G: float32(rgb.Green()) / 255,
+		B: float32(rgb.Blue()) / 255,
+		A: alpha,
+	}
+}
+
+// Creates a LAYER_PARAMETERS with the default values: infinite content bounds,
+// no geometric mask, identity transform and full opacity.
+//
+// # Example
+//
+//	var rt d2d1.ID2D1RenderTarget // initialized somewhere
+//
+//	layer := rt.CreateLayer(d2d1.SIZE_F{})
+//	defer layer.Release()
+//
+//	params := d2d1.LayerParameters()
+//	params.Opacity = 0.5
+//	rt.PushLayer(&params, layer)
+//	// ... draw ...
+//	rt.PopLayer()
+func LayerParameters() LAYER_PARAMETERS {
+	return LAYER_PARAMETERS{
+		ContentBounds: RECT_F{
+			Left:   -math.MaxFloat32,
+			Top:    -math.MaxFloat32,
+			Right:  math.MaxFloat32,
+			Bottom: math.MaxFloat32,
+		},
+		MaskAntialiasMode: d2d1co.ANTIALIAS_MODE_PER_PRIMITIVE,
+		MaskTransform:     MatrixIdentity(),
+		Opacity:           1,
+		LayerOptions:      d2d1co.LAYER_OPTIONS_NONE,
+	}
+}
+
+//------------------------------------------------------------------------------
+
+// Creates an identity MATRIX_3X2_F.
+func MatrixIdentity() MATRIX_3X2_F {
+	return MATRIX_3X2_F{M11: 1, M22: 1}
+}
+
+// Creates a MATRIX_3X2_F which rotates by the given angle, in degrees,
+// clockwise, around the center point.
+func MatrixRotation(angle float32, center POINT_2F) MATRIX_3X2_F {
+	rad := float64(angle) * math.Pi / 180
+	sin, cos := float32(math.Sin(rad)), float32(math.Cos(rad))
+	return MATRIX_3X2_F{
+		M11: cos, M12: sin,
+		M21: -sin, M22: cos,
+		Dx: center.X - center.X*cos + center.Y*sin,
+		Dy: center.Y - center.X*sin - center.Y*cos,
+	}
+}
+
+// Creates a MATRIX_3X2_F which scales by the given factors around the center
+// point.
+func MatrixScale(x, y float32, center POINT_2F) MATRIX_3X2_F {
+	return MATRIX_3X2_F{
+		M11: x, M22: y,
+		Dx: center.X - x*center.X,
+		Dy: center.Y - y*center.Y,
+	}
+}
+
+// Creates a MATRIX_3X2_F which skews by the given angles, in degrees, around
+// the center point.
+func MatrixSkew(angleX, angleY float32, center POINT_2F) MATRIX_3X2_F {
+	tanX := float32(math.Tan(float64(angleX) * math.Pi / 180))
+	tanY := float32(math.Tan(float64(angleY) * math.Pi / 180))
+	return MATRIX_3X2_F{
+		M11: 1, M12: tanY,
+		M21: tanX, M22: 1,
+		Dx: -center.Y * tanX,
+		Dy: -center.X * tanY,
+	}
+}
+
+// Creates a MATRIX_3X2_F which translates by the given offsets.
+func MatrixTranslation(x, y float32) MATRIX_3X2_F {
+	return MATRIX_3X2_F{M11: 1, M22: 1, Dx: x, Dy: y}
+}
+
+// Returns the determinant of the matrix.
+func (m *MATRIX_3X2_F) Determinant() float32 {
+	return m.M11*m.M22 - m.M12*m.M21
+}
+
+// Inverts the matrix in place. Returns false if the matrix is not invertible,
+// in which case it's left untouched.
+func (m *MATRIX_3X2_F) Invert() bool {
+	det := m.Determinant()
+	if det == 0 {
+		return false
+	}
+	*m = MATRIX_3X2_F{
+		M11: m.M22 / det,
+		M12: -m.M12 / det,
+		M21: -m.M21 / det,
+		M22: m.M11 / det,
+		Dx:  (m.M21*m.Dy - m.M22*m.Dx) / det,
+		Dy:  (m.M12*m.Dx - m.M11*m.Dy) / det,
+	}
+	return true
+}
+
+// Tells whether the matrix is an identity matrix.
+func (m *MATRIX_3X2_F) IsIdentity() bool {
+	return *m == MatrixIdentity()
+}
+
+// Returns the product of this matrix by other, that is, a transform which
+// applies this matrix first, then the other.
+//
+// # Example
+//
+//	var rt d2d1.ID2D1RenderTarget // initialized somewhere
+//
+//	rot := d2d1.MatrixRotation(45, d2d1.POINT_2F{})
+//	trans := d2d1.MatrixTranslation(100, 50)
+//	transform := rot.Multiply(&trans) // rotate, then translate
+//	rt.SetTransform(&transform)
+func (m *MATRIX_3X2_F) Multiply(other *MATRIX_3X2_F) MATRIX_3X2_F {
+	return MATRIX_3X2_F{
+		M11: m.M11*other.M11 + m.M12*other.M21,
+		M12: m.M11*other.M12 + m.M12*other.M22,
+		M21: m.M21*other.M11 + m.M22*other.M21,
+		M22: m.M21*other.M12 + m.M22*other.M22,
+		Dx:  m.Dx*other.M11 + m.Dy*other.M21 + other.Dx,
+		Dy:  m.Dx*other.M12 + m.Dy*other.M22 + other.Dy,
+	}
+}
+
+// Applies the matrix to the given point.
+func (m *MATRIX_3X2_F) TransformPoint(pt POINT_2F) POINT_2F {
+	return POINT_2F{
+		X: pt.X*m.M11 + pt.Y*m.M21 + m.Dx,
+		Y: pt.X*m.M12 + pt.Y*m.M22 + m.Dy,
+	}
+}
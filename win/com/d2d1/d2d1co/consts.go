@@ -2,6 +2,12 @@
 
 package d2d1co
 
+// [D2D1_DEFAULT_FLATTENING_TOLERANCE] constant, the default flattening
+// tolerance for the geometry methods.
+//
+// [D2D1_DEFAULT_FLATTENING_TOLERANCE]: https://learn.microsoft.com/en-us/windows/win32/direct2d/direct2d-constants
+const DEFAULT_FLATTENING_TOLERANCE float32 = 0.25
+
 // [D2D1_ALPHA_MODE] enumeration.
 //
 // [D2D1_ALPHA_MODE]: https://learn.microsoft.com/en-us/windows/win32/api/dcommon/ne-dcommon-d2d1_alpha_mode
@@ -24,6 +30,42 @@ const (
 	ANTIALIAS_MODE_ALIASED       ANTIALIAS_MODE = 1
 )
 
+// [D2D1_ARC_SIZE] enumeration.
+//
+// [D2D1_ARC_SIZE]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_arc_size
+type ARC_SIZE uint32
+
+const (
+	ARC_SIZE_SMALL ARC_SIZE = 0
+	ARC_SIZE_LARGE ARC_SIZE = 1
+)
+
+// [D2D1_CAP_STYLE] enumeration.
+//
+// [D2D1_CAP_STYLE]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_cap_style
+type CAP_STYLE uint32
+
+const (
+	CAP_STYLE_FLAT     CAP_STYLE = 0
+	CAP_STYLE_SQUARE   CAP_STYLE = 1
+	CAP_STYLE_ROUND    CAP_STYLE = 2
+	CAP_STYLE_TRIANGLE CAP_STYLE = 3
+)
+
+// [D2D1_DASH_STYLE] enumeration.
+//
+// [D2D1_DASH_STYLE]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_dash_style
+type DASH_STYLE uint32
+
+const (
+	DASH_STYLE_SOLID        DASH_STYLE = 0
+	DASH_STYLE_DASH         DASH_STYLE = 1
+	DASH_STYLE_DOT          DASH_STYLE = 2
+	DASH_STYLE_DASH_DOT     DASH_STYLE = 3
+	DASH_STYLE_DASH_DOT_DOT DASH_STYLE = 4
+	DASH_STYLE_CUSTOM       DASH_STYLE = 5
+)
+
 // [D2D1_DEBUG_LEVEL] enumeration.
 //
 // [D2D1_DEBUG_LEVEL]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_debug_level
@@ -163,6 +205,17 @@ const (
 	DXGI_FORMAT_V408                       DXGI_FORMAT = 132
 )
 
+// [D2D1_EXTEND_MODE] enumeration.
+//
+// [D2D1_EXTEND_MODE]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_extend_mode
+type EXTEND_MODE uint32
+
+const (
+	EXTEND_MODE_CLAMP  EXTEND_MODE = 0
+	EXTEND_MODE_WRAP   EXTEND_MODE = 1
+	EXTEND_MODE_MIRROR EXTEND_MODE = 2
+)
+
 // [D2D1_FACTORY_TYPE] enumeration.
 //
 // [D2D1_FACTORY_TYPE]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_factory_type
@@ -184,6 +237,79 @@ const (
 	FEATURE_LEVEL_10      FEATURE_LEVEL = 0xa000 // The video card must support DirectX 10.
 )
 
+// [D2D1_FIGURE_BEGIN] enumeration.
+//
+// [D2D1_FIGURE_BEGIN]: https://learn.microsoft.com/en-us/windows/win32/api/dcommon/ne-dcommon-d2d1_figure_begin
+type FIGURE_BEGIN uint32
+
+const (
+	FIGURE_BEGIN_FILLED FIGURE_BEGIN = 0
+	FIGURE_BEGIN_HOLLOW FIGURE_BEGIN = 1
+)
+
+// [D2D1_FIGURE_END] enumeration.
+//
+// [D2D1_FIGURE_END]: https://learn.microsoft.com/en-us/windows/win32/api/dcommon/ne-dcommon-d2d1_figure_end
+type FIGURE_END uint32
+
+const (
+	FIGURE_END_OPEN   FIGURE_END = 0
+	FIGURE_END_CLOSED FIGURE_END = 1
+)
+
+// [D2D1_FILL_MODE] enumeration.
+//
+// [D2D1_FILL_MODE]: https://learn.microsoft.com/en-us/windows/win32/api/dcommon/ne-dcommon-d2d1_fill_mode
+type FILL_MODE uint32
+
+const (
+	FILL_MODE_ALTERNATE FILL_MODE = 0
+	FILL_MODE_WINDING   FILL_MODE = 1
+)
+
+// [D2D1_GAMMA] enumeration.
+//
+// [D2D1_GAMMA]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_gamma
+type GAMMA uint32
+
+const (
+	GAMMA_2_2 GAMMA = 0 // Colors are manipulated in 2.2 gamma color space.
+	GAMMA_1_0 GAMMA = 1 // Colors are manipulated in 1.0 gamma color space.
+)
+
+// [D2D1_LAYER_OPTIONS] enumeration.
+//
+// [D2D1_LAYER_OPTIONS]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_layer_options
+type LAYER_OPTIONS uint32
+
+const (
+	LAYER_OPTIONS_NONE                     LAYER_OPTIONS = 0x0000_0000
+	LAYER_OPTIONS_INITIALIZE_FOR_CLEARTYPE LAYER_OPTIONS = 0x0000_0001
+)
+
+// [D2D1_LINE_JOIN] enumeration.
+//
+// [D2D1_LINE_JOIN]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_line_join
+type LINE_JOIN uint32
+
+const (
+	LINE_JOIN_MITER          LINE_JOIN = 0
+	LINE_JOIN_BEVEL          LINE_JOIN = 1
+	LINE_JOIN_ROUND          LINE_JOIN = 2
+	LINE_JOIN_MITER_OR_BEVEL LINE_JOIN = 3
+)
+
+// [D2D1_PATH_SEGMENT] enumeration.
+//
+// [D2D1_PATH_SEGMENT]: https://learn.microsoft.com/en-us/windows/win32/api/dcommon/ne-dcommon-d2d1_path_segment
+type PATH_SEGMENT uint32
+
+const (
+	PATH_SEGMENT_NONE                  PATH_SEGMENT = 0x0000_0000
+	PATH_SEGMENT_FORCE_UNSTROKED       PATH_SEGMENT = 0x0000_0001
+	PATH_SEGMENT_FORCE_ROUND_LINE_JOIN PATH_SEGMENT = 0x0000_0002
+)
+
 // [D2D1_PRESENT_OPTIONS] enumeration.
 //
 // [D2D1_PRESENT_OPTIONS]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_present_options
@@ -217,6 +343,16 @@ const (
 	RENDER_TARGET_USAGE_GDI_COMPATIBLE        RENDER_TARGET_USAGE = 0x0000_0002
 )
 
+// [D2D1_SWEEP_DIRECTION] enumeration.
+//
+// [D2D1_SWEEP_DIRECTION]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_sweep_direction
+type SWEEP_DIRECTION uint32
+
+const (
+	SWEEP_DIRECTION_COUNTER_CLOCKWISE SWEEP_DIRECTION = 0
+	SWEEP_DIRECTION_CLOCKWISE         SWEEP_DIRECTION = 1
+)
+
 // [D2D1_WINDOW_STATE] enumeration.
 //
 // [D2D1_WINDOW_STATE]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/ne-d2d1-d2d1_window_state
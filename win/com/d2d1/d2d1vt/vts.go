@@ -6,6 +6,17 @@ import (
 	"github.com/rodrigocfd/windigo/win/com/com/comvt"
 )
 
+// [ID2D1Brush] virtual table.
+//
+// [ID2D1Brush]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1brush
+type ID2D1Brush struct {
+	ID2D1Resource
+	SetOpacity   uintptr
+	SetTransform uintptr
+	GetOpacity   uintptr
+	GetTransform uintptr
+}
+
 // [ID2D1Factory] virtual table.
 //
 // [ID2D1Factory]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1factory
@@ -27,6 +38,49 @@ type ID2D1Factory struct {
 	CreateDCRenderTarget           uintptr
 }
 
+// [ID2D1Geometry] virtual table.
+//
+// [ID2D1Geometry]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1geometry
+type ID2D1Geometry struct {
+	ID2D1Resource
+	GetBounds            uintptr
+	GetWidenedBounds     uintptr
+	StrokeContainsPoint  uintptr
+	FillContainsPoint    uintptr
+	CompareWithGeometry  uintptr
+	Simplify             uintptr
+	Tessellate           uintptr
+	CombineWithGeometry  uintptr
+	Outline              uintptr
+	ComputeArea          uintptr
+	ComputeLength        uintptr
+	ComputePointAtLength uintptr
+	Widen                uintptr
+}
+
+// [ID2D1GeometrySink] virtual table.
+//
+// [ID2D1GeometrySink]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1geometrysink
+type ID2D1GeometrySink struct {
+	ID2D1SimplifiedGeometrySink
+	AddLine             uintptr
+	AddBezier           uintptr
+	AddQuadraticBezier  uintptr
+	AddQuadraticBeziers uintptr
+	AddArc              uintptr
+}
+
+// [ID2D1GradientStopCollection] virtual table.
+//
+// [ID2D1GradientStopCollection]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1gradientstopcollection
+type ID2D1GradientStopCollection struct {
+	ID2D1Resource
+	GetGradientStopCount       uintptr
+	GetGradientStops           uintptr
+	GetColorInterpolationGamma uintptr
+	GetExtendMode              uintptr
+}
+
 // [ID2D1HwndRenderTarget] virtual table.
 //
 // [ID2D1HwndRenderTarget]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1hwndrendertarget
@@ -45,6 +99,18 @@ type ID2D1Layer struct {
 	GetSize uintptr
 }
 
+// [ID2D1LinearGradientBrush] virtual table.
+//
+// [ID2D1LinearGradientBrush]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1lineargradientbrush
+type ID2D1LinearGradientBrush struct {
+	ID2D1Brush
+	SetStartPoint             uintptr
+	SetEndPoint               uintptr
+	GetStartPoint             uintptr
+	GetEndPoint               uintptr
+	GetGradientStopCollection uintptr
+}
+
 // [ID2D1Mesh] virtual table.
 //
 // [ID2D1Mesh]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1mesh
@@ -53,6 +119,17 @@ type ID2D1Mesh struct {
 	Open uintptr
 }
 
+// [ID2D1PathGeometry] virtual table.
+//
+// [ID2D1PathGeometry]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1pathgeometry
+type ID2D1PathGeometry struct {
+	ID2D1Geometry
+	Open            uintptr
+	Stream          uintptr
+	GetSegmentCount uintptr
+	GetFigureCount  uintptr
+}
+
 // [ID2D1RenderTarget] virtual table.
 //
 // [ID2D1RenderTarget]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1rendertarget
@@ -121,6 +198,45 @@ type ID2D1Resource struct {
 	GetFactory uintptr
 }
 
+// [ID2D1SimplifiedGeometrySink] virtual table.
+//
+// [ID2D1SimplifiedGeometrySink]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1simplifiedgeometrysink
+type ID2D1SimplifiedGeometrySink struct {
+	comvt.IUnknown
+	SetFillMode     uintptr
+	SetSegmentFlags uintptr
+	BeginFigure     uintptr
+	AddLines        uintptr
+	AddBeziers      uintptr
+	EndFigure       uintptr
+	Close           uintptr
+}
+
+// [ID2D1SolidColorBrush] virtual table.
+//
+// [ID2D1SolidColorBrush]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1solidcolorbrush
+type ID2D1SolidColorBrush struct {
+	ID2D1Brush
+	SetColor uintptr
+	GetColor uintptr
+}
+
+// [ID2D1StrokeStyle] virtual table.
+//
+// [ID2D1StrokeStyle]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1strokestyle
+type ID2D1StrokeStyle struct {
+	ID2D1Resource
+	GetStartCap    uintptr
+	GetEndCap      uintptr
+	GetDashCap     uintptr
+	GetMiterLimit  uintptr
+	GetLineJoin    uintptr
+	GetDashOffset  uintptr
+	GetDashStyle   uintptr
+	GetDashesCount uintptr
+	GetDashes      uintptr
+}
+
 // [ID2D1TessellationSink] virtual table.
 //
 // [ID2D1TessellationSink]: https://learn.microsoft.com/en-us/windows/win32/api/d2d1/nn-d2d1-id2d1tessellationsink
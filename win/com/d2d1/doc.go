@@ -0,0 +1,8 @@
+//go:build windows && !arm64
+
+// Package d2d1 contains the Direct2D COM interfaces.
+//
+// It is not available on windows/arm64: Direct2D methods take float32 and
+// POINT_2F arguments by value, which go in the floating-point registers on this
+// architecture, and syscall.SyscallN only uses the integer ones.
+package d2d1
@@ -3,7 +3,18 @@
 package shell
 
 import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
 	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
 )
 
 // [IDataObject] COM interface.
@@ -11,6 +22,86 @@ import (
 // [IDataObject]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/nn-objidl-idataobject
 type IDataObject interface {
 	com.IUnknown
+
+	// [EnumFormatEtc] COM method.
+	//
+	// Prefer using IDataObject.ListFormats(), which directly retrieves all
+	// formats at once.
+	//
+	// ⚠️ You must defer IEnumFORMATETC.Release() on the returned object.
+	//
+	// [EnumFormatEtc]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/nf-objidl-idataobject-enumformatetc
+	EnumFormatEtc(direction shellco.DATADIR) IEnumFORMATETC
+
+	// [GetData] COM method.
+	//
+	// ⚠️ You must defer STGMEDIUM.ReleaseStgMedium() on the returned object.
+	//
+	// # Example
+	//
+	//	var dataObj shell.IDataObject // initialized somewhere
+	//
+	//	fe := shell.FormatEtcHGlobal(co.CF_UNICODETEXT)
+	//	if dataObj.QueryGetData(&fe) {
+	//		medium := dataObj.GetData(&fe)
+	//		defer medium.ReleaseStgMedium()
+	//	}
+	//
+	// [GetData]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/nf-objidl-idataobject-getdata
+	GetData(formatEtc *FORMATETC) STGMEDIUM
+
+	// This helper method retrieves the data of the given clipboard format from
+	// global memory. Returns false if the format is not available.
+	//
+	// For registered formats, call win.RegisterClipboardFormat() to retrieve
+	// the format ID.
+	//
+	// # Example
+	//
+	//	var dataObj shell.IDataObject // initialized somewhere
+	//
+	//	cfHtml, _ := win.RegisterClipboardFormat("HTML Format")
+	//	if html, ok := dataObj.GetHGlobalData(cfHtml); ok {
+	//		println(string(html))
+	//	}
+	GetHGlobalData(format co.CF) ([]byte, bool)
+
+	// This helper method retrieves the text in the CF_UNICODETEXT format.
+	// Returns false if the format is not available.
+	GetText() (string, bool)
+
+	// This helper method retrieves the file paths in the CF_HDROP format.
+	// Returns false if the format is not available, and an empty slice if the
+	// HDROP has no files.
+	//
+	// # Example
+	//
+	//	var dataObj shell.IDataObject // initialized somewhere
+	//
+	//	if paths, ok := dataObj.ListFiles(); ok {
+	//		for _, path := range paths {
+	//			println(path)
+	//		}
+	//	}
+	ListFiles() ([]string, bool)
+
+	// This helper method calls IDataObject.EnumFormatEtc() with DATADIR_GET,
+	// and retrieves all the formats at once.
+	ListFormats() []FORMATETC
+
+	// [QueryGetData] COM method.
+	//
+	// Returns true if a GetData() call with the given format would succeed.
+	//
+	// [QueryGetData]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/nf-objidl-idataobject-querygetdata
+	QueryGetData(formatEtc *FORMATETC) bool
+
+	// [SetData] COM method.
+	//
+	// If release is true, the data object takes ownership of the medium.
+	//
+	// [SetData]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/nf-objidl-idataobject-setdata
+	SetData(formatEtc *FORMATETC, medium *STGMEDIUM, release bool)
 }
 
 type _IDataObject struct{ com.IUnknown }
@@ -21,3 +112,124 @@ type _IDataObject struct{ com.IUnknown }
 func NewIDataObject(base com.IUnknown) IDataObject {
 	return &_IDataObject{IUnknown: base}
 }
+
+func (me *_IDataObject) EnumFormatEtc(direction shellco.DATADIR) IEnumFORMATETC {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IDataObject)(unsafe.Pointer(*me.Ptr())).EnumFormatEtc,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(direction), uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIEnumFORMATETC(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IDataObject) GetData(formatEtc *FORMATETC) STGMEDIUM {
+	var medium STGMEDIUM
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IDataObject)(unsafe.Pointer(*me.Ptr())).GetData,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(formatEtc)), uintptr(unsafe.Pointer(&medium)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return medium
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IDataObject) GetHGlobalData(format co.CF) ([]byte, bool) {
+	fe := FormatEtcHGlobal(format)
+	if !me.QueryGetData(&fe) {
+		return nil, false
+	}
+
+	medium := me.GetData(&fe)
+	defer medium.ReleaseStgMedium()
+
+	hMem, ok := medium.HGlobal()
+	if !ok {
+		return nil, false
+	}
+	data := hMem.GlobalLock(hMem.GlobalSize())
+	defer hMem.GlobalUnlock()
+	return append([]byte{}, data...), true // copy before the memory is released
+}
+
+func (me *_IDataObject) GetText() (string, bool) {
+	data, ok := me.GetHGlobalData(co.CF_UNICODETEXT)
+	if !ok {
+		return "", false
+	} else if len(data) < 2 {
+		return "", true
+	}
+	words := unsafe.Slice((*uint16)(unsafe.Pointer(&data[0])), len(data)/2)
+	return win.Str.FromNativeSlice(words), true
+}
+
+func (me *_IDataObject) ListFiles() ([]string, bool) {
+	fe := FormatEtcHGlobal(co.CF_HDROP)
+	if !me.QueryGetData(&fe) {
+		return nil, false
+	}
+
+	medium := me.GetData(&fe)
+	defer medium.ReleaseStgMedium() // don't call DragFinish()
+
+	hMem, ok := medium.HGlobal()
+	if !ok {
+		return nil, false
+	}
+	hDrop := win.HDROP(hMem)
+
+	// Raw count query, because HDROP.DragQueryFile() panics on zero.
+	count, _, _ := syscall.SyscallN(proc.DragQueryFile.Addr(),
+		uintptr(hDrop), 0xffff_ffff, 0, 0)
+	paths := make([]string, 0, count)
+	for i := uint32(0); i < uint32(count); i++ {
+		pathBuf := make([]uint16, hDrop.DragQueryFile(i, nil, 0)+1) // room for terminating null
+		hDrop.DragQueryFile(i, &pathBuf[0], uint32(len(pathBuf)))
+		paths = append(paths, win.Str.FromNativeSlice(pathBuf))
+	}
+	return paths, true
+}
+
+func (me *_IDataObject) ListFormats() []FORMATETC {
+	enumFmt := me.EnumFormatEtc(shellco.DATADIR_GET)
+	defer enumFmt.Release()
+	return enumFmt.ListAll()
+}
+
+func (me *_IDataObject) QueryGetData(formatEtc *FORMATETC) bool {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IDataObject)(unsafe.Pointer(*me.Ptr())).QueryGetData,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(formatEtc)))
+
+	switch hr := errco.ERROR(ret); hr {
+	case errco.S_OK:
+		return true
+	case errco.S_FALSE, errco.DV_E_FORMATETC, errco.DV_E_TYMED,
+		errco.DV_E_DVASPECT, errco.DV_E_LINDEX, errco.DV_E_CLIPFORMAT:
+		return false
+	default:
+		panic(hr)
+	}
+}
+
+func (me *_IDataObject) SetData(
+	formatEtc *FORMATETC, medium *STGMEDIUM, release bool) {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IDataObject)(unsafe.Pointer(*me.Ptr())).SetData,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(formatEtc)), uintptr(unsafe.Pointer(medium)),
+		util.BoolToUintptr(release))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
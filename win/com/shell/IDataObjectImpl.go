@@ -0,0 +1,258 @@
+//go:build windows
+
+package shell
+
+import (
+	"encoding/binary"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Data of a clipboard format, offered by an IDataObject created with
+// NewIDataObjectImpl().
+//
+// For registered formats, call win.RegisterClipboardFormat() to retrieve the
+// format ID.
+type DataItem struct {
+	Format co.CF
+	Data   []byte
+}
+
+// Creates a DataItem with the text in the CF_UNICODETEXT format.
+func DataItemText(text string) DataItem {
+	return DataItem{
+		Format: co.CF_UNICODETEXT,
+		Data:   _Utf16Bytes(win.Str.ToNativeSlice(text)),
+	}
+}
+
+// Creates a DataItem with the file paths in the CF_HDROP format.
+func DataItemFiles(paths []string) DataItem {
+	const SZ_DROPFILES = 20
+
+	var words []uint16
+	for _, path := range paths {
+		words = append(words, win.Str.ToNativeSlice(path)...) // null-terminated
+	}
+	words = append(words, 0) // the list is double-null terminated
+
+	data := make([]byte, SZ_DROPFILES, SZ_DROPFILES+len(words)*2)
+	binary.LittleEndian.PutUint32(data[0:], SZ_DROPFILES) // pFiles
+	binary.LittleEndian.PutUint32(data[16:], 1)           // fWide
+	data = append(data, _Utf16Bytes(words)...)
+
+	return DataItem{Format: co.CF_HDROP, Data: data}
+}
+
+func _Utf16Bytes(words []uint16) []byte {
+	buf := make([]byte, 0, len(words)*2)
+	for _, w := range words {
+		buf = binary.LittleEndian.AppendUint16(buf, w)
+	}
+	return buf
+}
+
+// Creates an IDataObject COM object implemented in Go, which offers the given
+// data in global memory. It can be passed to OleSetClipboard() or DoDragDrop().
+//
+// The data object also accepts IDataObject.SetData() calls with global memory,
+// which are commonly made by the shell during drag and drop operations.
+//
+// ⚠️ You must defer IDataObject.Release().
+//
+// # Example
+//
+//	dataObj := shell.NewIDataObjectImpl(
+//		shell.DataItemText("Hello"),
+//		shell.DataItemFiles([]string{"C:\\Temp\\foo.txt"}),
+//	)
+//	defer dataObj.Release()
+//
+//	shell.OleSetClipboard(dataObj)
+//	shell.OleFlushClipboard()
+func NewIDataObjectImpl(items ...DataItem) IDataObject {
+	_globalIDataObjectOnce.Do(func() {
+		_globalIDataObjectVtbl = com.NewGoVtbl(
+			[]co.IID{shellco.IID_IDataObject},
+			syscall.NewCallback(_IDataObjectGetData),
+			syscall.NewCallback(_IDataObjectGetDataHere),
+			syscall.NewCallback(_IDataObjectQueryGetData),
+			syscall.NewCallback(_IDataObjectGetCanonicalFormatEtc),
+			syscall.NewCallback(_IDataObjectSetData),
+			syscall.NewCallback(_IDataObjectEnumFormatEtc),
+			syscall.NewCallback(_IDataObjectDAdvise),
+			syscall.NewCallback(_IDataObjectDUnadvise),
+			syscall.NewCallback(_IDataObjectEnumDAdvise),
+		)
+	})
+
+	impl := &_DataObjectImpl{items: make([]DataItem, 0, len(items))}
+	for _, item := range items {
+		impl.set(item.Format, item.Data)
+	}
+	return NewIDataObject(com.NewGoObj(_globalIDataObjectVtbl, impl))
+}
+
+var (
+	_globalIDataObjectOnce sync.Once
+	_globalIDataObjectVtbl *com.GoVtbl
+)
+
+type _DataObjectImpl struct {
+	mutex sync.Mutex
+	items []DataItem
+}
+
+func (me *_DataObjectImpl) find(format co.CF) (DataItem, bool) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	for _, item := range me.items {
+		if item.Format == format {
+			return item, true
+		}
+	}
+	return DataItem{}, false
+}
+
+func (me *_DataObjectImpl) set(format co.CF, data []byte) {
+	me.mutex.Lock()
+	defer me.mutex.Unlock()
+
+	dataCopy := append([]byte{}, data...)
+	for i := range me.items {
+		if me.items[i].Format == format {
+			me.items[i].Data = dataCopy
+			return
+		}
+	}
+	me.items = append(me.items, DataItem{Format: format, Data: dataCopy})
+}
+
+func (me *_DataObjectImpl) check(pformatetc *FORMATETC) (DataItem, errco.ERROR) {
+	if pformatetc == nil {
+		return DataItem{}, errco.E_INVALIDARG
+	} else if pformatetc.DwAspect != shellco.DVASPECT_CONTENT {
+		return DataItem{}, errco.DV_E_DVASPECT
+	} else if (pformatetc.Tymed & shellco.TYMED_HGLOBAL) == 0 {
+		return DataItem{}, errco.DV_E_TYMED
+	}
+
+	item, ok := me.find(pformatetc.CfFormat)
+	if !ok {
+		return DataItem{}, errco.DV_E_FORMATETC
+	}
+	return item, errco.S_OK
+}
+
+func _IDataObjectGetData(
+	this *com.GoObj, pformatetc *FORMATETC, pmedium *STGMEDIUM) uintptr {
+
+	item, hr := this.Impl().(*_DataObjectImpl).check(pformatetc)
+	if hr != errco.S_OK {
+		return uintptr(hr)
+	}
+
+	numBytes := len(item.Data)
+	if numBytes == 0 {
+		numBytes = 1 // GlobalAlloc() fails with zero bytes
+	}
+	hMem := win.GlobalAlloc(co.GMEM_MOVEABLE|co.GMEM_ZEROINIT, numBytes)
+	copy(hMem.GlobalLock(numBytes), item.Data)
+	hMem.GlobalUnlock()
+
+	pmedium.SetHGlobal(hMem) // will be freed by the caller
+	return uintptr(errco.S_OK)
+}
+
+func _IDataObjectGetDataHere(
+	_ *com.GoObj, _ *FORMATETC, _ *STGMEDIUM) uintptr {
+
+	return uintptr(errco.E_NOTIMPL)
+}
+
+func _IDataObjectQueryGetData(this *com.GoObj, pformatetc *FORMATETC) uintptr {
+	_, hr := this.Impl().(*_DataObjectImpl).check(pformatetc)
+	return uintptr(hr)
+}
+
+func _IDataObjectGetCanonicalFormatEtc(
+	_ *com.GoObj, _ *FORMATETC, pformatetcOut *FORMATETC) uintptr {
+
+	if pformatetcOut == nil {
+		return uintptr(errco.E_INVALIDARG)
+	}
+	pformatetcOut.Ptd = 0
+	return uintptr(errco.DATA_S_SAMEFORMATETC)
+}
+
+func _IDataObjectSetData(
+	this *com.GoObj, pformatetc *FORMATETC, pmedium *STGMEDIUM,
+	fRelease int32) uintptr {
+
+	if pformatetc == nil || pmedium == nil {
+		return uintptr(errco.E_INVALIDARG)
+	}
+	hMem, ok := pmedium.HGlobal()
+	if !ok {
+		return uintptr(errco.DV_E_TYMED)
+	}
+
+	data := hMem.GlobalLock(hMem.GlobalSize())
+	this.Impl().(*_DataObjectImpl).set(pformatetc.CfFormat, data)
+	hMem.GlobalUnlock()
+
+	if fRelease != 0 {
+		pmedium.ReleaseStgMedium()
+	}
+	return uintptr(errco.S_OK)
+}
+
+func _IDataObjectEnumFormatEtc(
+	this *com.GoObj, dwDirection shellco.DATADIR,
+	ppenumFormatEtc ***comvt.IUnknown) uintptr {
+
+	if dwDirection != shellco.DATADIR_GET {
+		return uintptr(errco.E_NOTIMPL)
+	}
+
+	impl := this.Impl().(*_DataObjectImpl)
+	impl.mutex.Lock()
+	formats := make([]FORMATETC, 0, len(impl.items))
+	for _, item := range impl.items {
+		formats = append(formats, FormatEtcHGlobal(item.Format))
+	}
+	impl.mutex.Unlock()
+
+	var pFormats *FORMATETC
+	if len(formats) > 0 {
+		pFormats = &formats[0]
+	}
+	ret, _, _ := syscall.SyscallN(proc.SHCreateStdEnumFmtEtc.Addr(),
+		uintptr(len(formats)), uintptr(unsafe.Pointer(pFormats)),
+		uintptr(unsafe.Pointer(ppenumFormatEtc))) // the array is copied
+	return ret
+}
+
+func _IDataObjectDAdvise(
+	_ *com.GoObj, _ *FORMATETC, _ uint32, _ uintptr, _ *uint32) uintptr {
+
+	return uintptr(errco.OLE_E_ADVISENOTSUPPORTED)
+}
+
+func _IDataObjectDUnadvise(_ *com.GoObj, _ uint32) uintptr {
+	return uintptr(errco.OLE_E_ADVISENOTSUPPORTED)
+}
+
+func _IDataObjectEnumDAdvise(_ *com.GoObj, _ uintptr) uintptr {
+	return uintptr(errco.OLE_E_ADVISENOTSUPPORTED)
+}
@@ -0,0 +1,75 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// [IDropSource] COM interface.
+//
+// [IDropSource]: https://learn.microsoft.com/en-us/windows/win32/api/oleidl/nn-oleidl-idropsource
+type IDropSource interface {
+	com.IUnknown
+
+	// [GiveFeedback] COM method.
+	//
+	// Returns errco.S_OK or errco.DRAGDROP_S_USEDEFAULTCURSORS.
+	//
+	// [GiveFeedback]: https://learn.microsoft.com/en-us/windows/win32/api/oleidl/nf-oleidl-idropsource-givefeedback
+	GiveFeedback(effect shellco.DROPEFFECT) errco.ERROR
+
+	// [QueryContinueDrag] COM method.
+	//
+	// Returns errco.S_OK, errco.DRAGDROP_S_DROP or errco.DRAGDROP_S_CANCEL.
+	//
+	// [QueryContinueDrag]: https://learn.microsoft.com/en-us/windows/win32/api/oleidl/nf-oleidl-idropsource-querycontinuedrag
+	QueryContinueDrag(escapePressed bool, keyState co.MK) errco.ERROR
+}
+
+type _IDropSource struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IDropSource.Release().
+func NewIDropSource(base com.IUnknown) IDropSource {
+	return &_IDropSource{IUnknown: base}
+}
+
+func (me *_IDropSource) GiveFeedback(effect shellco.DROPEFFECT) errco.ERROR {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IDropSource)(unsafe.Pointer(*me.Ptr())).GiveFeedback,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(effect))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK ||
+		hr == errco.DRAGDROP_S_USEDEFAULTCURSORS {
+		return hr
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IDropSource) QueryContinueDrag(
+	escapePressed bool, keyState co.MK) errco.ERROR {
+
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IDropSource)(unsafe.Pointer(*me.Ptr())).QueryContinueDrag,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		util.BoolToUintptr(escapePressed), uintptr(keyState))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK ||
+		hr == errco.DRAGDROP_S_DROP || hr == errco.DRAGDROP_S_CANCEL {
+		return hr
+	} else {
+		panic(hr)
+	}
+}
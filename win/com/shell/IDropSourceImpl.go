@@ -0,0 +1,75 @@
+//go:build windows
+
+package shell
+
+import (
+	"sync"
+	"syscall"
+
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Go implementation of the [IDropSource] COM interface, to be passed to
+// NewIDropSourceImpl().
+//
+// [IDropSource]: https://learn.microsoft.com/en-us/windows/win32/api/oleidl/nn-oleidl-idropsource
+type IDropSourceImpl interface {
+	// Must return errco.S_OK or errco.DRAGDROP_S_USEDEFAULTCURSORS.
+	GiveFeedback(effect shellco.DROPEFFECT) errco.ERROR
+
+	// Must return errco.S_OK to continue, errco.DRAGDROP_S_DROP to drop, or
+	// errco.DRAGDROP_S_CANCEL to cancel the operation.
+	QueryContinueDrag(escapePressed bool, keyState co.MK) errco.ERROR
+}
+
+// Creates an IDropSource COM object whose methods are implemented in Go.
+//
+// ⚠️ You must defer IDropSource.Release().
+//
+// # Example
+//
+//	type MySource struct{}
+//
+//	func (*MySource) GiveFeedback(_ shellco.DROPEFFECT) errco.ERROR {
+//		return errco.DRAGDROP_S_USEDEFAULTCURSORS
+//	}
+//
+//	func (*MySource) QueryContinueDrag(
+//		escapePressed bool, keyState co.MK) errco.ERROR {
+//		if escapePressed {
+//			return errco.DRAGDROP_S_CANCEL
+//		} else if (keyState & co.MK_LBUTTON) == 0 {
+//			return errco.DRAGDROP_S_DROP
+//		}
+//		return errco.S_OK
+//	}
+//
+//	source := shell.NewIDropSourceImpl(&MySource{})
+//	defer source.Release()
+func NewIDropSourceImpl(impl IDropSourceImpl) IDropSource {
+	_globalIDropSourceOnce.Do(func() {
+		_globalIDropSourceVtbl = com.NewGoVtbl(
+			[]co.IID{shellco.IID_IDropSource},
+			syscall.NewCallback(
+				func(this *com.GoObj, fEscapePressed int32, grfKeyState uint32) uintptr {
+					return uintptr(this.Impl().(IDropSourceImpl).
+						QueryContinueDrag(fEscapePressed != 0, co.MK(grfKeyState)))
+				},
+			),
+			syscall.NewCallback(
+				func(this *com.GoObj, dwEffect shellco.DROPEFFECT) uintptr {
+					return uintptr(this.Impl().(IDropSourceImpl).GiveFeedback(dwEffect))
+				},
+			),
+		)
+	})
+	return NewIDropSource(com.NewGoObj(_globalIDropSourceVtbl, impl))
+}
+
+var (
+	_globalIDropSourceOnce sync.Once
+	_globalIDropSourceVtbl *com.GoVtbl
+)
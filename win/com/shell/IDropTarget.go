@@ -58,10 +58,14 @@ func (me *_IDropTarget) DragEnter(
 
 	ret, _, _ := syscall.SyscallN(
 		(*shellvt.IDropTarget)(unsafe.Pointer(*me.Ptr())).DragEnter,
-		uintptr(unsafe.Pointer(me.Ptr())),
-		uintptr(unsafe.Pointer(dataObj.Ptr())),
-		uintptr(keyState), uintptr(pt.X), uintptr(pt.Y),
-		uintptr(unsafe.Pointer(effect)))
+		_PointlArgs(
+			[]uintptr{
+				uintptr(unsafe.Pointer(me.Ptr())),
+				uintptr(unsafe.Pointer(dataObj.Ptr())),
+				uintptr(keyState),
+			},
+			pt,
+			uintptr(unsafe.Pointer(effect)))...)
 
 	if hr := errco.ERROR(ret); hr != errco.S_OK {
 		panic(hr)
@@ -83,9 +87,13 @@ func (me *_IDropTarget) DragOver(
 
 	ret, _, _ := syscall.SyscallN(
 		(*shellvt.IDropTarget)(unsafe.Pointer(*me.Ptr())).DragOver,
-		uintptr(unsafe.Pointer(me.Ptr())),
-		uintptr(keyState), uintptr(pt.X), uintptr(pt.Y),
-		uintptr(unsafe.Pointer(effect)))
+		_PointlArgs(
+			[]uintptr{
+				uintptr(unsafe.Pointer(me.Ptr())),
+				uintptr(keyState),
+			},
+			pt,
+			uintptr(unsafe.Pointer(effect)))...)
 
 	if hr := errco.ERROR(ret); hr != errco.S_OK {
 		panic(hr)
@@ -98,10 +106,14 @@ func (me *_IDropTarget) Drop(
 
 	ret, _, _ := syscall.SyscallN(
 		(*shellvt.IDropTarget)(unsafe.Pointer(*me.Ptr())).Drop,
-		uintptr(unsafe.Pointer(me.Ptr())),
-		uintptr(unsafe.Pointer(dataObj.Ptr())),
-		uintptr(keyState), uintptr(pt.X), uintptr(pt.Y),
-		uintptr(unsafe.Pointer(effect)))
+		_PointlArgs(
+			[]uintptr{
+				uintptr(unsafe.Pointer(me.Ptr())),
+				uintptr(unsafe.Pointer(dataObj.Ptr())),
+				uintptr(keyState),
+			},
+			pt,
+			uintptr(unsafe.Pointer(effect)))...)
 
 	if hr := errco.ERROR(ret); hr != errco.S_OK {
 		panic(hr)
@@ -0,0 +1,81 @@
+//go:build windows
+
+package shell
+
+import (
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Go implementation of the [IDropTarget] COM interface, to be passed to
+// NewIDropTargetImpl().
+//
+// The IDataObject received by DragEnter() and Drop() is owned by the caller;
+// if you want to keep it, call IDataObject.AddRef().
+//
+// [IDropTarget]: https://learn.microsoft.com/en-us/windows/win32/api/oleidl/nn-oleidl-idroptarget
+type IDropTargetImpl interface {
+	DragEnter(dataObj IDataObject, keyState co.MK,
+		pt win.POINT, effect *shellco.DROPEFFECT)
+	DragLeave()
+	DragOver(keyState co.MK, pt win.POINT, effect *shellco.DROPEFFECT)
+	Drop(dataObj IDataObject, keyState co.MK,
+		pt win.POINT, effect *shellco.DROPEFFECT)
+}
+
+// Creates an IDropTarget COM object whose methods are implemented in Go.
+//
+// ⚠️ You must defer IDropTarget.Release().
+//
+// # Example
+//
+//	type MyTarget struct{}
+//
+//	func (*MyTarget) DragEnter(dataObj shell.IDataObject, keyState co.MK,
+//		pt win.POINT, effect *shellco.DROPEFFECT) {
+//		*effect = shellco.DROPEFFECT_COPY
+//	}
+//	// ... the other methods
+//
+//	target := shell.NewIDropTargetImpl(&MyTarget{})
+//	defer target.Release()
+func NewIDropTargetImpl(impl IDropTargetImpl) IDropTarget {
+	return NewIDropTarget(com.NewGoObj(_IDropTargetVtbl(), impl))
+}
+
+func _IDropTargetDragEnter(
+	this *com.GoObj, pDataObj **comvt.IUnknown, grfKeyState uint32,
+	pt win.POINT, pdwEffect *shellco.DROPEFFECT) uintptr {
+
+	this.Impl().(IDropTargetImpl).DragEnter(
+		NewIDataObject(com.NewIUnknown(pDataObj)),
+		co.MK(grfKeyState), pt, pdwEffect)
+	return uintptr(errco.S_OK)
+}
+
+func _IDropTargetDragLeave(this *com.GoObj) uintptr {
+	this.Impl().(IDropTargetImpl).DragLeave()
+	return uintptr(errco.S_OK)
+}
+
+func _IDropTargetDragOver(
+	this *com.GoObj, grfKeyState uint32,
+	pt win.POINT, pdwEffect *shellco.DROPEFFECT) uintptr {
+
+	this.Impl().(IDropTargetImpl).DragOver(co.MK(grfKeyState), pt, pdwEffect)
+	return uintptr(errco.S_OK)
+}
+
+func _IDropTargetDrop(
+	this *com.GoObj, pDataObj **comvt.IUnknown, grfKeyState uint32,
+	pt win.POINT, pdwEffect *shellco.DROPEFFECT) uintptr {
+
+	this.Impl().(IDropTargetImpl).Drop(
+		NewIDataObject(com.NewIUnknown(pDataObj)),
+		co.MK(grfKeyState), pt, pdwEffect)
+	return uintptr(errco.S_OK)
+}
@@ -0,0 +1,59 @@
+//go:build windows
+
+package shell
+
+import (
+	"sync"
+	"syscall"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+)
+
+// In 32-bit, a POINTL passed by value takes two arguments.
+
+func _PointlArgs(before []uintptr, pt win.POINT, after ...uintptr) []uintptr {
+	return append(append(before, uintptr(pt.X), uintptr(pt.Y)), after...)
+}
+
+var (
+	_globalIDropTargetOnce sync.Once
+	_globalIDropTargetVtbl *com.GoVtbl
+)
+
+func _IDropTargetVtbl() *com.GoVtbl {
+	_globalIDropTargetOnce.Do(func() {
+		_globalIDropTargetVtbl = com.NewGoVtbl(
+			[]co.IID{shellco.IID_IDropTarget},
+			syscall.NewCallback(
+				func(this *com.GoObj, pDataObj **comvt.IUnknown, grfKeyState uint32,
+					x, y int32, pdwEffect *shellco.DROPEFFECT) uintptr {
+
+					return _IDropTargetDragEnter(this, pDataObj, grfKeyState,
+						win.POINT{X: x, Y: y}, pdwEffect)
+				},
+			),
+			syscall.NewCallback(
+				func(this *com.GoObj, grfKeyState uint32,
+					x, y int32, pdwEffect *shellco.DROPEFFECT) uintptr {
+
+					return _IDropTargetDragOver(this, grfKeyState,
+						win.POINT{X: x, Y: y}, pdwEffect)
+				},
+			),
+			syscall.NewCallback(_IDropTargetDragLeave),
+			syscall.NewCallback(
+				func(this *com.GoObj, pDataObj **comvt.IUnknown, grfKeyState uint32,
+					x, y int32, pdwEffect *shellco.DROPEFFECT) uintptr {
+
+					return _IDropTargetDrop(this, pDataObj, grfKeyState,
+						win.POINT{X: x, Y: y}, pdwEffect)
+				},
+			),
+		)
+	})
+	return _globalIDropTargetVtbl
+}
@@ -0,0 +1,65 @@
+//go:build windows
+
+package shell
+
+import (
+	"sync"
+	"syscall"
+
+	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+)
+
+// In ARM64, a POINTL passed by value is packed into a single register, like
+// in amd64.
+
+func _PointlArgs(before []uintptr, pt win.POINT, after ...uintptr) []uintptr {
+	packed := uintptr(uint32(pt.X)) | uintptr(uint32(pt.Y))<<32
+	return append(append(before, packed), after...)
+}
+
+func _PointlUnpack(packed uintptr) win.POINT {
+	return win.POINT{X: int32(uint32(packed)), Y: int32(uint32(packed >> 32))}
+}
+
+var (
+	_globalIDropTargetOnce sync.Once
+	_globalIDropTargetVtbl *com.GoVtbl
+)
+
+func _IDropTargetVtbl() *com.GoVtbl {
+	_globalIDropTargetOnce.Do(func() {
+		_globalIDropTargetVtbl = com.NewGoVtbl(
+			[]co.IID{shellco.IID_IDropTarget},
+			syscall.NewCallback(
+				func(this *com.GoObj, pDataObj **comvt.IUnknown, grfKeyState uint32,
+					pt uintptr, pdwEffect *shellco.DROPEFFECT) uintptr {
+
+					return _IDropTargetDragEnter(this, pDataObj, grfKeyState,
+						_PointlUnpack(pt), pdwEffect)
+				},
+			),
+			syscall.NewCallback(
+				func(this *com.GoObj, grfKeyState uint32,
+					pt uintptr, pdwEffect *shellco.DROPEFFECT) uintptr {
+
+					return _IDropTargetDragOver(this, grfKeyState,
+						_PointlUnpack(pt), pdwEffect)
+				},
+			),
+			syscall.NewCallback(_IDropTargetDragLeave),
+			syscall.NewCallback(
+				func(this *com.GoObj, pDataObj **comvt.IUnknown, grfKeyState uint32,
+					pt uintptr, pdwEffect *shellco.DROPEFFECT) uintptr {
+
+					return _IDropTargetDrop(this, pDataObj, grfKeyState,
+						_PointlUnpack(pt), pdwEffect)
+				},
+			),
+		)
+	})
+	return _globalIDropTargetVtbl
+}
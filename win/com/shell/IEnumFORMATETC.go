@@ -0,0 +1,132 @@
+//go:build windows
+
+package shell
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// [IEnumFORMATETC] COM interface.
+//
+// [IEnumFORMATETC]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/nn-objidl-ienumformatetc
+type IEnumFORMATETC interface {
+	com.IUnknown
+
+	// [Clone] COM method.
+	//
+	// ⚠️ You must defer IEnumFORMATETC.Release() on the returned object.
+	//
+	// [Clone]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/nf-objidl-ienumformatetc-clone
+	Clone() IEnumFORMATETC
+
+	// This helper method calls IEnumFORMATETC.Next() until all the remaining
+	// formats are retrieved.
+	//
+	// # Example
+	//
+	//	var enumFmt shell.IEnumFORMATETC // initialized somewhere
+	//
+	//	formats := enumFmt.ListAll()
+	ListAll() []FORMATETC
+
+	// [Next] COM method.
+	//
+	// Returns false if there are no more formats.
+	//
+	// [Next]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/nf-objidl-ienumformatetc-next
+	Next() (FORMATETC, bool)
+
+	// [Reset] COM method.
+	//
+	// [Reset]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/nf-objidl-ienumformatetc-reset
+	Reset()
+
+	// [Skip] COM method.
+	//
+	// Returns false if the end of the enumeration was reached.
+	//
+	// [Skip]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/nf-objidl-ienumformatetc-skip
+	Skip(count int) bool
+}
+
+type _IEnumFORMATETC struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IEnumFORMATETC.Release().
+func NewIEnumFORMATETC(base com.IUnknown) IEnumFORMATETC {
+	return &_IEnumFORMATETC{IUnknown: base}
+}
+
+func (me *_IEnumFORMATETC) Clone() IEnumFORMATETC {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IEnumFORMATETC)(unsafe.Pointer(*me.Ptr())).Clone,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIEnumFORMATETC(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IEnumFORMATETC) ListAll() []FORMATETC {
+	formats := make([]FORMATETC, 0, 10) // arbitrary
+	for {
+		fe, ok := me.Next()
+		if !ok {
+			return formats
+		}
+		formats = append(formats, fe)
+	}
+}
+
+func (me *_IEnumFORMATETC) Next() (FORMATETC, bool) {
+	var fe FORMATETC
+	var numFetched uint32
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IEnumFORMATETC)(unsafe.Pointer(*me.Ptr())).Next,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		1, uintptr(unsafe.Pointer(&fe)), uintptr(unsafe.Pointer(&numFetched)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return fe, true
+	} else if hr == errco.S_FALSE {
+		return FORMATETC{}, false
+	} else {
+		panic(hr)
+	}
+}
+
+func (me *_IEnumFORMATETC) Reset() {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IEnumFORMATETC)(unsafe.Pointer(*me.Ptr())).Reset,
+		uintptr(unsafe.Pointer(me.Ptr())))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+func (me *_IEnumFORMATETC) Skip(count int) bool {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IEnumFORMATETC)(unsafe.Pointer(*me.Ptr())).Skip,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(uint32(count)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return true
+	} else if hr == errco.S_FALSE {
+		return false
+	} else {
+		panic(hr)
+	}
+}
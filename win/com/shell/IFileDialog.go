@@ -20,6 +20,24 @@ import (
 type IFileDialog interface {
 	IModalWindow
 
+	// [Advise] COM method.
+	//
+	// Returns the cookie to be passed to Unadvise().
+	//
+	// # Example
+	//
+	//	var fd shell.IFileDialog // initialized somewhere
+	//	var myImpl shell.IFileDialogEventsImpl
+	//
+	//	events := shell.NewIFileDialogEventsImpl(myImpl)
+	//	defer events.Release()
+	//
+	//	cookie := fd.Advise(events)
+	//	defer fd.Unadvise(cookie)
+	//
+	// [Advise]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-advise
+	Advise(events IFileDialogEvents) uint32
+
 	// [ClearClientData] COM method.
 	//
 	// [ClearClientData]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-clearclientdata
@@ -144,6 +162,11 @@ type IFileDialog interface {
 	//
 	// [SetTitle]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-settitle
 	SetTitle(title string)
+
+	// [Unadvise] COM method.
+	//
+	// [Unadvise]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/nf-shobjidl_core-ifiledialog-unadvise
+	Unadvise(cookie uint32)
 }
 
 type _IFileDialog struct{ IModalWindow }
@@ -155,6 +178,21 @@ func NewIFileDialog(base com.IUnknown) IFileDialog {
 	return &_IFileDialog{IModalWindow: NewIModalWindow(base)}
 }
 
+func (me *_IFileDialog) Advise(events IFileDialogEvents) uint32 {
+	var cookie uint32
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialog)(unsafe.Pointer(*me.Ptr())).Advise,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(unsafe.Pointer(events.Ptr())),
+		uintptr(unsafe.Pointer(&cookie)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return cookie
+	} else {
+		panic(hr)
+	}
+}
+
 func (me *_IFileDialog) ClearClientData() {
 	ret, _, _ := syscall.SyscallN(
 		(*shellvt.IFileDialog)(unsafe.Pointer(*me.Ptr())).ClearClientData,
@@ -377,3 +415,14 @@ func (me *_IFileDialog) SetTitle(title string) {
 		panic(hr)
 	}
 }
+
+func (me *_IFileDialog) Unadvise(cookie uint32) {
+	ret, _, _ := syscall.SyscallN(
+		(*shellvt.IFileDialog)(unsafe.Pointer(*me.Ptr())).Unadvise,
+		uintptr(unsafe.Pointer(me.Ptr())),
+		uintptr(cookie))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
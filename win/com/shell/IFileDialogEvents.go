@@ -0,0 +1,26 @@
+//go:build windows
+
+package shell
+
+import (
+	"github.com/rodrigocfd/windigo/win/com/com"
+)
+
+// [IFileDialogEvents] COM interface.
+//
+// Usually created with NewIFileDialogEventsImpl(), and passed to
+// IFileDialog.Advise().
+//
+// [IFileDialogEvents]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifiledialogevents
+type IFileDialogEvents interface {
+	com.IUnknown
+}
+
+type _IFileDialogEvents struct{ com.IUnknown }
+
+// Constructs a COM object from the base IUnknown.
+//
+// ⚠️ You must defer IFileDialogEvents.Release().
+func NewIFileDialogEvents(base com.IUnknown) IFileDialogEvents {
+	return &_IFileDialogEvents{IUnknown: base}
+}
@@ -0,0 +1,108 @@
+//go:build windows
+
+package shell
+
+import (
+	"sync"
+	"syscall"
+
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Go implementation of the [IFileDialogEvents] COM interface, to be passed to
+// NewIFileDialogEventsImpl().
+//
+// Each method returns an HRESULT; return errco.E_NOTIMPL to have the default
+// behavior. The IFileDialog and IShellItem objects received are owned by the
+// caller; if you want to keep them, call AddRef().
+//
+// [IFileDialogEvents]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifiledialogevents
+type IFileDialogEventsImpl interface {
+	// Return errco.S_OK to accept the file, or errco.S_FALSE to keep the
+	// dialog open.
+	OnFileOk(fd IFileDialog) errco.ERROR
+
+	// Return errco.S_OK to allow the navigation, or an error code to deny it.
+	OnFolderChanging(fd IFileDialog, folder IShellItem) errco.ERROR
+
+	OnFolderChange(fd IFileDialog) errco.ERROR
+	OnSelectionChange(fd IFileDialog) errco.ERROR
+	OnShareViolation(fd IFileDialog, item IShellItem, response *shellco.FDESVR) errco.ERROR
+	OnTypeChange(fd IFileDialog) errco.ERROR
+	OnOverwrite(fd IFileDialog, item IShellItem, response *shellco.FDEOR) errco.ERROR
+}
+
+// Creates an IFileDialogEvents COM object whose methods are implemented in Go.
+//
+// ⚠️ You must defer IFileDialogEvents.Release().
+func NewIFileDialogEventsImpl(impl IFileDialogEventsImpl) IFileDialogEvents {
+	_globalIFileDialogEventsOnce.Do(func() {
+		_globalIFileDialogEventsVtbl = com.NewGoVtbl(
+			[]co.IID{shellco.IID_IFileDialogEvents},
+			syscall.NewCallback(
+				func(this *com.GoObj, pfd **comvt.IUnknown) uintptr {
+					return uintptr(this.Impl().(IFileDialogEventsImpl).
+						OnFileOk(_FileDialogArg(pfd)))
+				},
+			),
+			syscall.NewCallback(
+				func(this *com.GoObj, pfd, psiFolder **comvt.IUnknown) uintptr {
+					return uintptr(this.Impl().(IFileDialogEventsImpl).
+						OnFolderChanging(_FileDialogArg(pfd), _ShellItemArg(psiFolder)))
+				},
+			),
+			syscall.NewCallback(
+				func(this *com.GoObj, pfd **comvt.IUnknown) uintptr {
+					return uintptr(this.Impl().(IFileDialogEventsImpl).
+						OnFolderChange(_FileDialogArg(pfd)))
+				},
+			),
+			syscall.NewCallback(
+				func(this *com.GoObj, pfd **comvt.IUnknown) uintptr {
+					return uintptr(this.Impl().(IFileDialogEventsImpl).
+						OnSelectionChange(_FileDialogArg(pfd)))
+				},
+			),
+			syscall.NewCallback(
+				func(this *com.GoObj, pfd, psi **comvt.IUnknown,
+					pResponse *shellco.FDESVR) uintptr {
+
+					return uintptr(this.Impl().(IFileDialogEventsImpl).
+						OnShareViolation(_FileDialogArg(pfd), _ShellItemArg(psi), pResponse))
+				},
+			),
+			syscall.NewCallback(
+				func(this *com.GoObj, pfd **comvt.IUnknown) uintptr {
+					return uintptr(this.Impl().(IFileDialogEventsImpl).
+						OnTypeChange(_FileDialogArg(pfd)))
+				},
+			),
+			syscall.NewCallback(
+				func(this *com.GoObj, pfd, psi **comvt.IUnknown,
+					pResponse *shellco.FDEOR) uintptr {
+
+					return uintptr(this.Impl().(IFileDialogEventsImpl).
+						OnOverwrite(_FileDialogArg(pfd), _ShellItemArg(psi), pResponse))
+				},
+			),
+		)
+	})
+	return NewIFileDialogEvents(com.NewGoObj(_globalIFileDialogEventsVtbl, impl))
+}
+
+var (
+	_globalIFileDialogEventsOnce sync.Once
+	_globalIFileDialogEventsVtbl *com.GoVtbl
+)
+
+func _FileDialogArg(ppv **comvt.IUnknown) IFileDialog {
+	return NewIFileDialog(com.NewIUnknown(ppv))
+}
+
+func _ShellItemArg(ppv **comvt.IUnknown) IShellItem {
+	return NewIShellItem(com.NewIUnknown(ppv))
+}
@@ -8,16 +8,147 @@ import (
 
 	"github.com/rodrigocfd/windigo/internal/proc"
 	"github.com/rodrigocfd/windigo/win"
-	"github.com/rodrigocfd/windigo/win/com/shell/shellvt"
+	"github.com/rodrigocfd/windigo/win/com/com"
+	"github.com/rodrigocfd/windigo/win/com/com/comvt"
+	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
 	"github.com/rodrigocfd/windigo/win/errco"
 )
 
+// [DoDragDrop] function.
+//
+// Blocks until the drag and drop operation ends. Returns the effect performed
+// by the drop target, and false if the operation was cancelled.
+//
+// # Example
+//
+//	var mySource shell.IDropSourceImpl // initialized somewhere
+//
+//	dataObj := shell.NewIDataObjectImpl(shell.DataItemText("Hello"))
+//	defer dataObj.Release()
+//
+//	dropSource := shell.NewIDropSourceImpl(mySource)
+//	defer dropSource.Release()
+//
+//	effect, dropped := shell.DoDragDrop(dataObj, dropSource,
+//		shellco.DROPEFFECT_COPY|shellco.DROPEFFECT_MOVE)
+//
+// [DoDragDrop]: https://learn.microsoft.com/en-us/windows/win32/api/ole2/nf-ole2-dodragdrop
+func DoDragDrop(
+	dataObj IDataObject, dropSource IDropSource,
+	okEffects shellco.DROPEFFECT) (shellco.DROPEFFECT, bool) {
+
+	var effect shellco.DROPEFFECT
+	ret, _, _ := syscall.SyscallN(proc.DoDragDrop.Addr(),
+		uintptr(unsafe.Pointer(dataObj.Ptr())),
+		uintptr(unsafe.Pointer(dropSource.Ptr())),
+		uintptr(okEffects), uintptr(unsafe.Pointer(&effect)))
+
+	switch hr := errco.ERROR(ret); hr {
+	case errco.DRAGDROP_S_DROP:
+		return effect, true
+	case errco.DRAGDROP_S_CANCEL:
+		return shellco.DROPEFFECT_NONE, false
+	default:
+		panic(hr)
+	}
+}
+
+// [OleFlushClipboard] function.
+//
+// Renders the data of the current clipboard object, so it remains available
+// after the object is released.
+//
+// [OleFlushClipboard]: https://learn.microsoft.com/en-us/windows/win32/api/ole2/nf-ole2-oleflushclipboard
+func OleFlushClipboard() {
+	ret, _, _ := syscall.SyscallN(proc.OleFlushClipboard.Addr())
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
+// [OleGetClipboard] function.
+//
+// ⚠️ You must defer IDataObject.Release().
+//
+// # Example
+//
+//	dataObj := shell.OleGetClipboard()
+//	defer dataObj.Release()
+//
+//	if text, ok := dataObj.GetText(); ok {
+//		println(text)
+//	}
+//
+// [OleGetClipboard]: https://learn.microsoft.com/en-us/windows/win32/api/ole2/nf-ole2-olegetclipboard
+func OleGetClipboard() IDataObject {
+	var ppvQueried **comvt.IUnknown
+	ret, _, _ := syscall.SyscallN(proc.OleGetClipboard.Addr(),
+		uintptr(unsafe.Pointer(&ppvQueried)))
+
+	if hr := errco.ERROR(ret); hr == errco.S_OK {
+		return NewIDataObject(com.NewIUnknown(ppvQueried))
+	} else {
+		panic(hr)
+	}
+}
+
+// [OleIsCurrentClipboard] function.
+//
+// [OleIsCurrentClipboard]: https://learn.microsoft.com/en-us/windows/win32/api/ole2/nf-ole2-oleiscurrentclipboard
+func OleIsCurrentClipboard(dataObj IDataObject) bool {
+	ret, _, _ := syscall.SyscallN(proc.OleIsCurrentClipboard.Addr(),
+		uintptr(unsafe.Pointer(dataObj.Ptr())))
+
+	switch hr := errco.ERROR(ret); hr {
+	case errco.S_OK:
+		return true
+	case errco.S_FALSE:
+		return false
+	default:
+		panic(hr)
+	}
+}
+
+// [OleSetClipboard] function.
+//
+// The clipboard keeps its own reference to the object. Pass nil to empty the
+// clipboard.
+//
+// [OleSetClipboard]: https://learn.microsoft.com/en-us/windows/win32/api/ole2/nf-ole2-olesetclipboard
+func OleSetClipboard(dataObj IDataObject) {
+	var ppv **comvt.IUnknown
+	if dataObj != nil {
+		ppv = dataObj.Ptr()
+	}
+
+	ret, _, _ := syscall.SyscallN(proc.OleSetClipboard.Addr(),
+		uintptr(unsafe.Pointer(ppv)))
+
+	if hr := errco.ERROR(ret); hr != errco.S_OK {
+		panic(hr)
+	}
+}
+
 // [RegisterDragDrop] function.
 //
+// The drop target is usually created with NewIDropTargetImpl(). The system
+// keeps its own reference until RevokeDragDrop() is called.
+//
+// # Example
+//
+//	var hWnd win.HWND // initialized somewhere
+//	var myImpl shell.IDropTargetImpl
+//
+//	target := shell.NewIDropTargetImpl(myImpl)
+//	defer target.Release()
+//
+//	shell.RegisterDragDrop(hWnd, target)
+//	defer shell.RevokeDragDrop(hWnd)
+//
 // [RegisterDragDrop]: https://learn.microsoft.com/en-us/windows/win32/api/ole2/nf-ole2-registerdragdrop
-func RegisterDragDrop(hWnd win.HWND, dropTarget *shellvt.IDropTarget) {
+func RegisterDragDrop(hWnd win.HWND, dropTarget IDropTarget) {
 	ret, _, _ := syscall.SyscallN(proc.RegisterDragDrop.Addr(),
-		uintptr(hWnd), uintptr(unsafe.Pointer(&dropTarget)))
+		uintptr(hWnd), uintptr(unsafe.Pointer(dropTarget.Ptr())))
 
 	if hr := errco.ERROR(ret); hr != errco.S_OK {
 		panic(hr)
@@ -3,7 +3,12 @@
 package shell
 
 import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
 	"github.com/rodrigocfd/windigo/win"
+	"github.com/rodrigocfd/windigo/win/co"
 	"github.com/rodrigocfd/windigo/win/com/shell/shellco"
 )
 
@@ -15,6 +20,64 @@ type COMDLG_FILTERSPEC struct {
 	PszSpec *uint16
 }
 
+// [FORMATETC] struct.
+//
+// [FORMATETC]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/ns-objidl-formatetc
+type FORMATETC struct {
+	CfFormat co.CF
+	Ptd      uintptr // *DVTARGETDEVICE
+	DwAspect shellco.DVASPECT
+	Lindex   int32
+	Tymed    shellco.TYMED
+}
+
+// Returns a FORMATETC for the given clipboard format in global memory, which
+// is the most common case.
+func FormatEtcHGlobal(format co.CF) FORMATETC {
+	return FORMATETC{
+		CfFormat: format,
+		DwAspect: shellco.DVASPECT_CONTENT,
+		Lindex:   -1,
+		Tymed:    shellco.TYMED_HGLOBAL,
+	}
+}
+
+// [STGMEDIUM] struct.
+//
+// ⚠️ If returned by IDataObject.GetData(), you must defer
+// STGMEDIUM.ReleaseStgMedium().
+//
+// [STGMEDIUM]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/ns-objidl-ustgmedium-r1
+type STGMEDIUM struct {
+	Tymed          shellco.TYMED
+	data           uintptr // union
+	pUnkForRelease uintptr
+}
+
+// Returns the global memory handle, if Tymed is TYMED_HGLOBAL.
+func (sm *STGMEDIUM) HGlobal() (win.HGLOBAL, bool) {
+	if sm.Tymed != shellco.TYMED_HGLOBAL {
+		return win.HGLOBAL(0), false
+	}
+	return win.HGLOBAL(sm.data), true
+}
+
+// Sets the global memory handle, and Tymed to TYMED_HGLOBAL.
+func (sm *STGMEDIUM) SetHGlobal(hMem win.HGLOBAL) {
+	sm.Tymed = shellco.TYMED_HGLOBAL
+	sm.data = uintptr(hMem)
+	sm.pUnkForRelease = 0
+}
+
+// [ReleaseStgMedium] function.
+//
+// [ReleaseStgMedium]: https://learn.microsoft.com/en-us/windows/win32/api/ole2/nf-ole2-releasestgmedium
+func (sm *STGMEDIUM) ReleaseStgMedium() {
+	syscall.SyscallN(proc.ReleaseStgMedium.Addr(),
+		uintptr(unsafe.Pointer(sm)))
+	*sm = STGMEDIUM{}
+}
+
 // [THUMBBUTTON] struct.
 //
 // [THUMBBUTTON]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/ns-shobjidl_core-thumbbutton
@@ -2,6 +2,16 @@
 
 package shellco
 
+// [DATADIR] enumeration.
+//
+// [DATADIR]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/ne-objidl-datadir
+type DATADIR uint32
+
+const (
+	DATADIR_GET DATADIR = 1
+	DATADIR_SET DATADIR = 2
+)
+
 // [DROPEFFECT] constants.
 //
 // [DROPEFFECT]: https://learn.microsoft.com/en-us/windows/win32/com/dropeffect-constants
@@ -46,6 +56,18 @@ const (
 	DSS_DISABLED_BY_REMOTE_SESSION DSS = 0x04
 )
 
+// [DVASPECT] enumeration.
+//
+// [DVASPECT]: https://learn.microsoft.com/en-us/windows/win32/api/wtypes/ne-wtypes-dvaspect
+type DVASPECT uint32
+
+const (
+	DVASPECT_CONTENT   DVASPECT = 1
+	DVASPECT_THUMBNAIL DVASPECT = 2
+	DVASPECT_ICON      DVASPECT = 4
+	DVASPECT_DOCPRINT  DVASPECT = 8
+)
+
 // [DESKTOP_WALLPAPER_POSITION] enumeration.
 //
 // [DESKTOP_WALLPAPER_POSITION]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/ne-shobjidl_core-desktop_wallpaper_position
@@ -60,6 +82,28 @@ const (
 	DWPOS_SPAN    DWPOS = 5
 )
 
+// [FDE_OVERWRITE_RESPONSE] enumeration.
+//
+// [FDE_OVERWRITE_RESPONSE]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/ne-shobjidl_core-fde_overwrite_response
+type FDEOR uint32
+
+const (
+	FDEOR_DEFAULT FDEOR = 0
+	FDEOR_ACCEPT  FDEOR = 1
+	FDEOR_REFUSE  FDEOR = 2
+)
+
+// [FDE_SHAREVIOLATION_RESPONSE] enumeration.
+//
+// [FDE_SHAREVIOLATION_RESPONSE]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/ne-shobjidl_core-fde_shareviolation_response
+type FDESVR uint32
+
+const (
+	FDESVR_DEFAULT FDESVR = 0
+	FDESVR_ACCEPT  FDESVR = 1
+	FDESVR_REFUSE  FDESVR = 2
+)
+
 // [_FILEOPENDIALOGOPTIONS] enumeration.
 //
 // [_FILEOPENDIALOGOPTIONS]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/ne-shobjidl_core-_fileopendialogoptions
@@ -230,3 +274,19 @@ const (
 	THBF_HIDDEN         THBF = 0x8
 	THBF_NONINTERACTIVE THBF = 0x10
 )
+
+// [TYMED] enumeration.
+//
+// [TYMED]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/ne-objidl-tymed
+type TYMED uint32
+
+const (
+	TYMED_NULL     TYMED = 0
+	TYMED_HGLOBAL  TYMED = 1
+	TYMED_FILE     TYMED = 2
+	TYMED_ISTREAM  TYMED = 4
+	TYMED_ISTORAGE TYMED = 8
+	TYMED_GDI      TYMED = 16
+	TYMED_MFPICT   TYMED = 32
+	TYMED_ENHMF    TYMED = 64
+)
@@ -19,8 +19,11 @@ const (
 const (
 	IID_IDataObject       co.IID = "0000010e-0000-0000-c000-000000000046"
 	IID_IDesktopWallpaper co.IID = "b92b56a9-8b55-4e14-9a89-0199bbb6f93b"
+	IID_IDropSource       co.IID = "00000121-0000-0000-c000-000000000046"
 	IID_IDropTarget       co.IID = "00000122-0000-0000-c000-000000000046"
+	IID_IEnumFORMATETC    co.IID = "00000103-0000-0000-c000-000000000046"
 	IID_IFileDialog       co.IID = "42f85136-db7e-439c-85f1-e4075d135fc8"
+	IID_IFileDialogEvents co.IID = "973510db-7d7f-452b-8975-74a85828d354"
 	IID_IFileOpenDialog   co.IID = "d57c7288-d4ad-4768-be02-9d969532d960"
 	IID_IFileSaveDialog   co.IID = "84bccd23-5fde-4cdb-aea4-af64b83d78ab"
 	IID_IModalWindow      co.IID = "b4db1657-70d7-485e-8e3e-6fcb5a5c1802"
@@ -45,6 +45,15 @@ type IDesktopWallpaper struct {
 	Enable                    uintptr
 }
 
+// [IDropSource] virtual table.
+//
+// [IDropSource]: https://learn.microsoft.com/en-us/windows/win32/api/oleidl/nn-oleidl-idropsource
+type IDropSource struct {
+	comvt.IUnknown
+	QueryContinueDrag uintptr
+	GiveFeedback      uintptr
+}
+
 // [IDropTarget] virtual table.
 //
 // [IDropTarget]: https://learn.microsoft.com/en-us/windows/win32/api/oleidl/nn-oleidl-idroptarget
@@ -56,6 +65,17 @@ type IDropTarget struct {
 	Drop      uintptr
 }
 
+// [IEnumFORMATETC] virtual table.
+//
+// [IEnumFORMATETC]: https://learn.microsoft.com/en-us/windows/win32/api/objidl/nn-objidl-ienumformatetc
+type IEnumFORMATETC struct {
+	comvt.IUnknown
+	Next  uintptr
+	Skip  uintptr
+	Reset uintptr
+	Clone uintptr
+}
+
 // [IFileDialog] virtual table.
 //
 // [IFileDialog]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifiledialog
@@ -86,6 +106,20 @@ type IFileDialog struct {
 	SetFilter           uintptr
 }
 
+// [IFileDialogEvents] virtual table.
+//
+// [IFileDialogEvents]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifiledialogevents
+type IFileDialogEvents struct {
+	comvt.IUnknown
+	OnFileOk          uintptr
+	OnFolderChanging  uintptr
+	OnFolderChange    uintptr
+	OnSelectionChange uintptr
+	OnShareViolation  uintptr
+	OnTypeChange      uintptr
+	OnOverwrite       uintptr
+}
+
 // [IFileOpenDialog] virtual table.
 //
 // [IFileOpenDialog]: https://learn.microsoft.com/en-us/windows/win32/api/shobjidl_core/nn-shobjidl_core-ifileopendialog
@@ -190,6 +190,7 @@ const (
 	VC_DISCONNECTED                                                     ERROR = 240
 	INVALID_EA_NAME                                                     ERROR = 254
 	EA_LIST_INCONSISTENT                                                ERROR = 255
+	WAIT_TIMEOUT                                                        ERROR = 258
 	NO_MORE_ITEMS                                                       ERROR = 259
 	CANNOT_COPY                                                         ERROR = 266
 	DIRECTORY                                                           ERROR = 267
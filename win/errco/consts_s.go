@@ -6,6 +6,11 @@ const (
 	S_OK    ERROR = 0
 	S_FALSE ERROR = 1
 
+	DRAGDROP_S_DROP              ERROR = 0x0004_0100
+	DRAGDROP_S_CANCEL            ERROR = 0x0004_0101
+	DRAGDROP_S_USEDEFAULTCURSORS ERROR = 0x0004_0102
+	DATA_S_SAMEFORMATETC         ERROR = 0x0004_0130
+
 	VFW_S_NO_MORE_ITEMS                      ERROR = 0x0004_0103
 	VFW_S_DUPLICATE_NAME                     ERROR = 0x0004_022d
 	VFW_S_STATE_INTERMEDIATE                 ERROR = 0x0004_0237
@@ -4,6 +4,7 @@ package win
 
 import (
 	"runtime"
+	"sort"
 	"strings"
 	"syscall"
 	"unsafe"
@@ -115,6 +116,121 @@ func CreateProcess(
 	}
 }
 
+// [CreateProcess] function, which takes the environment variables as a map,
+// restricts the inherited handles to the given ones, and returns an error
+// instead of panicking.
+//
+// The environment variables, if not nil, replace the whole environment of the
+// new process; CREATE_UNICODE_ENVIRONMENT is automatically added to the
+// creation flags.
+//
+// The new process inherits only the handles in inheritHandles, which must be
+// inheritable, through a PROC_THREAD_ATTRIBUTE_HANDLE_LIST; if it's empty, no
+// handles are inherited.
+//
+// Prefer using the high-level ProcessStart(), which also redirects the standard
+// input and output.
+//
+// ⚠️ You must defer HPROCESS.CloseHandle() and HTHREAD.CloseHandle() on
+// HProcess and HThread members of PROCESS_INFORMATION.
+//
+// [CreateProcess]: https://learn.microsoft.com/en-us/windows/win32/api/processthreadsapi/nf-processthreadsapi-createprocessw
+func CreateProcessWithHandles(
+	applicationName, commandLine StrOpt,
+	processAttributes, threadAttributes *SECURITY_ATTRIBUTES,
+	inheritHandles []HANDLE,
+	creationFlags co.CREATE,
+	environment map[string]string,
+	currentDirectory StrOpt,
+	startupInfo *STARTUPINFO,
+	processInformation *PROCESS_INFORMATION) error {
+
+	var envStrsPtr unsafe.Pointer
+	if environment != nil {
+		envStrsPtr = unsafe.Pointer(&_EnvironmentBlock(environment)[0])
+		creationFlags |= co.CREATE_UNICODE_ENVIRONMENT
+	}
+
+	var siEx struct { // STARTUPINFOEX
+		startupInfo     STARTUPINFO
+		lpAttributeList uintptr
+	}
+	siEx.startupInfo = *startupInfo
+	pStartupInfo := unsafe.Pointer(startupInfo)
+
+	var attrList []uintptr // LPPROC_THREAD_ATTRIBUTE_LIST, pointer-aligned
+	if len(inheritHandles) > 0 {
+		var attrListSize uintptr
+		syscall.SyscallN(proc.InitializeProcThreadAttributeList.Addr(),
+			0, 1, 0, uintptr(unsafe.Pointer(&attrListSize))) // retrieve needed size
+
+		ptrSize := unsafe.Sizeof(uintptr(0))
+		attrList = make([]uintptr, (attrListSize+ptrSize-1)/ptrSize)
+		pAttrList := unsafe.Pointer(&attrList[0])
+
+		ret, _, err := syscall.SyscallN(proc.InitializeProcThreadAttributeList.Addr(),
+			uintptr(pAttrList), 1, 0, uintptr(unsafe.Pointer(&attrListSize)))
+		if ret == 0 {
+			return errco.ERROR(err)
+		}
+		defer syscall.SyscallN(proc.DeleteProcThreadAttributeList.Addr(),
+			uintptr(pAttrList))
+
+		ret, _, err = syscall.SyscallN(proc.UpdateProcThreadAttribute.Addr(),
+			uintptr(pAttrList), 0, _PROC_THREAD_ATTRIBUTE_HANDLE_LIST,
+			uintptr(unsafe.Pointer(&inheritHandles[0])),
+			uintptr(len(inheritHandles))*unsafe.Sizeof(inheritHandles[0]), 0, 0)
+		if ret == 0 {
+			return errco.ERROR(err)
+		}
+
+		siEx.startupInfo.cb = uint32(unsafe.Sizeof(siEx))
+		siEx.lpAttributeList = uintptr(pAttrList)
+		pStartupInfo = unsafe.Pointer(&siEx)
+		creationFlags |= co.CREATE_EXTENDED_STARTUPINFO_PRESENT
+	}
+
+	ret, _, err := syscall.SyscallN(proc.CreateProcess.Addr(),
+		uintptr(applicationName.Raw()),
+		uintptr(commandLine.Raw()),
+		uintptr(unsafe.Pointer(processAttributes)),
+		uintptr(unsafe.Pointer(threadAttributes)),
+		util.BoolToUintptr(len(inheritHandles) > 0),
+		uintptr(creationFlags),
+		uintptr(envStrsPtr),
+		uintptr(currentDirectory.Raw()),
+		uintptr(pStartupInfo),
+		uintptr(unsafe.Pointer(processInformation)))
+	runtime.KeepAlive(attrList)
+	runtime.KeepAlive(inheritHandles)
+
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// Builds the environment block for CreateProcessWithHandles(), sorted by name, case
+// insensitive, as required by the system.
+func _EnvironmentBlock(environment map[string]string) []uint16 {
+	names := make([]string, 0, len(environment))
+	for name := range environment {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(a, b int) bool {
+		return strings.ToUpper(names[a]) < strings.ToUpper(names[b])
+	})
+
+	envStrs := make([]string, 0, len(names))
+	for _, name := range names {
+		envStrs = append(envStrs, name+"="+environment[name])
+	}
+	if len(envStrs) == 0 {
+		return []uint16{0, 0} // empty block still needs two terminating nulls
+	}
+	return Str.ToNativeSliceMulti(envStrs)
+}
+
 // [DeleteFile] function.
 //
 // [DeleteFile]: https://learn.microsoft.com/en-us/windows/win32/api/fileapi/nf-fileapi-deletefilew
@@ -846,3 +962,21 @@ func TzSpecificLocalTimeToSystemTime(
 		panic(errco.ERROR(err))
 	}
 }
+
+// [WaitForMultipleObjects] function.
+//
+// If waitAll is false, the returned value minus WAIT_OBJECT_0 is the index of
+// the signaled handle.
+//
+// [WaitForMultipleObjects]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-waitformultipleobjects
+func WaitForMultipleObjects(
+	handles []HANDLE, waitAll bool, milliseconds NumInf) (co.WAIT, error) {
+
+	ret, _, err := syscall.SyscallN(proc.WaitForMultipleObjects.Addr(),
+		uintptr(len(handles)), uintptr(unsafe.Pointer(&handles[0])),
+		util.BoolToUintptr(waitAll), milliseconds.Raw())
+	if co.WAIT(ret) == co.WAIT_FAILED {
+		return co.WAIT_FAILED, errco.ERROR(err)
+	}
+	return co.WAIT(ret), nil
+}
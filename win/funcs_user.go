@@ -231,6 +231,22 @@ func GetCaretPos() RECT {
 	return rc
 }
 
+// [GetClipboardFormatName] function.
+//
+// Retrieves the name of a registered clipboard format.
+//
+// [GetClipboardFormatName]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getclipboardformatnamew
+func GetClipboardFormatName(format co.CF) (string, error) {
+	var buf [256]uint16
+	ret, _, err := syscall.SyscallN(proc.GetClipboardFormatName.Addr(),
+		uintptr(format), uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+
+	if ret == 0 {
+		return "", errco.ERROR(err)
+	}
+	return Str.FromNativeSlice(buf[:]), nil
+}
+
 // [GetCursorPos] function.
 //
 // [GetCursorPos]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-getcursorpos
@@ -471,6 +487,19 @@ func RegisterClassEx(wcx *WNDCLASSEX) (ATOM, error) {
 	}
 }
 
+// [RegisterClipboardFormat] function.
+//
+// [RegisterClipboardFormat]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerclipboardformatw
+func RegisterClipboardFormat(format string) (co.CF, error) {
+	ret, _, err := syscall.SyscallN(proc.RegisterClipboardFormat.Addr(),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(format))))
+
+	if ret == 0 {
+		return co.CF(0), errco.ERROR(err)
+	}
+	return co.CF(ret), nil
+}
+
 // [RegisterWindowMessage] function.
 //
 // [RegisterWindowMessage]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-registerwindowmessagew
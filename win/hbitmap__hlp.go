@@ -0,0 +1,114 @@
+//go:build windows
+
+package win
+
+import (
+	"image"
+	"image/draw"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// This helper function creates a 32 bpp top-down DIB section with the pixels
+// of the image, with premultiplied alpha, as expected by HDC.AlphaBlend().
+//
+// ⚠️ You must defer HBITMAP.DeleteObject().
+//
+// # Example
+//
+//	fin, _ := os.Open("C:\\Temp\\picture.png")
+//	defer fin.Close()
+//	img, _ := png.Decode(fin)
+//
+//	hBmp := win.HbitmapFromImage(img)
+//	defer hBmp.DeleteObject()
+func HbitmapFromImage(img image.Image) HBITMAP {
+	rgba := _ImageToRgba(img)
+	hBmp, bits := _CreateDibSection32(rgba.Rect.Dx(), rgba.Rect.Dy())
+
+	dib := unsafe.Slice(bits, rgba.Rect.Dx()*rgba.Rect.Dy()*4)
+	for i := 0; i < len(dib); i += 4 { // RGBA to BGRA
+		dib[i], dib[i+1], dib[i+2], dib[i+3] =
+			rgba.Pix[i+2], rgba.Pix[i+1], rgba.Pix[i], rgba.Pix[i+3]
+	}
+	return hBmp
+}
+
+// This helper method copies the pixels of the bitmap into an image, with
+// GetDIBits().
+//
+// If the bitmap has 32 bpp and a non-empty alpha channel, it's assumed to be
+// premultiplied, like in DIB sections created by HbitmapFromImage();
+// otherwise, all pixels are opaque.
+func (hBmp HBITMAP) ToImage() *image.RGBA {
+	var bm BITMAP
+	hBmp.GetObject(&bm)
+
+	width, height := int(bm.BmWidth), int(bm.BmHeight)
+	if height < 0 {
+		height = -height
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	hdcScreen := HWND(0).GetDC()
+	defer HWND(0).ReleaseDC(hdcScreen)
+	hdcScreen.GetDIBits(hBmp, 0, height, img.Pix,
+		_BitmapInfo32(width, height), co.DIB_RGB_COLORS)
+
+	_BgraToRgba(img.Pix, bm.BmBitsPixel == 32)
+	return img
+}
+
+// Returns the image itself if it's already an *image.RGBA at the origin;
+// otherwise, converts it.
+func _ImageToRgba(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok &&
+		rgba.Rect.Min == (image.Point{}) && rgba.Stride == rgba.Rect.Dx()*4 {
+		return rgba
+	}
+
+	bounds := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Rect, img, bounds.Min, draw.Src)
+	return rgba
+}
+
+// Describes a 32 bpp top-down DIB, whose rows have no padding.
+func _BitmapInfo32(width, height int) *BITMAPINFO {
+	bi := &BITMAPINFO{}
+	bi.BmiHeader.SetBiSize()
+	bi.BmiHeader.BiWidth = int32(width)
+	bi.BmiHeader.BiHeight = -int32(height) // negative height means top-down
+	bi.BmiHeader.BiPlanes = 1
+	bi.BmiHeader.BiBitCount = 32
+	bi.BmiHeader.BiCompression = co.BI_RGB
+	return bi
+}
+
+// Creates a 32 bpp top-down DIB section, returning a pointer to its pixels.
+func _CreateDibSection32(width, height int) (HBITMAP, *byte) {
+	return HDC(0).CreateDIBSection(_BitmapInfo32(width, height),
+		co.DIB_RGB_COLORS, HFILEMAP(0), 0)
+}
+
+// Converts 32 bpp BGRA pixels to RGBA in place. If keepAlpha is false, or if
+// the alpha channel is empty, all pixels are made opaque.
+func _BgraToRgba(pix []byte, keepAlpha bool) {
+	if keepAlpha {
+		keepAlpha = false
+		for i := 3; i < len(pix); i += 4 {
+			if pix[i] != 0 {
+				keepAlpha = true
+				break
+			}
+		}
+	}
+
+	for i := 0; i < len(pix); i += 4 {
+		pix[i], pix[i+2] = pix[i+2], pix[i]
+		if !keepAlpha {
+			pix[i+3] = 0xff
+		}
+	}
+}
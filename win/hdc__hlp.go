@@ -3,6 +3,9 @@
 package win
 
 import (
+	"image"
+	"unsafe"
+
 	"github.com/rodrigocfd/windigo/win/co"
 )
 
@@ -42,3 +45,29 @@ func (hdc HDC) PixelToHiMetric(
 	)
 	return
 }
+
+// Copies an area of the DC into an image, through a DIB section. The alpha
+// channel is discarded, since it's undefined in screen DCs.
+func _CaptureDcArea(hdcSrc HDC, rc RECT) *image.RGBA {
+	width, height := int(rc.Right-rc.Left), int(rc.Bottom-rc.Top)
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	if width <= 0 || height <= 0 {
+		return img
+	}
+
+	hBmp, bits := _CreateDibSection32(width, height)
+	defer hBmp.DeleteObject()
+
+	hdcMem := hdcSrc.CreateCompatibleDC()
+	defer hdcMem.DeleteDC()
+	hBmpOld := hdcMem.SelectObjectBitmap(hBmp)
+	defer hdcMem.SelectObjectBitmap(hBmpOld)
+
+	hdcMem.BitBlt(POINT{}, SIZE{Cx: int32(width), Cy: int32(height)},
+		hdcSrc, POINT{X: rc.Left, Y: rc.Top}, co.ROP_SRCCOPY|co.ROP_CAPTUREBLT)
+	GdiFlush() // pending GDI operations must finish before reading the bits
+
+	copy(img.Pix, unsafe.Slice(bits, len(img.Pix)))
+	_BgraToRgba(img.Pix, false)
+	return img
+}
@@ -0,0 +1,81 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// [CreateEvent] function.
+//
+// ⚠️ You must defer HEVENT.CloseHandle().
+//
+// [CreateEvent]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-createeventw
+func CreateEvent(
+	securityAttributes *SECURITY_ATTRIBUTES,
+	manualReset, initialState bool,
+	name StrOpt) (HEVENT, error) {
+
+	ret, _, err := syscall.SyscallN(proc.CreateEvent.Addr(),
+		uintptr(unsafe.Pointer(securityAttributes)),
+		util.BoolToUintptr(manualReset), util.BoolToUintptr(initialState),
+		uintptr(name.Raw()))
+	if ret == 0 {
+		return HEVENT(0), errco.ERROR(err)
+	}
+	return HEVENT(ret), nil
+}
+
+// [CloseHandle] function.
+//
+// [CloseHandle]: https://learn.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
+func (hEvent HEVENT) CloseHandle() error {
+	ret, _, err := syscall.SyscallN(proc.CloseHandle.Addr(),
+		uintptr(hEvent))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [ResetEvent] function.
+//
+// [ResetEvent]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-resetevent
+func (hEvent HEVENT) ResetEvent() error {
+	ret, _, err := syscall.SyscallN(proc.ResetEvent.Addr(),
+		uintptr(hEvent))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [SetEvent] function.
+//
+// [SetEvent]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-setevent
+func (hEvent HEVENT) SetEvent() error {
+	ret, _, err := syscall.SyscallN(proc.SetEvent.Addr(),
+		uintptr(hEvent))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [WaitForSingleObject] function.
+//
+// [WaitForSingleObject]: https://learn.microsoft.com/en-us/windows/win32/api/synchapi/nf-synchapi-waitforsingleobject
+func (hEvent HEVENT) WaitForSingleObject(milliseconds NumInf) (co.WAIT, error) {
+	ret, _, err := syscall.SyscallN(proc.WaitForSingleObject.Addr(),
+		uintptr(hEvent), milliseconds.Raw())
+	if co.WAIT(ret) == co.WAIT_FAILED {
+		return co.WAIT_FAILED, errco.ERROR(err)
+	}
+	return co.WAIT(ret), nil
+}
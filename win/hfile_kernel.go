@@ -48,6 +48,21 @@ func CreateFile(
 	return HFILE(ret), nil
 }
 
+// [CancelIoEx] function.
+//
+// If overlapped is nil, all the pending operations issued by the current
+// process for the handle are cancelled.
+//
+// [CancelIoEx]: https://learn.microsoft.com/en-us/windows/win32/fileio/cancelioex-func
+func (hFile HFILE) CancelIoEx(overlapped *OVERLAPPED) error {
+	ret, _, err := syscall.SyscallN(proc.CancelIoEx.Addr(),
+		uintptr(hFile), uintptr(unsafe.Pointer(overlapped)))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
 // [CloseHandle] function.
 //
 // [CloseHandle]: https://learn.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
@@ -74,6 +89,21 @@ func (hFile HFILE) GetFileSizeEx() (uint64, error) {
 	return uint64(retSz), nil
 }
 
+// [GetOverlappedResult] function.
+//
+// [GetOverlappedResult]: https://learn.microsoft.com/en-us/windows/win32/api/ioapiset/nf-ioapiset-getoverlappedresult
+func (hFile HFILE) GetOverlappedResult(
+	overlapped *OVERLAPPED, wait bool) (numBytesTransferred uint32, e error) {
+
+	ret, _, err := syscall.SyscallN(proc.GetOverlappedResult.Addr(),
+		uintptr(hFile), uintptr(unsafe.Pointer(overlapped)),
+		uintptr(unsafe.Pointer(&numBytesTransferred)), util.BoolToUintptr(wait))
+	if ret == 0 {
+		return numBytesTransferred, errco.ERROR(err)
+	}
+	return numBytesTransferred, nil
+}
+
 // [CreateFileMapping] function.
 //
 // ⚠️ You must defer HFILEMAP.CloseHandle().
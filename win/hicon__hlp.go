@@ -0,0 +1,70 @@
+//go:build windows
+
+package win
+
+import (
+	"image"
+)
+
+// This helper function creates an icon from the image, keeping its alpha
+// channel, with CreateIconIndirect().
+//
+// Panics if the image is empty.
+//
+// ⚠️ You must defer HICON.DestroyIcon().
+//
+// # Example
+//
+//	img := image.NewNRGBA(image.Rect(0, 0, 16, 16))
+//	draw.Draw(img, img.Rect, image.NewUniform(color.NRGBA{R: 255, A: 255}),
+//		image.Point{}, draw.Src)
+//
+//	hIcon := win.HiconFromImage(img)
+//	defer hIcon.DestroyIcon()
+func HiconFromImage(img image.Image) HICON {
+	var ii ICONINFO
+	ii.SetFIcon(true)
+	return _CreateIconFromImage(img, &ii)
+}
+
+// This helper function creates a cursor from the image, keeping its alpha
+// channel, with CreateIconIndirect().
+//
+// Panics if the image is empty.
+//
+// ⚠️ You must defer HCURSOR.DestroyCursor().
+func HcursorFromImage(img image.Image, hotspot POINT) HCURSOR {
+	ii := ICONINFO{
+		XHotspot: uint32(hotspot.X),
+		YHotspot: uint32(hotspot.Y),
+	}
+	ii.SetFIcon(false)
+	return HCURSOR(_CreateIconFromImage(img, &ii))
+}
+
+func _CreateIconFromImage(img image.Image, ii *ICONINFO) HICON {
+	if img.Bounds().Empty() {
+		panic("Cannot create an icon or cursor from an empty image.")
+	}
+	rgba := _ImageToRgba(img)
+	width, height := rgba.Rect.Dx(), rgba.Rect.Dy()
+
+	// The AND mask is ignored when the color bitmap has alpha, but it's still
+	// used when drawing the icon without alpha blending.
+	maskStride := (width + 15) / 16 * 2 // monochrome rows are WORD-aligned
+	mask := make([]byte, maskStride*height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if rgba.Pix[y*rgba.Stride+x*4+3] == 0 {
+				mask[y*maskStride+x/8] |= 0x80 >> (x % 8)
+			}
+		}
+	}
+
+	ii.HbmColor = HbitmapFromImage(rgba)
+	defer ii.HbmColor.DeleteObject()
+	ii.HbmMask = CreateBitmap(int32(width), int32(height), 1, 1, &mask[0])
+	defer ii.HbmMask.DeleteObject()
+
+	return CreateIconIndirect(ii) // bitmaps are copied, so they can be deleted
+}
@@ -4,10 +4,13 @@ package win
 
 import (
 	"runtime"
+	"sync"
 	"syscall"
 	"unsafe"
 
 	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win/co"
 	"github.com/rodrigocfd/windigo/win/errco"
 )
 
@@ -45,6 +48,180 @@ func LoadLibrary(libFileName string) HINSTANCE {
 	return HINSTANCE(ret)
 }
 
+// [LoadLibraryEx] function.
+//
+// ⚠️ You must defer HINSTANCE.FreeLibrary().
+//
+// # Example
+//
+// Loading an executable only to read its resources:
+//
+//	hInst, _ := win.LoadLibraryEx("C:\\Temp\\foo.exe",
+//		co.LOAD_LIBRARY_AS_DATAFILE|co.LOAD_LIBRARY_AS_IMAGE_RESOURCE)
+//	defer hInst.FreeLibrary()
+//
+// [LoadLibraryEx]: https://learn.microsoft.com/en-us/windows/win32/api/libloaderapi/nf-libloaderapi-loadlibraryexw
+func LoadLibraryEx(libFileName string, flags co.LOAD_LIBRARY) (HINSTANCE, error) {
+	ret, _, err := syscall.SyscallN(proc.LoadLibraryEx.Addr(),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(libFileName))), 0, uintptr(flags))
+	if ret == 0 {
+		return HINSTANCE(0), errco.ERROR(err)
+	}
+	return HINSTANCE(ret), nil
+}
+
+// [EnumResourceLanguages] function.
+//
+// To continue enumeration, the callback function must return true; to stop
+// enumeration, it must return false.
+//
+// [EnumResourceLanguages]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-enumresourcelanguagesw
+func (hInst HINSTANCE) EnumResourceLanguages(
+	rsrcType RsrcType, name ResId, callback func(language LANGID) bool) error {
+
+	rsrcTypeVal, rsrcTypeBuf := rsrcType.raw()
+	nameVal, nameBuf := name.raw()
+
+	pPack := &_EnumResLangPack{f: callback}
+	_globalEnumResLangMutex.Lock()
+	if _globalEnumResLangFuncs == nil { // the set was not initialized yet?
+		_globalEnumResLangFuncs = make(map[*_EnumResLangPack]struct{}, 1)
+	}
+	_globalEnumResLangFuncs[pPack] = struct{}{} // store pointer in the set
+	_globalEnumResLangMutex.Unlock()
+
+	ret, _, err := syscall.SyscallN(proc.EnumResourceLanguages.Addr(),
+		uintptr(hInst), rsrcTypeVal, nameVal,
+		_globalEnumResLangCallback, uintptr(unsafe.Pointer(pPack)))
+	runtime.KeepAlive(rsrcTypeBuf)
+	runtime.KeepAlive(nameBuf)
+
+	_globalEnumResLangMutex.Lock()
+	delete(_globalEnumResLangFuncs, pPack) // remove from the set
+	_globalEnumResLangMutex.Unlock()
+
+	if wErr := errco.ERROR(err); ret == 0 && wErr != errco.RESOURCE_ENUM_USER_STOP {
+		return wErr
+	}
+	return nil
+}
+
+type _EnumResLangPack struct{ f func(language LANGID) bool }
+
+var (
+	_globalEnumResLangFuncs    map[*_EnumResLangPack]struct{} // keeps pointers from being collected by GC
+	_globalEnumResLangMutex    = sync.Mutex{}
+	_globalEnumResLangCallback = syscall.NewCallback(
+		func(_ HINSTANCE, _, _ uintptr, language LANGID, lParam LPARAM) uintptr {
+			pPack := (*_EnumResLangPack)(unsafe.Pointer(lParam))
+			return util.BoolToUintptr(pPack.f(language))
+		})
+)
+
+// [EnumResourceNames] function.
+//
+// To continue enumeration, the callback function must return true; to stop
+// enumeration, it must return false.
+//
+// [EnumResourceNames]: https://learn.microsoft.com/en-us/windows/win32/api/libloaderapi/nf-libloaderapi-enumresourcenamesw
+func (hInst HINSTANCE) EnumResourceNames(
+	rsrcType RsrcType, callback func(name ResId) bool) error {
+
+	rsrcTypeVal, rsrcTypeBuf := rsrcType.raw()
+
+	pPack := &_EnumResNamePack{f: callback}
+	_globalEnumResNameMutex.Lock()
+	if _globalEnumResNameFuncs == nil { // the set was not initialized yet?
+		_globalEnumResNameFuncs = make(map[*_EnumResNamePack]struct{}, 1)
+	}
+	_globalEnumResNameFuncs[pPack] = struct{}{} // store pointer in the set
+	_globalEnumResNameMutex.Unlock()
+
+	ret, _, err := syscall.SyscallN(proc.EnumResourceNames.Addr(),
+		uintptr(hInst), rsrcTypeVal,
+		_globalEnumResNameCallback, uintptr(unsafe.Pointer(pPack)))
+	runtime.KeepAlive(rsrcTypeBuf)
+
+	_globalEnumResNameMutex.Lock()
+	delete(_globalEnumResNameFuncs, pPack) // remove from the set
+	_globalEnumResNameMutex.Unlock()
+
+	if wErr := errco.ERROR(err); ret == 0 && wErr != errco.RESOURCE_ENUM_USER_STOP {
+		return wErr
+	}
+	return nil
+}
+
+type _EnumResNamePack struct{ f func(name ResId) bool }
+
+var (
+	_globalEnumResNameFuncs    map[*_EnumResNamePack]struct{} // keeps pointers from being collected by GC
+	_globalEnumResNameMutex    = sync.Mutex{}
+	_globalEnumResNameCallback = syscall.NewCallback(
+		func(_ HINSTANCE, _, name uintptr, lParam LPARAM) uintptr {
+			pPack := (*_EnumResNamePack)(unsafe.Pointer(lParam))
+			return util.BoolToUintptr(pPack.f(_ResIdFromRaw(name)))
+		})
+)
+
+// [EnumResourceTypes] function.
+//
+// To continue enumeration, the callback function must return true; to stop
+// enumeration, it must return false.
+//
+// # Example
+//
+// Listing all the resources of an executable:
+//
+//	hInst, _ := win.LoadLibraryEx("C:\\Temp\\foo.exe",
+//		co.LOAD_LIBRARY_AS_DATAFILE|co.LOAD_LIBRARY_AS_IMAGE_RESOURCE)
+//	defer hInst.FreeLibrary()
+//
+//	hInst.EnumResourceTypes(func(rsrcType win.RsrcType) bool {
+//		hInst.EnumResourceNames(rsrcType, func(name win.ResId) bool {
+//			if id, ok := name.Id(); ok {
+//				fmt.Printf("ID %d\n", id)
+//			}
+//			return true
+//		})
+//		return true
+//	})
+//
+// [EnumResourceTypes]: https://learn.microsoft.com/en-us/windows/win32/api/libloaderapi/nf-libloaderapi-enumresourcetypesw
+func (hInst HINSTANCE) EnumResourceTypes(callback func(rsrcType RsrcType) bool) error {
+	pPack := &_EnumResTypePack{f: callback}
+	_globalEnumResTypeMutex.Lock()
+	if _globalEnumResTypeFuncs == nil { // the set was not initialized yet?
+		_globalEnumResTypeFuncs = make(map[*_EnumResTypePack]struct{}, 1)
+	}
+	_globalEnumResTypeFuncs[pPack] = struct{}{} // store pointer in the set
+	_globalEnumResTypeMutex.Unlock()
+
+	ret, _, err := syscall.SyscallN(proc.EnumResourceTypes.Addr(),
+		uintptr(hInst), _globalEnumResTypeCallback, uintptr(unsafe.Pointer(pPack)))
+
+	_globalEnumResTypeMutex.Lock()
+	delete(_globalEnumResTypeFuncs, pPack) // remove from the set
+	_globalEnumResTypeMutex.Unlock()
+
+	if wErr := errco.ERROR(err); ret == 0 && wErr != errco.RESOURCE_ENUM_USER_STOP {
+		return wErr
+	}
+	return nil
+}
+
+type _EnumResTypePack struct{ f func(rsrcType RsrcType) bool }
+
+var (
+	_globalEnumResTypeFuncs    map[*_EnumResTypePack]struct{} // keeps pointers from being collected by GC
+	_globalEnumResTypeMutex    = sync.Mutex{}
+	_globalEnumResTypeCallback = syscall.NewCallback(
+		func(_ HINSTANCE, rsrcType uintptr, lParam LPARAM) uintptr {
+			pPack := (*_EnumResTypePack)(unsafe.Pointer(lParam))
+			return util.BoolToUintptr(pPack.f(_RsrcTypeFromRaw(rsrcType)))
+		})
+)
+
 // [FindResource] function.
 //
 // [FindResource]: https://learn.microsoft.com/en-us/windows/win32/api/libloaderapi/nf-libloaderapi-findresourcew
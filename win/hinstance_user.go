@@ -12,6 +12,24 @@ import (
 	"github.com/rodrigocfd/windigo/win/errco"
 )
 
+// [CreateDialogIndirectParam] function.
+//
+// [CreateDialogIndirectParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogindirectparamw
+func (hInst HINSTANCE) CreateDialogIndirectParam(
+	template *DLGTEMPLATE,
+	hwndParent HWND,
+	dialogFunc uintptr,
+	dwInitParam LPARAM) HWND {
+
+	ret, _, err := syscall.SyscallN(proc.CreateDialogIndirectParam.Addr(),
+		uintptr(hInst), uintptr(unsafe.Pointer(template)),
+		uintptr(hwndParent), dialogFunc, uintptr(dwInitParam))
+	if ret == 0 {
+		panic(errco.ERROR(err))
+	}
+	return HWND(ret)
+}
+
 // [CreateDialogParam] function.
 //
 // [CreateDialogParam]: https://learn.microsoft.com/en-us/windows/win32/api/winuser/nf-winuser-createdialogparamw
@@ -0,0 +1,127 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Handle to an [I/O completion port].
+//
+// [I/O completion port]: https://learn.microsoft.com/en-us/windows/win32/fileio/i-o-completion-ports
+type HIOCP HANDLE
+
+// [CreateIoCompletionPort] function.
+//
+// If hFile is INVALID_HANDLE_VALUE, a new port is created, otherwise hFile is
+// associated with existingPort, which is returned. The completionKey is
+// returned along with each completion packet of hFile.
+//
+// Zero numThreads means one concurrent thread per processor.
+//
+// ⚠️ If a new port is created, you must defer HIOCP.CloseHandle().
+//
+// # Example
+//
+//	hPort, _ := win.CreateIoCompletionPort(
+//		win.HANDLE(syscall.InvalidHandle), win.HIOCP(0), 0, 0)
+//	defer hPort.CloseHandle()
+//
+//	var hFile win.HFILE // opened with FILE_FLAG_OVERLAPPED
+//	win.CreateIoCompletionPort(win.HANDLE(hFile), hPort, 1, 0)
+//
+// [CreateIoCompletionPort]: https://learn.microsoft.com/en-us/windows/win32/fileio/createiocompletionport
+func CreateIoCompletionPort(
+	hFile HANDLE,
+	existingPort HIOCP,
+	completionKey uintptr,
+	numThreads uint32) (HIOCP, error) {
+
+	ret, _, err := syscall.SyscallN(proc.CreateIoCompletionPort.Addr(),
+		uintptr(hFile), uintptr(existingPort), completionKey,
+		uintptr(numThreads))
+	if ret == 0 {
+		return HIOCP(0), errco.ERROR(err)
+	}
+	return HIOCP(ret), nil
+}
+
+// [CloseHandle] function.
+//
+// [CloseHandle]: https://learn.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
+func (hPort HIOCP) CloseHandle() error {
+	ret, _, err := syscall.SyscallN(proc.CloseHandle.Addr(),
+		uintptr(hPort))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [GetQueuedCompletionStatus] function.
+//
+// If the dequeued packet refers to a failed I/O operation, overlapped is
+// returned along with the error. If overlapped is nil and the error is
+// WAIT_TIMEOUT, the timeout elapsed.
+//
+// [GetQueuedCompletionStatus]: https://learn.microsoft.com/en-us/windows/win32/api/ioapiset/nf-ioapiset-getqueuedcompletionstatus
+func (hPort HIOCP) GetQueuedCompletionStatus(
+	milliseconds NumInf) (
+	numBytes uint32, completionKey uintptr, overlapped *OVERLAPPED, e error) {
+
+	ret, _, err := syscall.SyscallN(proc.GetQueuedCompletionStatus.Addr(),
+		uintptr(hPort), uintptr(unsafe.Pointer(&numBytes)),
+		uintptr(unsafe.Pointer(&completionKey)),
+		uintptr(unsafe.Pointer(&overlapped)), milliseconds.Raw())
+	if ret == 0 {
+		return numBytes, completionKey, overlapped, errco.ERROR(err)
+	}
+	return numBytes, completionKey, overlapped, nil
+}
+
+// [GetQueuedCompletionStatusEx] function.
+//
+// Dequeues up to len(entries) packets at once, returning how many were
+// dequeued. If the timeout elapses, returns WAIT_TIMEOUT. If entries is empty,
+// returns INVALID_PARAMETER without calling the function.
+//
+// [GetQueuedCompletionStatusEx]: https://learn.microsoft.com/en-us/windows/win32/fileio/getqueuedcompletionstatusex-func
+func (hPort HIOCP) GetQueuedCompletionStatusEx(
+	entries []OVERLAPPED_ENTRY,
+	milliseconds NumInf,
+	alertable bool) (uint32, error) {
+
+	if len(entries) == 0 {
+		return 0, errco.INVALID_PARAMETER
+	}
+
+	var numRemoved uint32
+	ret, _, err := syscall.SyscallN(proc.GetQueuedCompletionStatusEx.Addr(),
+		uintptr(hPort), uintptr(unsafe.Pointer(&entries[0])),
+		uintptr(uint32(len(entries))), uintptr(unsafe.Pointer(&numRemoved)),
+		milliseconds.Raw(), util.BoolToUintptr(alertable))
+	if ret == 0 {
+		return 0, errco.ERROR(err)
+	}
+	return numRemoved, nil
+}
+
+// [PostQueuedCompletionStatus] function.
+//
+// [PostQueuedCompletionStatus]: https://learn.microsoft.com/en-us/windows/win32/api/ioapiset/nf-ioapiset-postqueuedcompletionstatus
+func (hPort HIOCP) PostQueuedCompletionStatus(
+	numBytes uint32, completionKey uintptr, overlapped *OVERLAPPED) error {
+
+	ret, _, err := syscall.SyscallN(proc.PostQueuedCompletionStatus.Addr(),
+		uintptr(hPort), uintptr(numBytes), completionKey,
+		uintptr(unsafe.Pointer(overlapped)))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
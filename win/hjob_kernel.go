@@ -0,0 +1,172 @@
+//go:build windows
+
+package win
+
+import (
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/win/co"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Handle to a [job object].
+//
+// [job object]: https://learn.microsoft.com/en-us/windows/win32/procthread/job-objects
+type HJOB HANDLE
+
+// [CreateJobObject] function.
+//
+// ⚠️ You must defer HJOB.CloseHandle().
+//
+// # Example
+//
+// Creating a job which kills all its processes when the handle is closed,
+// which happens also when the current process exits:
+//
+//	hJob, _ := win.CreateJobObject(nil, win.StrOptNone())
+//	defer hJob.CloseHandle()
+//
+//	var info win.JOBOBJECT_EXTENDED_LIMIT_INFORMATION
+//	info.BasicLimitInformation.LimitFlags = co.JOB_OBJECT_LIMIT_KILL_ON_JOB_CLOSE
+//	hJob.SetInformationJobObject(
+//		co.JOB_INFO_ExtendedLimitInformation,
+//		unsafe.Pointer(&info),
+//		uint32(unsafe.Sizeof(info)),
+//	)
+//
+// [CreateJobObject]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi2/nf-jobapi2-createjobobjectw
+func CreateJobObject(
+	securityAttributes *SECURITY_ATTRIBUTES, name StrOpt) (HJOB, error) {
+
+	ret, _, err := syscall.SyscallN(proc.CreateJobObject.Addr(),
+		uintptr(unsafe.Pointer(securityAttributes)), uintptr(name.Raw()))
+	if ret == 0 {
+		return HJOB(0), errco.ERROR(err)
+	}
+	return HJOB(ret), nil
+}
+
+// [AssignProcessToJobObject] function.
+//
+// [AssignProcessToJobObject]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi2/nf-jobapi2-assignprocesstojobobject
+func (hJob HJOB) AssignProcessToJobObject(hProcess HPROCESS) error {
+	ret, _, err := syscall.SyscallN(proc.AssignProcessToJobObject.Addr(),
+		uintptr(hJob), uintptr(hProcess))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [CloseHandle] function.
+//
+// [CloseHandle]: https://learn.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
+func (hJob HJOB) CloseHandle() error {
+	ret, _, err := syscall.SyscallN(proc.CloseHandle.Addr(),
+		uintptr(hJob))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [IsProcessInJob] function.
+//
+// If the job handle is zero, tells whether the process runs under any job.
+//
+// [IsProcessInJob]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi/nf-jobapi-isprocessinjob
+func (hJob HJOB) IsProcessInJob(hProcess HPROCESS) (bool, error) {
+	var result int32 // BOOL
+	ret, _, err := syscall.SyscallN(proc.IsProcessInJob.Addr(),
+		uintptr(hProcess), uintptr(hJob), uintptr(unsafe.Pointer(&result)))
+	if ret == 0 {
+		return false, errco.ERROR(err)
+	}
+	return result != 0, nil
+}
+
+// [QueryInformationJobObject] function.
+//
+// # Example
+//
+// Retrieving the peak memory used by the job:
+//
+//	var hJob win.HJOB // initialized somewhere
+//
+//	var info win.JOBOBJECT_EXTENDED_LIMIT_INFORMATION
+//	hJob.QueryInformationJobObject(
+//		co.JOB_INFO_ExtendedLimitInformation,
+//		unsafe.Pointer(&info),
+//		uint32(unsafe.Sizeof(info)),
+//	)
+//	println(info.PeakJobMemoryUsed)
+//
+// [QueryInformationJobObject]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi2/nf-jobapi2-queryinformationjobobject
+func (hJob HJOB) QueryInformationJobObject(
+	infoClass co.JOB_INFO, pInfo unsafe.Pointer, szInfo uint32) error {
+
+	var retLen uint32
+	ret, _, err := syscall.SyscallN(proc.QueryInformationJobObject.Addr(),
+		uintptr(hJob), uintptr(infoClass),
+		uintptr(pInfo), uintptr(szInfo), uintptr(unsafe.Pointer(&retLen)))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [SetInformationJobObject] function.
+//
+// # Example
+//
+// Limiting the job to 4 processes, with 100 MB each, using at most 25% of the
+// CPU:
+//
+//	var hJob win.HJOB // initialized somewhere
+//
+//	var limits win.JOBOBJECT_EXTENDED_LIMIT_INFORMATION
+//	limits.BasicLimitInformation.LimitFlags = co.JOB_OBJECT_LIMIT_ACTIVE_PROCESS |
+//		co.JOB_OBJECT_LIMIT_PROCESS_MEMORY
+//	limits.BasicLimitInformation.ActiveProcessLimit = 4
+//	limits.ProcessMemoryLimit = 100 * 1024 * 1024
+//	hJob.SetInformationJobObject(
+//		co.JOB_INFO_ExtendedLimitInformation,
+//		unsafe.Pointer(&limits),
+//		uint32(unsafe.Sizeof(limits)),
+//	)
+//
+//	var cpu win.JOBOBJECT_CPU_RATE_CONTROL_INFORMATION
+//	cpu.ControlFlags = co.JOB_OBJECT_CPU_RATE_CONTROL_ENABLE |
+//		co.JOB_OBJECT_CPU_RATE_CONTROL_HARD_CAP
+//	cpu.SetCpuRate(25 * 100) // in 1/100 of percent
+//	hJob.SetInformationJobObject(
+//		co.JOB_INFO_CpuRateControlInformation,
+//		unsafe.Pointer(&cpu),
+//		uint32(unsafe.Sizeof(cpu)),
+//	)
+//
+// [SetInformationJobObject]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi2/nf-jobapi2-setinformationjobobject
+func (hJob HJOB) SetInformationJobObject(
+	infoClass co.JOB_INFO, pInfo unsafe.Pointer, szInfo uint32) error {
+
+	ret, _, err := syscall.SyscallN(proc.SetInformationJobObject.Addr(),
+		uintptr(hJob), uintptr(infoClass), uintptr(pInfo), uintptr(szInfo))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [TerminateJobObject] function.
+//
+// [TerminateJobObject]: https://learn.microsoft.com/en-us/windows/win32/api/jobapi2/nf-jobapi2-terminatejobobject
+func (hJob HJOB) TerminateJobObject(exitCode uint32) error {
+	ret, _, err := syscall.SyscallN(proc.TerminateJobObject.Addr(),
+		uintptr(hJob), uintptr(exitCode))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
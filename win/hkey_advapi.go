@@ -10,6 +10,7 @@ import (
 	"unsafe"
 
 	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
 	"github.com/rodrigocfd/windigo/win/co"
 	"github.com/rodrigocfd/windigo/win/errco"
 )
@@ -45,6 +46,46 @@ func (hKey HKEY) RegCloseKey() error {
 	return nil
 }
 
+// [RegCreateKeyEx] function.
+//
+// Creates the key, or opens it if it already exists, returning what happened.
+//
+// ⚠️ You must defer HKEY.RegCloseKey().
+//
+// # Example
+//
+//	hKey, _, _ := win.HKEY_CURRENT_USER.RegCreateKeyEx(
+//		"Software\\MyApp",
+//		co.REG_OPTION_NON_VOLATILE,
+//		co.KEY_READ|co.KEY_WRITE,
+//		nil)
+//	defer hKey.RegCloseKey()
+//
+// [RegCreateKeyEx]: https://learn.microsoft.com/en-us/windows/win32/api/winreg/nf-winreg-regcreatekeyexw
+func (hKey HKEY) RegCreateKeyEx(
+	subKey string,
+	options co.REG_OPTION,
+	samDesired co.KEY,
+	securityAttributes *SECURITY_ATTRIBUTES) (HKEY, co.REG_DISPOSITION, error) {
+
+	var createdKey HKEY
+	var disposition co.REG_DISPOSITION
+	ret, _, _ := syscall.SyscallN(proc.RegCreateKeyEx.Addr(),
+		uintptr(hKey),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(subKey))),
+		0, 0,
+		uintptr(options),
+		uintptr(samDesired),
+		uintptr(unsafe.Pointer(securityAttributes)),
+		uintptr(unsafe.Pointer(&createdKey)),
+		uintptr(unsafe.Pointer(&disposition)))
+
+	if wErr := errco.ERROR(ret); wErr != errco.SUCCESS {
+		return HKEY(0), co.REG_DISPOSITION(0), wErr
+	}
+	return createdKey, disposition, nil
+}
+
 // [RegDeleteKey] function.
 //
 // [RegDeleteKey]: https://learn.microsoft.com/en-us/windows/win32/api/winreg/nf-winreg-regdeletekeyw
@@ -152,11 +193,13 @@ func (hKey HKEY) RegEnumKeyEx() ([]string, error) {
 type _HkeyValueEnum struct {
 	Name string
 	Type co.REG
+	Val  RegVal
 }
 
 // [RegEnumValue] function.
 //
-// Returns the names and types of all values within a key.
+// Returns the names, types and data of all values within a key. The key must
+// be opened with co.KEY_QUERY_VALUE.
 //
 // # Example
 //
@@ -168,7 +211,9 @@ type _HkeyValueEnum struct {
 //
 //	values, _ := hKey.RegEnumValue()
 //	for _, value := range values {
-//		println(value.Name)
+//		if s, ok := value.Val.Sz(); ok {
+//			println(value.Name, s)
+//		}
 //	}
 //
 // [RegEnumValue]: https://learn.microsoft.com/en-us/windows/win32/api/winreg/nf-winreg-regenumvaluew
@@ -183,26 +228,42 @@ func (hKey HKEY) RegEnumValue() ([]_HkeyValueEnum, error) {
 	valueNameBuf := make([]uint16, keyInfo.MaxValueNameLen+2) // room to avoid "more data" error
 	var valueNameBufLen uint32
 	var valueTypeBuf co.REG
+	dataBuf := make([]byte, keyInfo.MaxValueDataLen+2)
+	var dataBufLen uint32
 
 	for i := 0; i < int(keyInfo.NumValues); i++ {
-		valueNameBufLen = uint32(len(valueNameBuf)) // reset available buffer size
+		valueNameBufLen = uint32(len(valueNameBuf)) // reset available buffer sizes
+		dataBufLen = uint32(len(dataBuf))
 
 		ret, _, _ := syscall.SyscallN(proc.RegEnumValue.Addr(),
 			uintptr(hKey), uintptr(i),
 			uintptr(unsafe.Pointer(&valueNameBuf[0])),
 			uintptr(unsafe.Pointer(&valueNameBufLen)), // receives the number of chars without null
-			0, uintptr(unsafe.Pointer(&valueTypeBuf)), 0, 0)
-
-		if wErr := errco.ERROR(ret); wErr != errco.SUCCESS {
+			0, uintptr(unsafe.Pointer(&valueTypeBuf)),
+			uintptr(unsafe.Pointer(&dataBuf[0])),
+			uintptr(unsafe.Pointer(&dataBufLen))) // receives the number of bytes
+
+		if wErr := errco.ERROR(ret); wErr == errco.MORE_DATA {
+			// The value grew after RegQueryInfoKey(), so we enlarge the
+			// buffers and try again.
+			valueNameBuf = make([]uint16, len(valueNameBuf)*2)
+			dataBuf = make([]byte, len(dataBuf)+int(dataBufLen))
+			i--
+			continue
+		} else if wErr != errco.SUCCESS {
 			return nil, wErr
 		}
 
-		values = append(values, struct {
-			Name string
-			Type co.REG
-		}{
-			Name: Str.FromNativeSlice(valueNameBuf),
+		data := make([]byte, dataBufLen)
+		copy(data, dataBuf)
+
+		values = append(values, _HkeyValueEnum{
+			Name: Str.FromNativeSlice(valueNameBuf[:valueNameBufLen]),
 			Type: valueTypeBuf,
+			Val: RegVal{
+				ty:  valueTypeBuf,
+				val: data,
+			},
 		})
 	}
 
@@ -262,6 +323,13 @@ func (hKey HKEY) RegGetValue(subKey, value StrOpt) (RegVal, error) {
 		return RegValNone(), wErr
 	}
 
+	if dataLen == 0 { // empty value, like a zero-length REG_BINARY
+		return RegVal{
+			ty:  dataType,
+			val: nil,
+		}, nil
+	}
+
 	// Alloc receiving block.
 	buf := make([]byte, dataLen)
 
@@ -284,6 +352,32 @@ func (hKey HKEY) RegGetValue(subKey, value StrOpt) (RegVal, error) {
 	}, nil
 }
 
+// [RegNotifyChangeKeyValue] function.
+//
+// If asynchronous is true, the event is signaled when a change happens, and
+// the function returns immediately; only one notification is delivered per
+// call. Otherwise the function blocks until a change happens.
+//
+// Prefer using RegWatch(), which handles the notifications in a goroutine.
+//
+// [RegNotifyChangeKeyValue]: https://learn.microsoft.com/en-us/windows/win32/api/winreg/nf-winreg-regnotifychangekeyvalue
+func (hKey HKEY) RegNotifyChangeKeyValue(
+	watchSubtree bool,
+	notifyFilter co.REG_NOTIFY,
+	hEvent HEVENT,
+	asynchronous bool) error {
+
+	ret, _, _ := syscall.SyscallN(proc.RegNotifyChangeKeyValue.Addr(),
+		uintptr(hKey), util.BoolToUintptr(watchSubtree),
+		uintptr(notifyFilter), uintptr(hEvent),
+		util.BoolToUintptr(asynchronous))
+
+	if wErr := errco.ERROR(ret); wErr != errco.SUCCESS {
+		return wErr
+	}
+	return nil
+}
+
 // [RegOpenKeyEx] function.
 //
 // ⚠️ You must defer HKEY.RegCloseKey().
@@ -390,12 +484,17 @@ func (hKey HKEY) RegQueryInfoKey() (_HkeyInfo, error) {
 //
 // [RegSetKeyValue]: https://learn.microsoft.com/en-us/windows/win32/api/winreg/nf-winreg-regsetkeyvaluew
 func (hKey HKEY) RegSetKeyValue(subKey, value StrOpt, data RegVal) error {
+	var pData unsafe.Pointer
+	if len(data.val) > 0 { // empty values, like a zero-length REG_BINARY, are allowed
+		pData = unsafe.Pointer(&data.val[0])
+	}
+
 	ret, _, _ := syscall.SyscallN(proc.RegSetKeyValue.Addr(),
 		uintptr(hKey),
 		uintptr(subKey.Raw()),
 		uintptr(value.Raw()),
 		uintptr(data.ty),
-		uintptr(unsafe.Pointer(&data.val[0])),
+		uintptr(pData),
 		uintptr(len(data.val)))
 
 	if wErr := errco.ERROR(ret); wErr != errco.SUCCESS {
@@ -0,0 +1,25 @@
+//go:build windows
+
+package win
+
+import (
+	"image"
+)
+
+// This helper method copies the whole area of the monitor into an image.
+//
+// # Example
+//
+//	hMon := win.MonitorFromPoint(win.POINT{}, co.MONITOR_DEFAULTTOPRIMARY)
+//	img, _ := hMon.CaptureImage()
+func (hMon HMONITOR) CaptureImage() (*image.RGBA, error) {
+	var mi MONITORINFOEX
+	mi.SetCbSize()
+	if err := hMon.GetMonitorInfo(&mi); err != nil {
+		return nil, err
+	}
+
+	hdcScreen := HWND(0).GetDC()
+	defer HWND(0).ReleaseDC(hdcScreen)
+	return _CaptureDcArea(hdcScreen, mi.RcMonitor), nil
+}
@@ -41,12 +41,55 @@ func CreateNamedPipe(
 		uintptr(nDefaultTimeOut),
 		uintptr(unsafe.Pointer(securityAttributes)))
 
-	if ret == 0 {
+	if int(ret) == _INVALID_HANDLE_VALUE {
 		return 0, errco.ERROR(err)
 	}
 	return HPIPE(ret), nil
 }
 
+// [CreatePipe] function.
+//
+// Creates an anonymous pipe, returning its read and write ends. Zero size
+// means the system default buffer size.
+//
+// ⚠️ You must defer HPIPE.CloseHandle() on both handles.
+//
+// [CreatePipe]: https://learn.microsoft.com/en-us/windows/win32/api/namedpipeapi/nf-namedpipeapi-createpipe
+func CreatePipe(
+	pipeAttributes *SECURITY_ATTRIBUTES,
+	size uint32) (readPipe, writePipe HPIPE, e error) {
+
+	ret, _, err := syscall.SyscallN(proc.CreatePipe.Addr(),
+		uintptr(unsafe.Pointer(&readPipe)), uintptr(unsafe.Pointer(&writePipe)),
+		uintptr(unsafe.Pointer(pipeAttributes)), uintptr(size))
+	if ret == 0 {
+		return HPIPE(0), HPIPE(0), errco.ERROR(err)
+	}
+	return
+}
+
+// [WaitNamedPipe] function.
+//
+// Waits until an instance of the named pipe is available for a client to
+// connect. If the timeout elapses, returns errco.SEM_TIMEOUT.
+//
+// [WaitNamedPipe]: https://learn.microsoft.com/en-us/windows/win32/api/namedpipeapi/nf-namedpipeapi-waitnamedpipew
+func WaitNamedPipe(name string, milliseconds NumInf) error {
+	ret, _, err := syscall.SyscallN(proc.WaitNamedPipe.Addr(),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(name))), milliseconds.Raw())
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [CancelIoEx] function.
+//
+// [CancelIoEx]: https://learn.microsoft.com/en-us/windows/win32/fileio/cancelioex-func
+func (hPipe HPIPE) CancelIoEx(overlapped *OVERLAPPED) error {
+	return HFILE(hPipe).CancelIoEx(overlapped)
+}
+
 // [CloseHandle] function.
 //
 // [CloseHandle]: https://learn.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-closehandle
@@ -58,8 +101,18 @@ func (hPipe HPIPE) CloseHandle() error {
 //
 // [ConnectNamedPipe]: https://learn.microsoft.com/en-us/windows/win32/api/namedpipeapi/nf-namedpipeapi-connectnamedpipe
 func (hPipe HPIPE) ConnectNamedPipe() error {
+	return hPipe.ConnectNamedPipeOverlapped(nil)
+}
+
+// [ConnectNamedPipe] function, for a pipe created with FILE_FLAG_OVERLAPPED.
+//
+// errco.IO_PENDING is returned while the client doesn't connect. If the client
+// connected before the call, errco.PIPE_CONNECTED is returned.
+//
+// [ConnectNamedPipe]: https://learn.microsoft.com/en-us/windows/win32/api/namedpipeapi/nf-namedpipeapi-connectnamedpipe
+func (hPipe HPIPE) ConnectNamedPipeOverlapped(overlapped *OVERLAPPED) error {
 	ret, _, err := syscall.SyscallN(proc.ConnectNamedPipe.Addr(),
-		uintptr(hPipe), uintptr(0))
+		uintptr(hPipe), uintptr(unsafe.Pointer(overlapped)))
 	if ret == 0 {
 		return errco.ERROR(err)
 	}
@@ -101,6 +154,15 @@ func (hPipe HPIPE) GetNamedPipeInfo() (_HpipeInfo, error) {
 	return info, nil
 }
 
+// [GetOverlappedResult] function.
+//
+// [GetOverlappedResult]: https://learn.microsoft.com/en-us/windows/win32/api/ioapiset/nf-ioapiset-getoverlappedresult
+func (hPipe HPIPE) GetOverlappedResult(
+	overlapped *OVERLAPPED, wait bool) (numBytesTransferred uint32, e error) {
+
+	return HFILE(hPipe).GetOverlappedResult(overlapped, wait)
+}
+
 type _HpipePeek struct {
 	Read      uint32
 	Available uint32
@@ -132,6 +194,32 @@ func (hPipe HPIPE) ReadFile(
 	return HFILE(hPipe).ReadFile(buffer, overlapped)
 }
 
+// [SetHandleInformation] function.
+//
+// [SetHandleInformation]: https://learn.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-sethandleinformation
+func (hPipe HPIPE) SetHandleInformation(mask, flags co.HANDLE_FLAG) error {
+	ret, _, err := syscall.SyscallN(proc.SetHandleInformation.Addr(),
+		uintptr(hPipe), uintptr(mask), uintptr(flags))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [SetNamedPipeHandleState] function.
+//
+// Sets the read mode and the wait mode of the pipe.
+//
+// [SetNamedPipeHandleState]: https://learn.microsoft.com/en-us/windows/win32/api/namedpipeapi/nf-namedpipeapi-setnamedpipehandlestate
+func (hPipe HPIPE) SetNamedPipeHandleState(mode co.PIPE) error {
+	ret, _, err := syscall.SyscallN(proc.SetNamedPipeHandleState.Addr(),
+		uintptr(hPipe), uintptr(unsafe.Pointer(&mode)), 0, 0)
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
 // [WriteFile] function.
 //
 // [WriteFile]: https://learn.microsoft.com/en-us/windows/win32/api/fileapi/nf-fileapi-writefile
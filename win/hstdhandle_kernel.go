@@ -127,6 +127,18 @@ func (hStd HSTDHANDLE) SetConsoleScreenBufferSize(x, y int) error {
 	return nil
 }
 
+// [SetHandleInformation] function.
+//
+// [SetHandleInformation]: https://learn.microsoft.com/en-us/windows/win32/api/handleapi/nf-handleapi-sethandleinformation
+func (hStd HSTDHANDLE) SetHandleInformation(mask, flags co.HANDLE_FLAG) error {
+	ret, _, err := syscall.SyscallN(proc.SetHandleInformation.Addr(),
+		uintptr(hStd), uintptr(mask), uintptr(flags))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
 // [WriteConsole] function.
 //
 // [WriteConsole]: https://learn.microsoft.com/en-us/windows/console/writeconsole
@@ -0,0 +1,85 @@
+//go:build windows
+
+package win
+
+import (
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/internal/proc"
+	"github.com/rodrigocfd/windigo/internal/util"
+	"github.com/rodrigocfd/windigo/win/errco"
+)
+
+// Handle to a resource update operation, returned by [BeginUpdateResource].
+//
+// [BeginUpdateResource]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-beginupdateresourcew
+type HUPDATERSRC HANDLE
+
+// [BeginUpdateResource] function.
+//
+// ⚠️ You must defer HUPDATERSRC.EndUpdateResource().
+//
+// # Example
+//
+//	hUpd, _ := win.BeginUpdateResource("C:\\Temp\\foo.exe", false)
+//	hUpd.UpdateResource(win.RsrcTypeRt(co.RT_MANIFEST), win.ResIdInt(1),
+//		win.LANGID_USER_DEFAULT, manifestBytes)
+//	hUpd.EndUpdateResource(false)
+//
+// [BeginUpdateResource]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-beginupdateresourcew
+func BeginUpdateResource(
+	fileName string, deleteExistingResources bool) (HUPDATERSRC, error) {
+
+	ret, _, err := syscall.SyscallN(proc.BeginUpdateResource.Addr(),
+		uintptr(unsafe.Pointer(Str.ToNativePtr(fileName))),
+		util.BoolToUintptr(deleteExistingResources))
+	if ret == 0 {
+		return HUPDATERSRC(0), errco.ERROR(err)
+	}
+	return HUPDATERSRC(ret), nil
+}
+
+// [EndUpdateResource] function.
+//
+// If discard is true, no changes are written to the file.
+//
+// [EndUpdateResource]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-endupdateresourcew
+func (hUpd HUPDATERSRC) EndUpdateResource(discard bool) error {
+	ret, _, err := syscall.SyscallN(proc.EndUpdateResource.Addr(),
+		uintptr(hUpd), util.BoolToUintptr(discard))
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
+
+// [UpdateResource] function.
+//
+// If data is empty, the resource is deleted.
+//
+// [UpdateResource]: https://learn.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-updateresourcew
+func (hUpd HUPDATERSRC) UpdateResource(
+	rsrcType RsrcType, name ResId, language LANGID, data []byte) error {
+
+	rsrcTypeVal, rsrcTypeBuf := rsrcType.raw()
+	nameVal, nameBuf := name.raw()
+
+	var pData unsafe.Pointer
+	if len(data) > 0 {
+		pData = unsafe.Pointer(&data[0])
+	}
+
+	ret, _, err := syscall.SyscallN(proc.UpdateResource.Addr(),
+		uintptr(hUpd), rsrcTypeVal, nameVal, uintptr(language),
+		uintptr(pData), uintptr(len(data)))
+	runtime.KeepAlive(rsrcTypeBuf)
+	runtime.KeepAlive(nameBuf)
+	runtime.KeepAlive(data)
+
+	if ret == 0 {
+		return errco.ERROR(err)
+	}
+	return nil
+}
@@ -2,13 +2,33 @@
 
 package win
 
-import "github.com/rodrigocfd/windigo/win/co"
+import (
+	"image"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
 
 // This helper method returns the window instance with GetWindowLongPtr().
 func (hWnd HWND) Hinstance() HINSTANCE {
 	return HINSTANCE(hWnd.GetWindowLongPtr(co.GWLP_HINSTANCE))
 }
 
+// This helper method copies the client area of the window, as currently seen
+// on the screen, into an image. Parts covered by other windows are captured as
+// they appear.
+//
+// # Example
+//
+//	img := hWnd.CaptureImage()
+//	fout, _ := os.Create("C:\\Temp\\screenshot.png")
+//	defer fout.Close()
+//	png.Encode(fout, img)
+func (hWnd HWND) CaptureImage() *image.RGBA {
+	hdc := hWnd.GetDC()
+	defer hWnd.ReleaseDC(hdc)
+	return _CaptureDcArea(hdc, hWnd.GetClientRect())
+}
+
 // Allegedly [undocumented] Win32 function.
 //
 // [undocumented]: https://stackoverflow.com/a/16975012
@@ -27,11 +27,12 @@ const (
 
 // Private constants from kernel.
 const (
-	_GMEM_INVALID_HANDLE  = 0x8000
-	_INVALID_HANDLE_VALUE = -1
-	_LMEM_INVALID_HANDLE  = 0x8000
-	_MAX_MODULE_NAME32    = 255
-	_MAX_PATH             = 260
+	_GMEM_INVALID_HANDLE               = 0x8000
+	_INVALID_HANDLE_VALUE              = -1
+	_LMEM_INVALID_HANDLE               = 0x8000
+	_MAX_MODULE_NAME32                 = 255
+	_MAX_PATH                          = 260
+	_PROC_THREAD_ATTRIBUTE_HANDLE_LIST = 0x0002_0002
 )
 
 // Private constants from ole.
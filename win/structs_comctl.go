@@ -667,6 +667,15 @@ type NMTBWRAPHOTITEM struct {
 	NReason co.HICF
 }
 
+// [NMTCKEYDOWN] struct.
+//
+// [NMTCKEYDOWN]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmtckeydown
+type NMTCKEYDOWN struct {
+	Hdr   NMHDR
+	WVKey co.VK
+	Flags uint32
+}
+
 // [NMTOOLBAR] struct.
 //
 // [NMTOOLBAR]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmtoolbarw
@@ -713,6 +722,24 @@ type NMTREEVIEW struct {
 	PtDrag  POINT
 }
 
+// [NMTTDISPINFO] struct.
+//
+// [NMTTDISPINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmttdispinfow
+type NMTTDISPINFO struct {
+	Hdr      NMHDR
+	LpszText *uint16
+	szText   [80]uint16
+	HInst    HINSTANCE
+	UFlags   co.TTF
+	LParam   LPARAM
+}
+
+func (tdi *NMTTDISPINFO) SzText() string { return Str.FromNativeSlice(tdi.szText[:]) }
+func (tdi *NMTTDISPINFO) SetSzText(val string) {
+	copy(tdi.szText[:], Str.ToNativeSlice(Str.Substr(val, 0, len(tdi.szText)-1)))
+	tdi.LpszText = &tdi.szText[0]
+}
+
 // [NMTVASYNCDRAW] struct.
 //
 // [NMTVASYNCDRAW]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmtvasyncdraw
@@ -782,6 +809,15 @@ type NMTVKEYDOWN struct {
 	Flags uint32
 }
 
+// [NMUPDOWN] struct.
+//
+// [NMUPDOWN]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmupdown
+type NMUPDOWN struct {
+	Hdr    NMHDR
+	IPos   int32
+	IDelta int32
+}
+
 // [NMVIEWCHANGE] struct.
 //
 // [NMVIEWCHANGE]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-nmviewchange
@@ -966,6 +1002,58 @@ func (tbi *TBBUTTONINFO) SetPszText(val []uint16) {
 	tbi.pszText = &val[0]
 }
 
+// [TCITEM] struct.
+//
+// [TCITEM]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tcitemw
+type TCITEM struct {
+	Mask        co.TCIF
+	DwState     co.TCIS
+	DwStateMask co.TCIS
+	pszText     *uint16
+	cchTextMax  int32
+	IImage      int32
+	LParam      LPARAM
+}
+
+func (tci *TCITEM) PszText() []uint16 { return unsafe.Slice(tci.pszText, tci.cchTextMax) }
+func (tci *TCITEM) SetPszText(val []uint16) {
+	tci.cchTextMax = int32(len(val))
+	tci.pszText = &val[0]
+}
+
+// [TTTOOLINFO] struct.
+//
+// ⚠️ You must call SetCbSize() to initialize the struct.
+//
+// # Example:
+//
+//	ti := &TTTOOLINFO{}
+//	ti.SetCbSize()
+//
+// [TTTOOLINFO]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-tttoolinfow
+type TTTOOLINFO struct {
+	cbSize     uint32
+	UFlags     co.TTF
+	Hwnd       HWND
+	UId        uintptr
+	Rect       RECT
+	Hinst      HINSTANCE
+	lpszText   *uint16
+	LParam     LPARAM
+	lpReserved uintptr
+}
+
+func (ti *TTTOOLINFO) SetCbSize() { ti.cbSize = uint32(unsafe.Sizeof(*ti)) }
+
+func (ti *TTTOOLINFO) LpszText() *uint16        { return ti.lpszText }
+func (ti *TTTOOLINFO) SetLpszText(val []uint16) { ti.lpszText = &val[0] }
+
+// Sets lpszText to LPSTR_TEXTCALLBACK, so the tooltip control will send a
+// TTN_GETDISPINFO notification whenever it needs the text.
+func (ti *TTTOOLINFO) SetLpszTextCallback() {
+	*(*uintptr)(unsafe.Pointer(&ti.lpszText)) = ^uintptr(0) // LPSTR_TEXTCALLBACK
+}
+
 // [TVINSERTSTRUCT] struct.
 //
 // [TVINSERTSTRUCT]: https://www.google.com/search?client=firefox-b-d&q=TVINSERTSTRUCTW
@@ -1023,3 +1111,11 @@ func (tvx *TVITEMEX) SetPszText(val []uint16) {
 	tvx.cchTextMax = int32(len(val))
 	tvx.pszText = &val[0]
 }
+
+// [UDACCEL] struct.
+//
+// [UDACCEL]: https://learn.microsoft.com/en-us/windows/win32/api/commctrl/ns-commctrl-udaccel
+type UDACCEL struct {
+	NSec uint32
+	NInc uint32
+}
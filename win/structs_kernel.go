@@ -126,6 +126,63 @@ func (ft *FILETIME) FromTime(val time.Time) {
 	)
 }
 
+// [IO_COUNTERS] struct.
+//
+// [IO_COUNTERS]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-io_counters
+type IO_COUNTERS struct {
+	ReadOperationCount  uint64
+	WriteOperationCount uint64
+	OtherOperationCount uint64
+	ReadTransferCount   uint64
+	WriteTransferCount  uint64
+	OtherTransferCount  uint64
+}
+
+// [JOBOBJECT_CPU_RATE_CONTROL_INFORMATION] struct.
+//
+// The union is accessed through the getter and setter methods; which one is
+// valid depends on ControlFlags.
+//
+// [JOBOBJECT_CPU_RATE_CONTROL_INFORMATION]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-jobobject_cpu_rate_control_information
+type JOBOBJECT_CPU_RATE_CONTROL_INFORMATION struct {
+	ControlFlags co.JOB_OBJECT_CPU_RATE_CONTROL
+	union0       uint32
+}
+
+// CPU cycles the job can use, in 1/100 of percent; for example, 25% is 2500.
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) CpuRate() uint32 { return cr.union0 }
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) SetCpuRate(val uint32) {
+	cr.union0 = val
+}
+
+// Scheduling weight of the job, from 1 to 9, used with
+// JOB_OBJECT_CPU_RATE_CONTROL_WEIGHT_BASED.
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) Weight() uint32 { return cr.union0 }
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) SetWeight(val uint32) {
+	cr.union0 = val
+}
+
+// Minimum and maximum CPU rates, in 1/100 of percent, used with
+// JOB_OBJECT_CPU_RATE_CONTROL_MIN_MAX_RATE.
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) MinMaxRate() (minRate, maxRate uint16) {
+	return util.Break32(cr.union0)
+}
+func (cr *JOBOBJECT_CPU_RATE_CONTROL_INFORMATION) SetMinMaxRate(minRate, maxRate uint16) {
+	cr.union0 = util.Make32(minRate, maxRate)
+}
+
+// [JOBOBJECT_EXTENDED_LIMIT_INFORMATION] struct.
+//
+// [JOBOBJECT_EXTENDED_LIMIT_INFORMATION]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-jobobject_extended_limit_information
+type JOBOBJECT_EXTENDED_LIMIT_INFORMATION struct {
+	BasicLimitInformation JOBOBJECT_BASIC_LIMIT_INFORMATION
+	IoInfo                IO_COUNTERS
+	ProcessMemoryLimit    uintptr
+	JobMemoryLimit        uintptr
+	PeakProcessMemoryUsed uintptr
+	PeakJobMemoryUsed     uintptr
+}
+
 // [MANAGEDAPPLICATION] struct.
 //
 // [MANAGEDAPPLICATION]: https://learn.microsoft.com/en-us/windows/win32/api/appmgmt/ns-appmgmt-managedapplication
@@ -222,10 +279,33 @@ func (osv *OSVERSIONINFOEX) SetSzCSDVersion(val string) {
 type OVERLAPPED struct {
 	Internal     uintptr
 	InternalHigh uintptr
-	Pointer      uintptr
+	Offset       uint32
+	OffsetHigh   uint32
 	HEvent       HEVENT
 }
 
+// Returns Offset and OffsetHigh as a single 64-bit offset.
+func (ov *OVERLAPPED) Offset64() uint64 { return util.Make64(ov.Offset, ov.OffsetHigh) }
+func (ov *OVERLAPPED) SetOffset64(val uint64) {
+	ov.Offset, ov.OffsetHigh = util.Break64(val)
+}
+
+// Returns the Pointer member, which is in union with Offset and OffsetHigh.
+func (ov *OVERLAPPED) Pointer() uintptr { return *(*uintptr)(unsafe.Pointer(&ov.Offset)) }
+func (ov *OVERLAPPED) SetPointer(val uintptr) {
+	*(*uintptr)(unsafe.Pointer(&ov.Offset)) = val
+}
+
+// [OVERLAPPED_ENTRY] struct.
+//
+// [OVERLAPPED_ENTRY]: https://learn.microsoft.com/en-us/windows/win32/api/minwinbase/ns-minwinbase-overlapped_entry
+type OVERLAPPED_ENTRY struct {
+	LpCompletionKey            uintptr
+	LpOverlapped               *OVERLAPPED
+	Internal                   uintptr
+	DwNumberOfBytesTransferred uint32
+}
+
 // [PROCESSENTRY32] struct.
 //
 // ⚠️ You must call SetDwSize() to initialize the struct.
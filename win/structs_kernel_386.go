@@ -0,0 +1,23 @@
+//go:build windows
+
+package win
+
+import (
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// [JOBOBJECT_BASIC_LIMIT_INFORMATION] struct.
+//
+// [JOBOBJECT_BASIC_LIMIT_INFORMATION]: https://learn.microsoft.com/en-us/windows/win32/api/winnt/ns-winnt-jobobject_basic_limit_information
+type JOBOBJECT_BASIC_LIMIT_INFORMATION struct {
+	PerProcessUserTimeLimit int64 // LARGE_INTEGER, in 100-nanosecond ticks
+	PerJobUserTimeLimit     int64 // LARGE_INTEGER, in 100-nanosecond ticks
+	LimitFlags              co.JOB_OBJECT_LIMIT
+	MinimumWorkingSetSize   uintptr
+	MaximumWorkingSetSize   uintptr
+	ActiveProcessLimit      uint32
+	Affinity                uintptr
+	PriorityClass           uint32
+	SchedulingClass         uint32
+	_                       uint32 // Go aligns int64 at 4 bytes in 32-bit, C aligns at 8
+}
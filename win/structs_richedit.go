@@ -0,0 +1,189 @@
+//go:build windows
+
+package win
+
+import (
+	"encoding/binary"
+	"unsafe"
+
+	"github.com/rodrigocfd/windigo/win/co"
+)
+
+// [CHARFORMAT2] struct.
+//
+// ⚠️ You must call SetCbSize() to initialize the struct.
+//
+// # Example:
+//
+//	cf := &CHARFORMAT2{}
+//	cf.SetCbSize()
+//
+// [CHARFORMAT2]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-charformat2w
+type CHARFORMAT2 struct {
+	cbSize          uint32
+	DwMask          co.CFM
+	DwEffects       co.CFE
+	YHeight         int32
+	YOffset         int32
+	CrTextColor     COLORREF
+	BCharSet        co.CHARSET
+	BPitchAndFamily uint8
+	szFaceName      [_LF_FACESIZE]uint16
+	WWeight         uint16
+	SSpacing        int16
+	CrBackColor     COLORREF
+	Lcid            LCID
+	DwCookie        uint32
+	SStyle          int16
+	WKerning        uint16
+	BUnderlineType  uint8
+	BAnimation      uint8
+	BRevAuthor      uint8
+	BUnderlineColor uint8
+}
+
+func (cf *CHARFORMAT2) SetCbSize() { cf.cbSize = uint32(unsafe.Sizeof(*cf)) }
+
+func (cf *CHARFORMAT2) SzFaceName() string { return Str.FromNativeSlice(cf.szFaceName[:]) }
+func (cf *CHARFORMAT2) SetSzFaceName(val string) {
+	copy(cf.szFaceName[:], Str.ToNativeSlice(Str.Substr(val, 0, len(cf.szFaceName)-1)))
+}
+
+// [CHARRANGE] struct.
+//
+// [CHARRANGE]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-charrange
+type CHARRANGE struct {
+	CpMin int32
+	CpMax int32
+}
+
+// [EDITSTREAM] struct.
+//
+// [EDITSTREAM]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-editstream
+type EDITSTREAM struct {
+	data [2*_PTR_SIZE + 4]byte // sizeof(EDITSTREAM) packed
+}
+
+func (es *EDITSTREAM) DwCookie() uintptr       { return _packedPtr(es.data[0:]) }
+func (es *EDITSTREAM) SetDwCookie(val uintptr) { _setPackedPtr(es.data[0:], val) }
+
+func (es *EDITSTREAM) DwError() uint32 { return binary.LittleEndian.Uint32(es.data[_PTR_SIZE:]) }
+func (es *EDITSTREAM) SetDwError(val uint32) {
+	binary.LittleEndian.PutUint32(es.data[_PTR_SIZE:], val)
+}
+
+func (es *EDITSTREAM) PfnCallback() uintptr       { return _packedPtr(es.data[_PTR_SIZE+4:]) }
+func (es *EDITSTREAM) SetPfnCallback(val uintptr) { _setPackedPtr(es.data[_PTR_SIZE+4:], val) }
+
+// [ENLINK] struct.
+//
+// [ENLINK]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-enlink
+type ENLINK struct {
+	data [_NMHDR_SIZE + 4 + 2*_PTR_SIZE + 8]byte // sizeof(ENLINK) packed
+}
+
+func (el *ENLINK) Hdr() NMHDR {
+	return NMHDR{
+		HWndFrom: HWND(_packedPtr(el.data[0:])),
+		IdFrom:   _packedPtr(el.data[_PTR_SIZE:]),
+		Code:     binary.LittleEndian.Uint32(el.data[2*_PTR_SIZE:]),
+	}
+}
+
+func (el *ENLINK) Msg() co.WM {
+	return co.WM(binary.LittleEndian.Uint32(el.data[_NMHDR_SIZE:]))
+}
+
+func (el *ENLINK) WParam() WPARAM { return WPARAM(_packedPtr(el.data[_NMHDR_SIZE+4:])) }
+func (el *ENLINK) LParam() LPARAM {
+	return LPARAM(_packedPtr(el.data[_NMHDR_SIZE+4+_PTR_SIZE:]))
+}
+
+func (el *ENLINK) Chrg() CHARRANGE {
+	off := _NMHDR_SIZE + 4 + 2*_PTR_SIZE
+	return CHARRANGE{
+		CpMin: int32(binary.LittleEndian.Uint32(el.data[off:])),
+		CpMax: int32(binary.LittleEndian.Uint32(el.data[off+4:])),
+	}
+}
+
+// [PARAFORMAT2] struct.
+//
+// ⚠️ You must call SetCbSize() to initialize the struct.
+//
+// # Example:
+//
+//	pf := &PARAFORMAT2{}
+//	pf.SetCbSize()
+//
+// [PARAFORMAT2]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-paraformat2
+type PARAFORMAT2 struct {
+	cbSize           uint32
+	DwMask           co.PFM
+	WNumbering       co.PFN
+	WEffects         uint16
+	DxStartIndent    int32
+	DxRightIndent    int32
+	DxOffset         int32
+	WAlignment       co.PFA
+	CTabCount        int16
+	RgxTabs          [32]int32
+	DySpaceBefore    int32
+	DySpaceAfter     int32
+	DyLineSpacing    int32
+	SStyle           int16
+	BLineSpacingRule uint8
+	BOutlineLevel    uint8
+	WShadingWeight   uint16
+	WShadingStyle    uint16
+	WNumberingStart  uint16
+	WNumberingStyle  uint16
+	WNumberingTab    uint16
+	WBorderSpace     uint16
+	WBorderWidth     uint16
+	WBorders         uint16
+}
+
+func (pf *PARAFORMAT2) SetCbSize() { pf.cbSize = uint32(unsafe.Sizeof(*pf)) }
+
+// [SELCHANGE] struct.
+//
+// [SELCHANGE]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-selchange
+type SELCHANGE struct {
+	Hdr    NMHDR
+	Chrg   CHARRANGE
+	Seltyp co.SEL
+}
+
+// [TEXTRANGE] struct.
+//
+// [TEXTRANGE]: https://learn.microsoft.com/en-us/windows/win32/api/richedit/ns-richedit-textrangew
+type TEXTRANGE struct {
+	Chrg      CHARRANGE
+	lpstrText *uint16
+}
+
+func (tr *TEXTRANGE) LpstrText() *uint16        { return tr.lpstrText }
+func (tr *TEXTRANGE) SetLpstrText(val []uint16) { tr.lpstrText = &val[0] }
+
+// Richedit structs are declared under #pragma pack(4), so pointer-sized fields
+// are read and written by hand.
+const (
+	_PTR_SIZE   = int(unsafe.Sizeof(uintptr(0)))
+	_NMHDR_SIZE = int(unsafe.Sizeof(NMHDR{}))
+)
+
+func _packedPtr(b []byte) uintptr {
+	if _PTR_SIZE == 8 {
+		return uintptr(binary.LittleEndian.Uint64(b))
+	}
+	return uintptr(binary.LittleEndian.Uint32(b))
+}
+
+func _setPackedPtr(b []byte, val uintptr) {
+	if _PTR_SIZE == 8 {
+		binary.LittleEndian.PutUint64(b, uint64(val))
+	} else {
+		binary.LittleEndian.PutUint32(b, uint32(val))
+	}
+}
@@ -83,8 +83,8 @@ func RegValDword(n uint32) RegVal {
 	}
 }
 
-// If the current value is co.REG_DWORD, returns it and true; otherwise 0 and
-// false.
+// If the current value is co.REG_DWORD, with at least 4 bytes, returns it and
+// true; otherwise 0 and false.
 //
 // # Example:
 //
@@ -94,7 +94,7 @@ func RegValDword(n uint32) RegVal {
 //		println(val)
 //	}
 func (me *RegVal) Dword() (uint32, bool) {
-	if me.ty == co.REG_DWORD {
+	if me.ty == co.REG_DWORD && len(me.val) >= 4 {
 		data := (*uint32)(unsafe.Pointer(&me.val[0]))
 		return *data, true
 	} else {
@@ -102,6 +102,33 @@ func (me *RegVal) Dword() (uint32, bool) {
 	}
 }
 
+// Creates a new RegVal variant with a co.REG_DWORD_BIG_ENDIAN value.
+func RegValDwordBigEndian(n uint32) RegVal {
+	return RegVal{
+		ty:  co.REG_DWORD_BIG_ENDIAN,
+		val: []byte{byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)},
+	}
+}
+
+// If the current value is co.REG_DWORD_BIG_ENDIAN, with at least 4 bytes,
+// returns it and true; otherwise 0 and false.
+//
+// # Example:
+//
+//	regVal := RegValDwordBigEndian(0x8000_1001)
+//
+//	if val, ok := regVal.DwordBigEndian(); ok {
+//		println(val)
+//	}
+func (me *RegVal) DwordBigEndian() (uint32, bool) {
+	if me.ty == co.REG_DWORD_BIG_ENDIAN && len(me.val) >= 4 {
+		return uint32(me.val[0])<<24 | uint32(me.val[1])<<16 |
+			uint32(me.val[2])<<8 | uint32(me.val[3]), true
+	} else {
+		return 0, false
+	}
+}
+
 // Creates a new RegVal variant with a co.REG_EXPAND_SZ value.
 //
 // When the value is retrieved, the environment variables should be expanded
@@ -135,13 +162,63 @@ func RegValExpandSz(s string) RegVal {
 // [ExpandEnvironmentStrings]: https://learn.microsoft.com/en-us/windows/win32/api/processenv/nf-processenv-expandenvironmentstringsw
 func (me *RegVal) ExpandSz() (string, bool) {
 	if me.ty == co.REG_EXPAND_SZ {
-		data := unsafe.Slice((*uint16)(unsafe.Pointer(&me.val[0])), len(me.val)/2)
-		return Str.FromNativeSlice(data), true
+		return me.sz(), true
 	} else {
 		return "", false
 	}
 }
 
+// Creates a new RegVal variant with a co.REG_MULTI_SZ value. The strings must
+// not be empty, because an empty string marks the end of the list.
+func RegValMultiSz(strs []string) RegVal {
+	sliceUsr := Str.ToNativeSliceMulti(strs)
+	sliceData := unsafe.Slice((*byte)(unsafe.Pointer(&sliceUsr[0])), len(sliceUsr)*2)
+
+	return RegVal{
+		ty:  co.REG_MULTI_SZ,
+		val: sliceData,
+	}
+}
+
+// If the current value is co.REG_MULTI_SZ, returns it and true; otherwise nil
+// and false.
+//
+// # Example:
+//
+//	regVal := RegValMultiSz([]string{"one", "two"})
+//
+//	if vals, ok := regVal.MultiSz(); ok {
+//		for _, val := range vals {
+//			println(val)
+//		}
+//	}
+func (me *RegVal) MultiSz() ([]string, bool) {
+	if me.ty != co.REG_MULTI_SZ {
+		return nil, false
+	}
+
+	vals := make([]string, 0)
+	if len(me.val) < 2 {
+		return vals, true
+	}
+
+	data := unsafe.Slice((*uint16)(unsafe.Pointer(&me.val[0])), len(me.val)/2)
+	start := 0
+	for i, ch := range data {
+		if ch == 0 {
+			if i == start {
+				return vals, true // empty string marks the end
+			}
+			vals = append(vals, Str.FromNativeSlice(data[start:i]))
+			start = i + 1
+		}
+	}
+	if start < len(data) { // data not properly terminated
+		vals = append(vals, Str.FromNativeSlice(data[start:]))
+	}
+	return vals, true
+}
+
 // Creates a new RegVal variant with a co.REG_QWORD value.
 func RegValQword(n uint64) RegVal {
 	sliceUsr := unsafe.Slice((*byte)(unsafe.Pointer(&n)), unsafe.Sizeof(n))
@@ -154,8 +231,8 @@ func RegValQword(n uint64) RegVal {
 	}
 }
 
-// If the current value is co.REG_QWORD, returns it and true; otherwise 0 and
-// false.
+// If the current value is co.REG_QWORD, with at least 8 bytes, returns it and
+// true; otherwise 0 and false.
 //
 // # Example:
 //
@@ -165,7 +242,7 @@ func RegValQword(n uint64) RegVal {
 //		println(val)
 //	}
 func (me *RegVal) Qword() (uint64, bool) {
-	if me.ty == co.REG_QWORD {
+	if me.ty == co.REG_QWORD && len(me.val) >= 8 {
 		data := (*uint64)(unsafe.Pointer(&me.val[0]))
 		return *data, true
 	} else {
@@ -173,6 +250,43 @@ func (me *RegVal) Qword() (uint64, bool) {
 	}
 }
 
+// Creates a new RegVal variant with any type, and the raw data as stored in the
+// registry. Useful for types without a specific constructor, like co.REG_LINK.
+//
+// # Example:
+//
+//	regVal := win.RegValRaw(co.REG_LINK,
+//		[]byte{0x5c, 0x00, 0x52, 0x00}) // "\R" without null terminator
+func RegValRaw(ty co.REG, data []byte) RegVal {
+	sliceData := make([]byte, len(data))
+	copy(sliceData, data)
+
+	return RegVal{
+		ty:  ty,
+		val: sliceData,
+	}
+}
+
+// Returns the raw data, as stored in the registry, regardless of the type.
+//
+// # Example:
+//
+//	hKey, _ := win.HKEY_LOCAL_MACHINE.RegOpenKeyEx(
+//		"SYSTEM\\CurrentControlSet\\Control\\Session Manager",
+//		co.REG_OPTION_NONE,
+//		co.KEY_READ)
+//	defer hKey.RegCloseKey()
+//
+//	regVal, _ := hKey.RegGetValue(
+//		win.StrOptNone(),
+//		win.StrOptSome("PendingFileRenameOperations"))
+//	println(regVal.Type(), len(regVal.Raw()))
+func (me *RegVal) Raw() []byte {
+	data := make([]byte, len(me.val))
+	copy(data, me.val)
+	return data
+}
+
 // Creates a new RegVal variant with a co.REG_SZ value.
 func RegValSz(s string) RegVal {
 	sliceUsr := Str.ToNativeSlice(s)
@@ -196,9 +310,17 @@ func RegValSz(s string) RegVal {
 //	}
 func (me *RegVal) Sz() (string, bool) {
 	if me.ty == co.REG_SZ {
-		data := unsafe.Slice((*uint16)(unsafe.Pointer(&me.val[0])), len(me.val)/2)
-		return Str.FromNativeSlice(data), true
+		return me.sz(), true
 	} else {
 		return "", false
 	}
 }
+
+// Converts the data to string, up to the first null.
+func (me *RegVal) sz() string {
+	if len(me.val) < 2 {
+		return ""
+	}
+	data := unsafe.Slice((*uint16)(unsafe.Pointer(&me.val[0])), len(me.val)/2)
+	return Str.FromNativeSlice(data)
+}
@@ -112,6 +112,15 @@ func (me *RsrcType) raw() (val uintptr, ptr *uint16) {
 	}
 }
 
+// Converts a raw resource type, which is either an integer or a pointer to a
+// null-terminated string, as received by enumeration callbacks.
+func _RsrcTypeFromRaw(raw uintptr) RsrcType {
+	if raw>>16 == 0 { // IS_INTRESOURCE
+		return RsrcTypeRt(co.RT(raw))
+	}
+	return RsrcTypeStr(Str.FromNativePtr((*uint16)(unsafe.Pointer(raw))))
+}
+
 //------------------------------------------------------------------------------
 
 // Variant type for an optional string value.
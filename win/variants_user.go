@@ -286,3 +286,12 @@ func (me *ResId) raw() (val uintptr, ptr *uint16) {
 		panic("Invalid ResId value.")
 	}
 }
+
+// Converts a raw resource identifier, which is either an integer or a pointer
+// to a null-terminated string, as received by enumeration callbacks.
+func _ResIdFromRaw(raw uintptr) ResId {
+	if raw>>16 == 0 { // IS_INTRESOURCE
+		return ResIdInt(int(raw))
+	}
+	return ResIdStr(Str.FromNativePtr((*uint16)(unsafe.Pointer(raw))))
+}